@@ -520,6 +520,13 @@ type ReplicationGroupParameters struct {
 	// +immutable
 	// +optional
 	TransitEncryptionEnabled *bool `json:"transitEncryptionEnabled,omitempty"`
+
+	// Region in which the ReplicationGroup will be created. Defaults to the region
+	// of the referenced Provider. It cannot be changed after the ReplicationGroup
+	// is created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
 }
 
 // A ReplicationGroupSpec defines the desired state of a ReplicationGroup.
@@ -0,0 +1,196 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	identity "github.com/crossplaneio/stack-aws/apis/identity/v1alpha2"
+	network "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+)
+
+// Fargate profile statuses.
+const (
+	FargateProfileStatusCreating     = "CREATING"
+	FargateProfileStatusActive       = "ACTIVE"
+	FargateProfileStatusDeleting     = "DELETING"
+	FargateProfileStatusCreateFailed = "CREATE_FAILED"
+	FargateProfileStatusDeleteFailed = "DELETE_FAILED"
+)
+
+// Error strings
+const (
+	errResourceIsNotEKSFargateProfile = "The managed resource is not an EKSFargateProfile"
+)
+
+// ClusterNameReferencerForEKSFargateProfile is an attribute referencer that
+// resolves the name of a referenced EKSCluster
+type ClusterNameReferencerForEKSFargateProfile struct {
+	EKSClusterNameReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved cluster name to the managed resource
+func (v *ClusterNameReferencerForEKSFargateProfile) Assign(res resource.CanReference, value string) error {
+	fp, ok := res.(*EKSFargateProfile)
+	if !ok {
+		return errors.New(errResourceIsNotEKSFargateProfile)
+	}
+
+	fp.Spec.ClusterName = value
+	return nil
+}
+
+// IAMRoleARNReferencerForEKSFargateProfile is an attribute referencer that
+// retrieves the ARN of the pod execution role from a referenced IAMRole
+type IAMRoleARNReferencerForEKSFargateProfile struct {
+	identity.IAMRoleARNReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved value to the managed resource
+func (v *IAMRoleARNReferencerForEKSFargateProfile) Assign(res resource.CanReference, value string) error {
+	fp, ok := res.(*EKSFargateProfile)
+	if !ok {
+		return errors.New(errResourceIsNotEKSFargateProfile)
+	}
+
+	fp.Spec.PodExecutionRoleARN = value
+	return nil
+}
+
+// SubnetIDReferencerForEKSFargateProfile is an attribute referencer that
+// resolves SubnetID from a referenced Subnet
+type SubnetIDReferencerForEKSFargateProfile struct {
+	network.SubnetIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved subnetId to the managed resource
+func (v *SubnetIDReferencerForEKSFargateProfile) Assign(res resource.CanReference, value string) error {
+	fp, ok := res.(*EKSFargateProfile)
+	if !ok {
+		return errors.New(errResourceIsNotEKSFargateProfile)
+	}
+
+	for _, id := range fp.Spec.SubnetIDs {
+		if id == value {
+			return nil
+		}
+	}
+	fp.Spec.SubnetIDs = append(fp.Spec.SubnetIDs, value)
+	return nil
+}
+
+// A FargateProfileSelector selects the pods that run on Fargate.
+type FargateProfileSelector struct {
+	// Namespace of the selected pods.
+	Namespace string `json:"namespace"`
+
+	// Labels the selected pods must have.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// EKSFargateProfileParameters define the desired state of an AWS Elastic
+// Kubernetes Service Fargate profile. Fargate profiles cannot be changed once
+// they are created.
+type EKSFargateProfileParameters struct {
+	// Region of the EKSCluster the EKSFargateProfile belongs to. It cannot be
+	// changed after the EKSFargateProfile is created.
+	// +kubebuilder:validation:Enum=us-west-2;us-east-1;eu-west-1
+	// +immutable
+	Region EKSRegion `json:"region"`
+
+	// ClusterName is the name of the EKS cluster the EKSFargateProfile
+	// belongs to.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef references an EKSCluster to retrieve its name.
+	ClusterNameRef *ClusterNameReferencerForEKSFargateProfile `json:"clusterNameRef,omitempty" resource:"attributereferencer"`
+
+	// PodExecutionRoleARN is the ARN of the IAM role the pods that run on
+	// Fargate assume.
+	PodExecutionRoleARN string `json:"podExecutionRoleARN,omitempty"`
+
+	// PodExecutionRoleARNRef references an IAMRole to retrieve its ARN.
+	PodExecutionRoleARNRef *IAMRoleARNReferencerForEKSFargateProfile `json:"podExecutionRoleARNRef,omitempty" resource:"attributereferencer"`
+
+	// SubnetIDs the pods are launched in. Only private subnets are supported.
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a set of referencers that each retrieve the subnetID
+	// from the referenced Subnet.
+	SubnetIDRefs []*SubnetIDReferencerForEKSFargateProfile `json:"subnetIdRefs,omitempty" resource:"attributereferencer"`
+
+	// Selectors of the pods that run on Fargate. A pod runs on Fargate if it
+	// matches any of the selectors.
+	Selectors []FargateProfileSelector `json:"selectors"`
+}
+
+// An EKSFargateProfileSpec defines the desired state of an EKSFargateProfile.
+type EKSFargateProfileSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	EKSFargateProfileParameters  `json:",inline"`
+}
+
+// EKSFargateProfileExternalStatus keeps the state of the external resource.
+type EKSFargateProfileExternalStatus struct {
+	// FargateProfileName is the name of the Fargate profile in EKS.
+	FargateProfileName string `json:"fargateProfileName,omitempty"`
+
+	// FargateProfileARN is the ARN of the Fargate profile.
+	FargateProfileARN string `json:"fargateProfileArn,omitempty"`
+
+	// Status of the Fargate profile.
+	Status string `json:"status,omitempty"`
+}
+
+// An EKSFargateProfileStatus represents the observed state of an
+// EKSFargateProfile.
+type EKSFargateProfileStatus struct {
+	runtimev1alpha1.ResourceStatus  `json:",inline"`
+	EKSFargateProfileExternalStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An EKSFargateProfile is a managed resource that represents an AWS Elastic
+// Kubernetes Service Fargate profile.
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.clusterName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.status"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+type EKSFargateProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EKSFargateProfileSpec   `json:"spec,omitempty"`
+	Status EKSFargateProfileStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// EKSFargateProfileList contains a list of EKSFargateProfiles
+type EKSFargateProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []EKSFargateProfile `json:"items"`
+}
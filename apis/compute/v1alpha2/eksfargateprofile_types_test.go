@@ -0,0 +1,112 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+	"github.com/crossplaneio/crossplane-runtime/pkg/test"
+)
+
+var _ resource.AttributeReferencer = (*ClusterNameReferencerForEKSFargateProfile)(nil)
+var _ resource.AttributeReferencer = (*IAMRoleARNReferencerForEKSFargateProfile)(nil)
+var _ resource.AttributeReferencer = (*SubnetIDReferencerForEKSFargateProfile)(nil)
+
+func TestClusterNameReferencerForEKSFargateProfile_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &ClusterNameReferencerForEKSFargateProfile{}
+	expectedErr := errors.New(errResourceIsNotEKSFargateProfile)
+
+	err := r.Assign(&struct{ resource.CanReference }{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestClusterNameReferencerForEKSFargateProfile_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r := &ClusterNameReferencerForEKSFargateProfile{}
+	res := &EKSFargateProfile{}
+	var expectedErr error
+
+	err := r.Assign(res, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.ClusterName, "mockValue"); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
+
+func TestIAMRoleARNReferencerForEKSFargateProfile_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &IAMRoleARNReferencerForEKSFargateProfile{}
+	expectedErr := errors.New(errResourceIsNotEKSFargateProfile)
+
+	err := r.Assign(&struct{ resource.CanReference }{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestIAMRoleARNReferencerForEKSFargateProfile_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r := &IAMRoleARNReferencerForEKSFargateProfile{}
+	res := &EKSFargateProfile{}
+	var expectedErr error
+
+	err := r.Assign(res, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.PodExecutionRoleARN, "mockValue"); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
+
+func TestSubnetIDReferencerForEKSFargateProfile_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &SubnetIDReferencerForEKSFargateProfile{}
+	expectedErr := errors.New(errResourceIsNotEKSFargateProfile)
+
+	err := r.Assign(&struct{ resource.CanReference }{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestSubnetIDReferencerForEKSFargateProfile_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r := &SubnetIDReferencerForEKSFargateProfile{}
+	res := &EKSFargateProfile{Spec: EKSFargateProfileSpec{EKSFargateProfileParameters: EKSFargateProfileParameters{SubnetIDs: []string{"mockValue"}}}}
+	var expectedErr error
+
+	err := r.Assign(res, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.SubnetIDs, []string{"mockValue"}); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
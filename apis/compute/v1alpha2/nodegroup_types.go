@@ -0,0 +1,266 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	identity "github.com/crossplaneio/stack-aws/apis/identity/v1alpha2"
+	network "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+)
+
+// Node group statuses.
+const (
+	NodeGroupStatusCreating     = "CREATING"
+	NodeGroupStatusActive       = "ACTIVE"
+	NodeGroupStatusUpdating     = "UPDATING"
+	NodeGroupStatusDeleting     = "DELETING"
+	NodeGroupStatusCreateFailed = "CREATE_FAILED"
+	NodeGroupStatusDeleteFailed = "DELETE_FAILED"
+	NodeGroupStatusDegraded     = "DEGRADED"
+)
+
+// Error strings
+const (
+	errResourceIsNotNodeGroup = "The managed resource is not a NodeGroup"
+)
+
+// ClusterNameReferencerForNodeGroup is an attribute referencer that resolves
+// the name of a referenced EKSCluster
+type ClusterNameReferencerForNodeGroup struct {
+	EKSClusterNameReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved cluster name to the managed resource
+func (v *ClusterNameReferencerForNodeGroup) Assign(res resource.CanReference, value string) error {
+	ng, ok := res.(*NodeGroup)
+	if !ok {
+		return errors.New(errResourceIsNotNodeGroup)
+	}
+
+	ng.Spec.ClusterName = value
+	return nil
+}
+
+// IAMRoleARNReferencerForNodeGroup is an attribute referencer that retrieves
+// the ARN of the node role from a referenced IAMRole
+type IAMRoleARNReferencerForNodeGroup struct {
+	identity.IAMRoleARNReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved value to the managed resource
+func (v *IAMRoleARNReferencerForNodeGroup) Assign(res resource.CanReference, value string) error {
+	ng, ok := res.(*NodeGroup)
+	if !ok {
+		return errors.New(errResourceIsNotNodeGroup)
+	}
+
+	ng.Spec.NodeRoleARN = value
+	return nil
+}
+
+// SubnetIDReferencerForNodeGroup is an attribute referencer that resolves
+// SubnetID from a referenced Subnet
+type SubnetIDReferencerForNodeGroup struct {
+	network.SubnetIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved subnetId to the managed resource
+func (v *SubnetIDReferencerForNodeGroup) Assign(res resource.CanReference, value string) error {
+	ng, ok := res.(*NodeGroup)
+	if !ok {
+		return errors.New(errResourceIsNotNodeGroup)
+	}
+
+	for _, id := range ng.Spec.SubnetIDs {
+		if id == value {
+			return nil
+		}
+	}
+	ng.Spec.SubnetIDs = append(ng.Spec.SubnetIDs, value)
+	return nil
+}
+
+// NodeGroupScalingConfig configures the size of the Auto Scaling group of a
+// NodeGroup.
+type NodeGroupScalingConfig struct {
+	// MinSize is the minimum number of worker nodes. Defaults to 1.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MinSize *int `json:"minSize,omitempty"`
+
+	// MaxSize is the maximum number of worker nodes. Defaults to 2.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxSize *int `json:"maxSize,omitempty"`
+
+	// DesiredSize is the number of worker nodes that should be running.
+	// Defaults to 2.
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	DesiredSize *int `json:"desiredSize,omitempty"`
+}
+
+// A NodeGroupTaint is a Kubernetes taint that is applied to the worker nodes
+// of a NodeGroup.
+type NodeGroupTaint struct {
+	// Key of the taint.
+	Key string `json:"key"`
+
+	// Value of the taint.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Effect of the taint on pods that do not tolerate it.
+	// +kubebuilder:validation:Enum=NO_SCHEDULE;NO_EXECUTE;PREFER_NO_SCHEDULE
+	Effect string `json:"effect"`
+}
+
+// NodeGroupParameters define the desired state of an AWS Elastic Kubernetes
+// Service managed node group.
+type NodeGroupParameters struct {
+	// Region of the EKSCluster the NodeGroup belongs to. It cannot be changed
+	// after the NodeGroup is created.
+	// +kubebuilder:validation:Enum=us-west-2;us-east-1;eu-west-1
+	// +immutable
+	Region EKSRegion `json:"region"`
+
+	// ClusterName is the name of the EKS cluster the NodeGroup belongs to.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// ClusterNameRef references an EKSCluster to retrieve its name.
+	ClusterNameRef *ClusterNameReferencerForNodeGroup `json:"clusterNameRef,omitempty" resource:"attributereferencer"`
+
+	// NodeRoleARN is the ARN of the IAM role the worker nodes assume.
+	NodeRoleARN string `json:"nodeRoleARN,omitempty"`
+
+	// NodeRoleARNRef references an IAMRole to retrieve its ARN.
+	NodeRoleARNRef *IAMRoleARNReferencerForNodeGroup `json:"nodeRoleARNRef,omitempty" resource:"attributereferencer"`
+
+	// SubnetIDs the worker nodes are launched in.
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a set of referencers that each retrieve the subnetID
+	// from the referenced Subnet.
+	SubnetIDRefs []*SubnetIDReferencerForNodeGroup `json:"subnetIdRefs,omitempty" resource:"attributereferencer"`
+
+	// ScalingConfig of the Auto Scaling group of the NodeGroup.
+	// +optional
+	ScalingConfig *NodeGroupScalingConfig `json:"scalingConfig,omitempty"`
+
+	// InstanceTypes of the worker nodes. Defaults to t3.medium.
+	// +optional
+	InstanceTypes []string `json:"instanceTypes,omitempty"`
+
+	// DiskSize is the root volume size of the worker nodes in GiB. Defaults
+	// to 20.
+	// +optional
+	DiskSize *int `json:"diskSize,omitempty"`
+
+	// AMIType of the worker nodes. Defaults to AL2_x86_64, or AL2_x86_64_GPU
+	// for GPU instance types.
+	// +optional
+	// +kubebuilder:validation:Enum=AL2_x86_64;AL2_x86_64_GPU
+	AMIType string `json:"amiType,omitempty"`
+
+	// Version of Kubernetes the worker nodes run. Defaults to the version of
+	// the EKS cluster.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// ReleaseVersion of the EKS optimized AMI the worker nodes run. Defaults
+	// to the latest release for the Kubernetes version.
+	// +optional
+	ReleaseVersion string `json:"releaseVersion,omitempty"`
+
+	// Labels to apply to the worker nodes.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Taints to apply to the worker nodes.
+	// +optional
+	Taints []NodeGroupTaint `json:"taints,omitempty"`
+}
+
+// A NodeGroupSpec defines the desired state of a NodeGroup.
+type NodeGroupSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	NodeGroupParameters          `json:",inline"`
+}
+
+// NodeGroupExternalStatus keeps the state of the external resource.
+type NodeGroupExternalStatus struct {
+	// NodeGroupName is the name of the node group in EKS.
+	NodeGroupName string `json:"nodeGroupName,omitempty"`
+
+	// NodeGroupARN is the ARN of the node group.
+	NodeGroupARN string `json:"nodeGroupArn,omitempty"`
+
+	// Status of the node group.
+	Status string `json:"status,omitempty"`
+
+	// Version of Kubernetes the worker nodes run.
+	Version string `json:"version,omitempty"`
+
+	// ReleaseVersion of the EKS optimized AMI the worker nodes run.
+	ReleaseVersion string `json:"releaseVersion,omitempty"`
+
+	// AutoScalingGroupNames are the names of the Auto Scaling groups that
+	// contain the worker nodes.
+	AutoScalingGroupNames []string `json:"autoScalingGroupNames,omitempty"`
+
+	// HealthIssues that are reported for the node group.
+	HealthIssues []string `json:"healthIssues,omitempty"`
+}
+
+// A NodeGroupStatus represents the observed state of a NodeGroup.
+type NodeGroupStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	NodeGroupExternalStatus        `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NodeGroup is a managed resource that represents an AWS Elastic Kubernetes
+// Service managed node group.
+// +kubebuilder:printcolumn:name="CLUSTER",type="string",JSONPath=".spec.clusterName"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.status"
+// +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".status.version"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+type NodeGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeGroupSpec   `json:"spec,omitempty"`
+	Status NodeGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeGroupList contains a list of NodeGroups
+type NodeGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeGroup `json:"items"`
+}
@@ -51,7 +51,23 @@ var (
 	EKSClusterClassGroupVersionKind = SchemeGroupVersion.WithKind(EKSClusterClassKind)
 )
 
+// NodeGroup type metadata.
+var (
+	NodeGroupKind             = reflect.TypeOf(NodeGroup{}).Name()
+	NodeGroupKindAPIVersion   = NodeGroupKind + "." + SchemeGroupVersion.String()
+	NodeGroupGroupVersionKind = SchemeGroupVersion.WithKind(NodeGroupKind)
+)
+
+// EKSFargateProfile type metadata.
+var (
+	EKSFargateProfileKind             = reflect.TypeOf(EKSFargateProfile{}).Name()
+	EKSFargateProfileKindAPIVersion   = EKSFargateProfileKind + "." + SchemeGroupVersion.String()
+	EKSFargateProfileGroupVersionKind = SchemeGroupVersion.WithKind(EKSFargateProfileKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&EKSCluster{}, &EKSClusterList{})
 	SchemeBuilder.Register(&EKSClusterClass{}, &EKSClusterClassList{})
+	SchemeBuilder.Register(&NodeGroup{}, &NodeGroupList{})
+	SchemeBuilder.Register(&EKSFargateProfile{}, &EKSFargateProfileList{})
 }
@@ -32,7 +32,10 @@ const (
 	// The resource is inaccessible while it is being created.
 	ClusterStatusCreating = "CREATING"
 
-	ClusterStatusActive = "ACTIVE"
+	ClusterStatusActive   = "ACTIVE"
+	ClusterStatusUpdating = "UPDATING"
+	ClusterStatusDeleting = "DELETING"
+	ClusterStatusFailed   = "FAILED"
 )
 
 // Error strings
@@ -135,14 +138,29 @@ func (v *SecurityGroupIDReferencerForEKSWorkerNodes) Assign(res resource.CanRefe
 	return nil
 }
 
+// A LogType is a type of EKS control plane log.
+// +kubebuilder:validation:Enum=api;audit;authenticator;controllerManager;scheduler
+type LogType string
+
+// EKS control plane log types.
+const (
+	LogTypeAPI               LogType = "api"
+	LogTypeAudit             LogType = "audit"
+	LogTypeAuthenticator     LogType = "authenticator"
+	LogTypeControllerManager LogType = "controllerManager"
+	LogTypeScheduler         LogType = "scheduler"
+)
+
 // EKSClusterParameters define the desired state of an AWS Elastic Kubernetes
 // Service cluster.
 type EKSClusterParameters struct {
 	// Configuration of this Spec is dependent on the readme as described here
 	// https://docs.aws.amazon.com/eks/latest/userguide/getting-started.html
 
-	// Region for this EKS Cluster.
+	// Region for this EKS Cluster. It cannot be changed after the cluster is
+	// created.
 	// +kubebuilder:validation:Enum=us-west-2;us-east-1;eu-west-1
+	// +immutable
 	Region EKSRegion `json:"region"`
 
 	// RoleARN: The Amazon Resource Name (ARN) of the IAM role that provides
@@ -185,6 +203,27 @@ type EKSClusterParameters struct {
 	// +optional
 	ClusterVersion string `json:"clusterVersion,omitempty"`
 
+	// EnabledLogTypes are the types of control plane logs that are sent to
+	// CloudWatch Logs. Log types that are not listed are disabled.
+	// +optional
+	EnabledLogTypes []LogType `json:"enabledLogTypes,omitempty"`
+
+	// EndpointPublicAccess enables access to the Kubernetes API server
+	// endpoint of this EKS Cluster from the internet. Defaults to true.
+	// +optional
+	EndpointPublicAccess *bool `json:"endpointPublicAccess,omitempty"`
+
+	// EndpointPrivateAccess enables access to the Kubernetes API server
+	// endpoint of this EKS Cluster from within its VPC. Defaults to false.
+	// +optional
+	EndpointPrivateAccess *bool `json:"endpointPrivateAccess,omitempty"`
+
+	// PublicAccessCIDRs are the CIDR blocks that are allowed to access the
+	// public Kubernetes API server endpoint of this EKS Cluster. Defaults to
+	// 0.0.0.0/0.
+	// +optional
+	PublicAccessCIDRs []string `json:"publicAccessCidrs,omitempty"`
+
 	// WorkerNodes configuration for cloudformation
 	WorkerNodes WorkerNodesSpec `json:"workerNodes"`
 
@@ -197,6 +236,17 @@ type EKSClusterParameters struct {
 	// MapUsers map AWS users to one or more Kubernetes groups.
 	// +optional
 	MapUsers []MapUser `json:"mapUsers,omitempty"`
+
+	// MapAccounts map the IAM users of AWS accounts to Kubernetes users of
+	// the same name.
+	// +optional
+	MapAccounts []string `json:"mapAccounts,omitempty"`
+
+	// PublishExecKubeconfig publishes a kubeconfig to the connection secret
+	// that obtains tokens by executing aws-iam-authenticator, in addition to
+	// the token that is refreshed before it expires.
+	// +optional
+	PublishExecKubeconfig bool `json:"publishExecKubeconfig,omitempty"`
 }
 
 // An EKSClusterSpec defines the desired state of an EKSCluster.
@@ -298,6 +348,17 @@ type EKSClusterStatus struct {
 
 	// CloudFormationStackID of the Stack used to create node groups.
 	CloudFormationStackID string `json:"cloudformationStackId,omitempty"`
+
+	// UpdateID of the update of the cluster that is in progress.
+	UpdateID string `json:"updateId,omitempty"`
+
+	// TokenExpiration is the time the token in the connection secret
+	// expires.
+	TokenExpiration *metav1.Time `json:"tokenExpiration,omitempty"`
+
+	// AWSAuthHash is the hash of the aws-auth entries that were last applied
+	// to the cluster.
+	AWSAuthHash string `json:"awsAuthHash,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -312,6 +373,7 @@ type EKSClusterStatus struct {
 // +kubebuilder:printcolumn:name="LOCATION",type="string",JSONPath=".spec.location"
 // +kubebuilder:printcolumn:name="RECLAIM-POLICY",type="string",JSONPath=".spec.reclaimPolicy"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
 type EKSCluster struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
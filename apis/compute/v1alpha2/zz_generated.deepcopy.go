@@ -24,6 +24,38 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNameReferencerForEKSFargateProfile) DeepCopyInto(out *ClusterNameReferencerForEKSFargateProfile) {
+	*out = *in
+	out.EKSClusterNameReferencer = in.EKSClusterNameReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNameReferencerForEKSFargateProfile.
+func (in *ClusterNameReferencerForEKSFargateProfile) DeepCopy() *ClusterNameReferencerForEKSFargateProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNameReferencerForEKSFargateProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterNameReferencerForNodeGroup) DeepCopyInto(out *ClusterNameReferencerForNodeGroup) {
+	*out = *in
+	out.EKSClusterNameReferencer = in.EKSClusterNameReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterNameReferencerForNodeGroup.
+func (in *ClusterNameReferencerForNodeGroup) DeepCopy() *ClusterNameReferencerForNodeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterNameReferencerForNodeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EKSCluster) DeepCopyInto(out *EKSCluster) {
 	*out = *in
@@ -158,6 +190,22 @@ func (in *EKSClusterList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSClusterNameReferencer) DeepCopyInto(out *EKSClusterNameReferencer) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSClusterNameReferencer.
+func (in *EKSClusterNameReferencer) DeepCopy() *EKSClusterNameReferencer {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSClusterNameReferencer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EKSClusterParameters) DeepCopyInto(out *EKSClusterParameters) {
 	*out = *in
@@ -203,6 +251,26 @@ func (in *EKSClusterParameters) DeepCopyInto(out *EKSClusterParameters) {
 			}
 		}
 	}
+	if in.EnabledLogTypes != nil {
+		in, out := &in.EnabledLogTypes, &out.EnabledLogTypes
+		*out = make([]LogType, len(*in))
+		copy(*out, *in)
+	}
+	if in.EndpointPublicAccess != nil {
+		in, out := &in.EndpointPublicAccess, &out.EndpointPublicAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EndpointPrivateAccess != nil {
+		in, out := &in.EndpointPrivateAccess, &out.EndpointPrivateAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PublicAccessCIDRs != nil {
+		in, out := &in.PublicAccessCIDRs, &out.PublicAccessCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	in.WorkerNodes.DeepCopyInto(&out.WorkerNodes)
 	if in.MapRoles != nil {
 		in, out := &in.MapRoles, &out.MapRoles
@@ -218,6 +286,11 @@ func (in *EKSClusterParameters) DeepCopyInto(out *EKSClusterParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MapAccounts != nil {
+		in, out := &in.MapAccounts, &out.MapAccounts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSClusterParameters.
@@ -251,6 +324,10 @@ func (in *EKSClusterSpec) DeepCopy() *EKSClusterSpec {
 func (in *EKSClusterStatus) DeepCopyInto(out *EKSClusterStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	if in.TokenExpiration != nil {
+		in, out := &in.TokenExpiration, &out.TokenExpiration
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSClusterStatus.
@@ -263,6 +340,184 @@ func (in *EKSClusterStatus) DeepCopy() *EKSClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSFargateProfile) DeepCopyInto(out *EKSFargateProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSFargateProfile.
+func (in *EKSFargateProfile) DeepCopy() *EKSFargateProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSFargateProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EKSFargateProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSFargateProfileExternalStatus) DeepCopyInto(out *EKSFargateProfileExternalStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSFargateProfileExternalStatus.
+func (in *EKSFargateProfileExternalStatus) DeepCopy() *EKSFargateProfileExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSFargateProfileExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSFargateProfileList) DeepCopyInto(out *EKSFargateProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]EKSFargateProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSFargateProfileList.
+func (in *EKSFargateProfileList) DeepCopy() *EKSFargateProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSFargateProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EKSFargateProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSFargateProfileParameters) DeepCopyInto(out *EKSFargateProfileParameters) {
+	*out = *in
+	if in.ClusterNameRef != nil {
+		in, out := &in.ClusterNameRef, &out.ClusterNameRef
+		*out = new(ClusterNameReferencerForEKSFargateProfile)
+		**out = **in
+	}
+	if in.PodExecutionRoleARNRef != nil {
+		in, out := &in.PodExecutionRoleARNRef, &out.PodExecutionRoleARNRef
+		*out = new(IAMRoleARNReferencerForEKSFargateProfile)
+		**out = **in
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]*SubnetIDReferencerForEKSFargateProfile, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SubnetIDReferencerForEKSFargateProfile)
+				**out = **in
+			}
+		}
+	}
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make([]FargateProfileSelector, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSFargateProfileParameters.
+func (in *EKSFargateProfileParameters) DeepCopy() *EKSFargateProfileParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSFargateProfileParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSFargateProfileSpec) DeepCopyInto(out *EKSFargateProfileSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.EKSFargateProfileParameters.DeepCopyInto(&out.EKSFargateProfileParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSFargateProfileSpec.
+func (in *EKSFargateProfileSpec) DeepCopy() *EKSFargateProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSFargateProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EKSFargateProfileStatus) DeepCopyInto(out *EKSFargateProfileStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.EKSFargateProfileExternalStatus = in.EKSFargateProfileExternalStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EKSFargateProfileStatus.
+func (in *EKSFargateProfileStatus) DeepCopy() *EKSFargateProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EKSFargateProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FargateProfileSelector) DeepCopyInto(out *FargateProfileSelector) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FargateProfileSelector.
+func (in *FargateProfileSelector) DeepCopy() *FargateProfileSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(FargateProfileSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IAMRoleARNReferencerForEKSCluster) DeepCopyInto(out *IAMRoleARNReferencerForEKSCluster) {
 	*out = *in
@@ -279,6 +534,38 @@ func (in *IAMRoleARNReferencerForEKSCluster) DeepCopy() *IAMRoleARNReferencerFor
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMRoleARNReferencerForEKSFargateProfile) DeepCopyInto(out *IAMRoleARNReferencerForEKSFargateProfile) {
+	*out = *in
+	out.IAMRoleARNReferencer = in.IAMRoleARNReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMRoleARNReferencerForEKSFargateProfile.
+func (in *IAMRoleARNReferencerForEKSFargateProfile) DeepCopy() *IAMRoleARNReferencerForEKSFargateProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMRoleARNReferencerForEKSFargateProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IAMRoleARNReferencerForNodeGroup) DeepCopyInto(out *IAMRoleARNReferencerForNodeGroup) {
+	*out = *in
+	out.IAMRoleARNReferencer = in.IAMRoleARNReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IAMRoleARNReferencerForNodeGroup.
+func (in *IAMRoleARNReferencerForNodeGroup) DeepCopy() *IAMRoleARNReferencerForNodeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(IAMRoleARNReferencerForNodeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MapRole) DeepCopyInto(out *MapRole) {
 	*out = *in
@@ -319,6 +606,237 @@ func (in *MapUser) DeepCopy() *MapUser {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroup.
+func (in *NodeGroup) DeepCopy() *NodeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupExternalStatus) DeepCopyInto(out *NodeGroupExternalStatus) {
+	*out = *in
+	if in.AutoScalingGroupNames != nil {
+		in, out := &in.AutoScalingGroupNames, &out.AutoScalingGroupNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthIssues != nil {
+		in, out := &in.HealthIssues, &out.HealthIssues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupExternalStatus.
+func (in *NodeGroupExternalStatus) DeepCopy() *NodeGroupExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupList) DeepCopyInto(out *NodeGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupList.
+func (in *NodeGroupList) DeepCopy() *NodeGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupParameters) DeepCopyInto(out *NodeGroupParameters) {
+	*out = *in
+	if in.ClusterNameRef != nil {
+		in, out := &in.ClusterNameRef, &out.ClusterNameRef
+		*out = new(ClusterNameReferencerForNodeGroup)
+		**out = **in
+	}
+	if in.NodeRoleARNRef != nil {
+		in, out := &in.NodeRoleARNRef, &out.NodeRoleARNRef
+		*out = new(IAMRoleARNReferencerForNodeGroup)
+		**out = **in
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]*SubnetIDReferencerForNodeGroup, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SubnetIDReferencerForNodeGroup)
+				**out = **in
+			}
+		}
+	}
+	if in.ScalingConfig != nil {
+		in, out := &in.ScalingConfig, &out.ScalingConfig
+		*out = new(NodeGroupScalingConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DiskSize != nil {
+		in, out := &in.DiskSize, &out.DiskSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]NodeGroupTaint, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupParameters.
+func (in *NodeGroupParameters) DeepCopy() *NodeGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupScalingConfig) DeepCopyInto(out *NodeGroupScalingConfig) {
+	*out = *in
+	if in.MinSize != nil {
+		in, out := &in.MinSize, &out.MinSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.DesiredSize != nil {
+		in, out := &in.DesiredSize, &out.DesiredSize
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupScalingConfig.
+func (in *NodeGroupScalingConfig) DeepCopy() *NodeGroupScalingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupScalingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupSpec) DeepCopyInto(out *NodeGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.NodeGroupParameters.DeepCopyInto(&out.NodeGroupParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupSpec.
+func (in *NodeGroupSpec) DeepCopy() *NodeGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupStatus) DeepCopyInto(out *NodeGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.NodeGroupExternalStatus.DeepCopyInto(&out.NodeGroupExternalStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupStatus.
+func (in *NodeGroupStatus) DeepCopy() *NodeGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeGroupTaint) DeepCopyInto(out *NodeGroupTaint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupTaint.
+func (in *NodeGroupTaint) DeepCopy() *NodeGroupTaint {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeGroupTaint)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroupIDReferencerForEKSCluster) DeepCopyInto(out *SecurityGroupIDReferencerForEKSCluster) {
 	*out = *in
@@ -367,6 +885,38 @@ func (in *SubnetIDReferencerForEKSCluster) DeepCopy() *SubnetIDReferencerForEKSC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetIDReferencerForEKSFargateProfile) DeepCopyInto(out *SubnetIDReferencerForEKSFargateProfile) {
+	*out = *in
+	out.SubnetIDReferencer = in.SubnetIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetIDReferencerForEKSFargateProfile.
+func (in *SubnetIDReferencerForEKSFargateProfile) DeepCopy() *SubnetIDReferencerForEKSFargateProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetIDReferencerForEKSFargateProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetIDReferencerForNodeGroup) DeepCopyInto(out *SubnetIDReferencerForNodeGroup) {
+	*out = *in
+	out.SubnetIDReferencer = in.SubnetIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetIDReferencerForNodeGroup.
+func (in *SubnetIDReferencerForNodeGroup) DeepCopy() *SubnetIDReferencerForNodeGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetIDReferencerForNodeGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCIDReferencerForEKSCluster) DeepCopyInto(out *VPCIDReferencerForEKSCluster) {
 	*out = *in
@@ -82,3 +82,123 @@ func (mg *EKSCluster) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
 func (mg *EKSCluster) SetWriteConnectionSecretToReference(r corev1.LocalObjectReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
+
+// GetBindingPhase of this EKSFargateProfile.
+func (mg *EKSFargateProfile) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this EKSFargateProfile.
+func (mg *EKSFargateProfile) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetCondition of this EKSFargateProfile.
+func (mg *EKSFargateProfile) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetNonPortableClassReference of this EKSFargateProfile.
+func (mg *EKSFargateProfile) GetNonPortableClassReference() *corev1.ObjectReference {
+	return mg.Spec.NonPortableClassReference
+}
+
+// GetReclaimPolicy of this EKSFargateProfile.
+func (mg *EKSFargateProfile) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this EKSFargateProfile.
+func (mg *EKSFargateProfile) GetWriteConnectionSecretToReference() corev1.LocalObjectReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this EKSFargateProfile.
+func (mg *EKSFargateProfile) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this EKSFargateProfile.
+func (mg *EKSFargateProfile) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetConditions of this EKSFargateProfile.
+func (mg *EKSFargateProfile) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetNonPortableClassReference of this EKSFargateProfile.
+func (mg *EKSFargateProfile) SetNonPortableClassReference(r *corev1.ObjectReference) {
+	mg.Spec.NonPortableClassReference = r
+}
+
+// SetReclaimPolicy of this EKSFargateProfile.
+func (mg *EKSFargateProfile) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this EKSFargateProfile.
+func (mg *EKSFargateProfile) SetWriteConnectionSecretToReference(r corev1.LocalObjectReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetBindingPhase of this NodeGroup.
+func (mg *NodeGroup) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this NodeGroup.
+func (mg *NodeGroup) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetCondition of this NodeGroup.
+func (mg *NodeGroup) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetNonPortableClassReference of this NodeGroup.
+func (mg *NodeGroup) GetNonPortableClassReference() *corev1.ObjectReference {
+	return mg.Spec.NonPortableClassReference
+}
+
+// GetReclaimPolicy of this NodeGroup.
+func (mg *NodeGroup) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this NodeGroup.
+func (mg *NodeGroup) GetWriteConnectionSecretToReference() corev1.LocalObjectReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this NodeGroup.
+func (mg *NodeGroup) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this NodeGroup.
+func (mg *NodeGroup) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetConditions of this NodeGroup.
+func (mg *NodeGroup) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetNonPortableClassReference of this NodeGroup.
+func (mg *NodeGroup) SetNonPortableClassReference(r *corev1.ObjectReference) {
+	mg.Spec.NonPortableClassReference = r
+}
+
+// SetReclaimPolicy of this NodeGroup.
+func (mg *NodeGroup) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this NodeGroup.
+func (mg *NodeGroup) SetWriteConnectionSecretToReference(r corev1.LocalObjectReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
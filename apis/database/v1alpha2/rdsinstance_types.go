@@ -80,7 +80,9 @@ type RDSInstanceParameters struct {
 	// +kubebuilder:validation:Enum=mysql;postgres
 	Engine string `json:"engine"`
 
-	// EngineVersion for this RDS instance, for example "5.6".
+	// EngineVersion for this RDS instance, for example "5.6". Changing it to
+	// a new major version, for example from "5.6" to "5.7", upgrades the
+	// instance to that major version.
 	// +optional
 	EngineVersion string `json:"engineVersion,omitempty"`
 
@@ -106,6 +108,19 @@ type RDSInstanceParameters struct {
 
 	// SecurityGroupRefs references to a list of SecurityGroups to retrieve a list of securityGroupIDs
 	SecurityGroupIDRefs []*SecurityGroupIDReferencerForRDSInstance `json:"securityGroupIdRefs,omitempty" resource:"attributereferencer"`
+
+	// Region in which the RDSInstance will be created. Defaults to the region of
+	// the referenced Provider. It cannot be changed after the RDSInstance is
+	// created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// ApplyModificationsImmediately causes changes to the class, size, engine
+	// version and security groups of the RDS instance to be applied as soon as
+	// possible. If false they are applied during the next maintenance window.
+	// +optional
+	ApplyModificationsImmediately bool `json:"applyModificationsImmediately,omitempty"`
 }
 
 // An RDSInstanceSpec defines the desired state of an RDSInstance.
@@ -155,6 +170,7 @@ type RDSInstanceStatus struct {
 // +kubebuilder:printcolumn:name="CLASS",type="string",JSONPath=".spec.classRef.name"
 // +kubebuilder:printcolumn:name="VERSION",type="string",JSONPath=".spec.engineVersion"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
 type RDSInstance struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
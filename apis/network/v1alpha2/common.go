@@ -40,3 +40,13 @@ func BuildFromEC2Tags(tags []ec2.Tag) []Tag {
 
 	return res
 }
+
+// BuildEC2Tags returns a list of ec2 tags, off of the given tags
+func BuildEC2Tags(tags []Tag) []ec2.Tag {
+	res := make([]ec2.Tag, len(tags))
+	for i, t := range tags {
+		res[i] = ec2.Tag{Key: aws.String(t.Key), Value: aws.String(t.Value)}
+	}
+
+	return res
+}
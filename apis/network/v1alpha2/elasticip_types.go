@@ -0,0 +1,128 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// ElasticIPParameters define the desired state of an AWS Elastic IP address.
+type ElasticIPParameters struct {
+	// Address is the Elastic IP address to recover or an IPv4 address from an
+	// address pool. If omitted, AWS allocates a new address from its pool.
+	// +optional
+	Address string `json:"address,omitempty"`
+
+	// Tags to apply to the ElasticIP. Tags that are not specified here are left
+	// untouched. Tags that record the UID, name and namespace of the ElasticIP
+	// are added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the ElasticIP will be allocated. Defaults to the region of
+	// the referenced Provider. It cannot be changed after the ElasticIP is
+	// allocated.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+}
+
+// An ElasticIPSpec defines the desired state of an ElasticIP.
+type ElasticIPSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	ElasticIPParameters          `json:",inline"`
+}
+
+// ElasticIPExternalStatus keeps the state for the external resource
+type ElasticIPExternalStatus struct {
+	// AllocationID is the ID that AWS assigns to represent the allocation of
+	// the Elastic IP address for use with instances in a VPC.
+	AllocationID string `json:"allocationId,omitempty"`
+
+	// AssociationID is the ID of the association between the address and an
+	// instance or network interface, if any.
+	AssociationID string `json:"associationId,omitempty"`
+
+	// InstanceID is the ID of the instance the address is associated with, if
+	// any.
+	InstanceID string `json:"instanceId,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface the address is
+	// associated with, if any.
+	NetworkInterfaceID string `json:"networkInterfaceId,omitempty"`
+
+	// PrivateIPAddress is the private IP address associated with the Elastic
+	// IP address, if any.
+	PrivateIPAddress string `json:"privateIpAddress,omitempty"`
+
+	// PublicIP is the Elastic IP address.
+	PublicIP string `json:"publicIp,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// An ElasticIPStatus represents the observed state of an ElasticIP.
+type ElasticIPStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	ElasticIPExternalStatus        `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ElasticIP is a managed resource that represents an AWS Elastic IP
+// address allocated for use in a VPC.
+// +kubebuilder:printcolumn:name="ALLOCATIONID",type="string",JSONPath=".status.allocationId"
+// +kubebuilder:printcolumn:name="PUBLICIP",type="string",JSONPath=".status.publicIp"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+type ElasticIP struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ElasticIPSpec   `json:"spec,omitempty"`
+	Status ElasticIPStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ElasticIPList contains a list of ElasticIPs
+type ElasticIPList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ElasticIP `json:"items"`
+}
+
+// UpdateExternalStatus updates the external status object, given the observation
+func (e *ElasticIP) UpdateExternalStatus(observation ec2.Address) {
+	e.Status.ElasticIPExternalStatus = ElasticIPExternalStatus{
+		AllocationID:       aws.StringValue(observation.AllocationId),
+		AssociationID:      aws.StringValue(observation.AssociationId),
+		InstanceID:         aws.StringValue(observation.InstanceId),
+		NetworkInterfaceID: aws.StringValue(observation.NetworkInterfaceId),
+		PrivateIPAddress:   aws.StringValue(observation.PrivateIpAddress),
+		PublicIP:           aws.StringValue(observation.PublicIp),
+		Tags:               BuildFromEC2Tags(observation.Tags),
+	}
+}
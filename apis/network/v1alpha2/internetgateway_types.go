@@ -55,6 +55,19 @@ type InternetGatewayParameters struct {
 
 	// VPCIDRef references to a VPC to and retrieves its vpcId
 	VPCIDRef *VPCIDReferencerForInternetGateway `json:"vpcIdRef,omitempty" resource:"attributereferencer"`
+
+	// Tags to apply to the InternetGateway. Tags that are not specified here
+	// are left untouched. Tags that record the UID, name and namespace of the
+	// InternetGateway are added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the InternetGateway will be created. Defaults to the region
+	// of the referenced Provider. It cannot be changed after the InternetGateway
+	// is created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
 }
 
 // An InternetGatewaySpec defines the desired state of an InternetGateway.
@@ -134,5 +147,6 @@ func (i *InternetGateway) UpdateExternalStatus(observation ec2.InternetGateway)
 	i.Status.InternetGatewayExternalStatus = InternetGatewayExternalStatus{
 		InternetGatewayID: aws.StringValue(observation.InternetGatewayId),
 		Attachments:       attachments,
+		Tags:              BuildFromEC2Tags(observation.Tags),
 	}
 }
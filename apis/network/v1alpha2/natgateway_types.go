@@ -0,0 +1,204 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+)
+
+// Error strings
+const (
+	errResourceIsNotNATGateway = "The managed resource is not a NATGateway"
+)
+
+// SubnetIDReferencerForNATGateway is an attribute referencer that resolves SubnetID from a referenced Subnet
+type SubnetIDReferencerForNATGateway struct {
+	SubnetIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved subnetId to the managed resource
+func (v *SubnetIDReferencerForNATGateway) Assign(res resource.CanReference, value string) error {
+	nat, ok := res.(*NATGateway)
+	if !ok {
+		return errors.New(errResourceIsNotNATGateway)
+	}
+
+	nat.Spec.SubnetID = value
+	return nil
+}
+
+// AllocationIDReferencerForNATGateway is an attribute referencer that resolves AllocationID from a referenced ElasticIP
+type AllocationIDReferencerForNATGateway struct {
+	AllocationIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved allocationId to the managed resource
+func (v *AllocationIDReferencerForNATGateway) Assign(res resource.CanReference, value string) error {
+	nat, ok := res.(*NATGateway)
+	if !ok {
+		return errors.New(errResourceIsNotNATGateway)
+	}
+
+	nat.Spec.AllocationID = value
+	return nil
+}
+
+// NATGatewayParameters define the desired state of an AWS VPC NAT Gateway.
+type NATGatewayParameters struct {
+	// AllocationID is the allocation ID of the Elastic IP address to associate
+	// with the NAT gateway.
+	AllocationID string `json:"allocationId,omitempty"`
+
+	// AllocationIDRef references to an ElasticIP to and retrieves its allocationId
+	AllocationIDRef *AllocationIDReferencerForNATGateway `json:"allocationIdRef,omitempty" resource:"attributereferencer"`
+
+	// SubnetID is the ID of the public subnet in which to create the NAT
+	// gateway.
+	SubnetID string `json:"subnetId,omitempty"`
+
+	// SubnetIDRef references to a Subnet to and retrieves its subnetId
+	SubnetIDRef *SubnetIDReferencerForNATGateway `json:"subnetIdRef,omitempty" resource:"attributereferencer"`
+
+	// Tags to apply to the NATGateway. Tags that are not specified here are
+	// left untouched. Tags that record the UID, name and namespace of the
+	// NATGateway are added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the NATGateway will be created. Defaults to the region of
+	// the referenced Provider. It cannot be changed after the NATGateway is
+	// created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+}
+
+// A NATGatewaySpec defines the desired state of a NATGateway.
+type NATGatewaySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	NATGatewayParameters         `json:",inline"`
+}
+
+// NATGatewayAddress describes the IP addresses and network interface
+// associated with a NAT gateway.
+type NATGatewayAddress struct {
+	// AllocationID is the allocation ID of the Elastic IP address that's
+	// associated with the NAT gateway.
+	AllocationID string `json:"allocationId,omitempty"`
+
+	// NetworkInterfaceID is the ID of the network interface associated with
+	// the NAT gateway.
+	NetworkInterfaceID string `json:"networkInterfaceId,omitempty"`
+
+	// PrivateIP is the private IP address associated with the Elastic IP
+	// address.
+	PrivateIP string `json:"privateIp,omitempty"`
+
+	// PublicIP is the Elastic IP address associated with the NAT gateway.
+	PublicIP string `json:"publicIp,omitempty"`
+}
+
+// NATGatewayExternalStatus keeps the state for the external resource
+type NATGatewayExternalStatus struct {
+	// NATGatewayID is the ID of the NAT gateway.
+	NATGatewayID string `json:"natGatewayId,omitempty"`
+
+	// NATGatewayState is the current state of the NAT gateway.
+	// +kubebuilder:validation:Enum=pending;failed;available;deleting;deleted
+	NATGatewayState string `json:"natGatewayState,omitempty"`
+
+	// Addresses holds the IP addresses and network interface associated with
+	// the NAT gateway.
+	Addresses []NATGatewayAddress `json:"addresses,omitempty"`
+
+	// FailureCode is the error code for the failure, if the NAT gateway
+	// failed.
+	FailureCode string `json:"failureCode,omitempty"`
+
+	// FailureMessage is the error message for the failure, if the NAT gateway
+	// failed.
+	FailureMessage string `json:"failureMessage,omitempty"`
+
+	// VPCID is the ID of the VPC in which the NAT gateway is located.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A NATGatewayStatus represents the observed state of a NATGateway.
+type NATGatewayStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	NATGatewayExternalStatus       `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NATGateway is a managed resource that represents an AWS VPC NAT Gateway.
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.natGatewayId"
+// +kubebuilder:printcolumn:name="SUBNETID",type="string",JSONPath=".spec.subnetId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.natGatewayState"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+type NATGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NATGatewaySpec   `json:"spec,omitempty"`
+	Status NATGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NATGatewayList contains a list of NATGateways
+type NATGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NATGateway `json:"items"`
+}
+
+// UpdateExternalStatus updates the external status object, given the observation
+func (n *NATGateway) UpdateExternalStatus(observation ec2.NatGateway) {
+	addresses := make([]NATGatewayAddress, len(observation.NatGatewayAddresses))
+	for i, a := range observation.NatGatewayAddresses {
+		addresses[i] = NATGatewayAddress{
+			AllocationID:       aws.StringValue(a.AllocationId),
+			NetworkInterfaceID: aws.StringValue(a.NetworkInterfaceId),
+			PrivateIP:          aws.StringValue(a.PrivateIp),
+			PublicIP:           aws.StringValue(a.PublicIp),
+		}
+	}
+
+	n.Status.NATGatewayExternalStatus = NATGatewayExternalStatus{
+		NATGatewayID:    aws.StringValue(observation.NatGatewayId),
+		NATGatewayState: string(observation.State),
+		Addresses:       addresses,
+		FailureCode:     aws.StringValue(observation.FailureCode),
+		FailureMessage:  aws.StringValue(observation.FailureMessage),
+		VPCID:           aws.StringValue(observation.VpcId),
+		Tags:            BuildFromEC2Tags(observation.Tags),
+	}
+}
@@ -66,11 +66,34 @@ func Test_SecurityGroup_BuildEC2Permissions(t *testing.T) {
 				{CIDRIP: "arbitranry cidrip"},
 			},
 		},
-	})
+	}, "arbitrary group id")
 
 	g.Expect(res).ToNot(gomega.BeNil())
 }
 
+func Test_SecurityGroup_BuildEC2Permissions_Sources(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	res := BuildEC2Permissions([]IPPermission{
+		{
+			IPv6CIDRBlocks:   []IPv6Range{{CIDRIPv6: "::/0"}},
+			PrefixListIDs:    []PrefixListID{{PrefixListID: "pl-123"}},
+			UserIDGroupPairs: []UserIDGroupPair{{GroupID: "sg-other"}, {GroupID: "ignored", Self: true}},
+		},
+	}, "sg-self")
+
+	g.Expect(res).To(gomega.HaveLen(1))
+	g.Expect(aws.StringValue(res[0].Ipv6Ranges[0].CidrIpv6)).To(gomega.Equal("::/0"))
+	g.Expect(aws.StringValue(res[0].PrefixListIds[0].PrefixListId)).To(gomega.Equal("pl-123"))
+	g.Expect(aws.StringValue(res[0].UserIdGroupPairs[0].GroupId)).To(gomega.Equal("sg-other"))
+	g.Expect(aws.StringValue(res[0].UserIdGroupPairs[1].GroupId)).To(gomega.Equal("sg-self"))
+	g.Expect(res[0].UserIdGroupPairs[0].UserId).To(gomega.BeNil())
+	g.Expect(res[0].UserIdGroupPairs[0].Description).To(gomega.BeNil())
+	g.Expect(res[0].Ipv6Ranges[0].Description).To(gomega.BeNil())
+	g.Expect(res[0].PrefixListIds[0].Description).To(gomega.BeNil())
+	g.Expect(res[0].FromPort).ToNot(gomega.BeNil())
+}
+
 func Test_InternetGateway_BuildEC2Permissions(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	r := InternetGateway{}
@@ -83,6 +106,104 @@ func Test_InternetGateway_BuildEC2Permissions(t *testing.T) {
 	g.Expect(len(r.Status.InternetGatewayExternalStatus.Attachments)).To(gomega.Equal(1))
 }
 
+func Test_ElasticIP_BuildExternalStatusFromObservation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	r := ElasticIP{}
+	r.UpdateExternalStatus(ec2.Address{
+		AllocationId: aws.String("eipalloc-1"),
+		PublicIp:     aws.String("203.0.113.1"),
+	})
+
+	g.Expect(r.Status.AllocationID).To(gomega.Equal("eipalloc-1"))
+	g.Expect(r.Status.PublicIP).To(gomega.Equal("203.0.113.1"))
+}
+
+func Test_NATGateway_BuildExternalStatusFromObservation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	r := NATGateway{}
+	r.UpdateExternalStatus(ec2.NatGateway{
+		NatGatewayId: aws.String("nat-1"),
+		State:        ec2.NatGatewayStateAvailable,
+		NatGatewayAddresses: []ec2.NatGatewayAddress{
+			{PrivateIp: aws.String("10.0.0.5"), PublicIp: aws.String("203.0.113.1")},
+		},
+	})
+
+	g.Expect(r.Status.NATGatewayID).To(gomega.Equal("nat-1"))
+	g.Expect(r.Status.NATGatewayState).To(gomega.Equal("available"))
+	g.Expect(r.Status.Addresses).To(gomega.Equal([]NATGatewayAddress{{PrivateIP: "10.0.0.5", PublicIP: "203.0.113.1"}}))
+}
+
+func Test_VPCEndpoint_BuildExternalStatusFromObservation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	r := VPCEndpoint{}
+	r.UpdateExternalStatus(ec2.VpcEndpoint{
+		VpcEndpointId: aws.String("vpce-1"),
+		State:         "available",
+		DnsEntries: []ec2.DnsEntry{
+			{DnsName: aws.String("vpce-1.sts.us-east-1.vpce.amazonaws.com"), HostedZoneId: aws.String("Z1")},
+		},
+	})
+
+	g.Expect(r.Status.VPCEndpointID).To(gomega.Equal("vpce-1"))
+	g.Expect(r.Status.VPCEndpointState).To(gomega.Equal("available"))
+	g.Expect(r.Status.DNSEntries).To(gomega.Equal([]DNSEntry{{DNSName: "vpce-1.sts.us-east-1.vpce.amazonaws.com", HostedZoneID: "Z1"}}))
+}
+
+func Test_VPCPeeringConnection_BuildExternalStatusFromObservation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	r := VPCPeeringConnection{}
+	r.UpdateExternalStatus(ec2.VpcPeeringConnection{
+		VpcPeeringConnectionId: aws.String("pcx-1"),
+		Status: &ec2.VpcPeeringConnectionStateReason{
+			Code:    ec2.VpcPeeringConnectionStateReasonCodePendingAcceptance,
+			Message: aws.String("Pending Acceptance by 123456789012"),
+		},
+		RequesterVpcInfo: &ec2.VpcPeeringConnectionVpcInfo{CidrBlock: aws.String("10.0.0.0/16")},
+	})
+
+	g.Expect(r.Status.VPCPeeringConnectionID).To(gomega.Equal("pcx-1"))
+	g.Expect(r.Status.StatusCode).To(gomega.Equal("pending-acceptance"))
+	g.Expect(r.Status.StatusMessage).To(gomega.Equal("Pending Acceptance by 123456789012"))
+	g.Expect(r.Status.RequesterCIDRBlock).To(gomega.Equal("10.0.0.0/16"))
+	g.Expect(r.Status.AccepterCIDRBlock).To(gomega.BeEmpty())
+}
+
+func Test_NetworkACL_BuildExternalStatusFromObservation(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+	r := NetworkACL{}
+	r.UpdateExternalStatus(ec2.NetworkAcl{
+		NetworkAclId: aws.String("acl-1"),
+		Entries: []ec2.NetworkAclEntry{
+			{
+				RuleNumber: aws.Int64(100),
+				Protocol:   aws.String("6"),
+				RuleAction: ec2.RuleActionDeny,
+				CidrBlock:  aws.String("10.0.0.0/8"),
+				PortRange:  &ec2.PortRange{From: aws.Int64(22), To: aws.Int64(22)},
+			},
+			{RuleNumber: aws.Int64(32767), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: ec2.RuleActionDeny},
+		},
+		Associations: []ec2.NetworkAclAssociation{
+			{NetworkAclAssociationId: aws.String("aclassoc-1"), SubnetId: aws.String("subnet-1")},
+		},
+	})
+
+	g.Expect(r.Status.NetworkACLID).To(gomega.Equal("acl-1"))
+	g.Expect(r.Status.IngressEntries).To(gomega.Equal([]NetworkACLEntry{{
+		RuleNumber: 100,
+		Protocol:   "6",
+		RuleAction: "deny",
+		CIDRBlock:  "10.0.0.0/8",
+		PortRange:  &NetworkACLPortRange{From: 22, To: 22},
+	}}))
+	g.Expect(r.Status.EgressEntries).To(gomega.HaveLen(1))
+	g.Expect(r.Status.Associations).To(gomega.Equal([]NetworkACLAssociationState{{
+		AssociationID:         "aclassoc-1",
+		NetworkACLAssociation: NetworkACLAssociation{SubnetID: "subnet-1"},
+	}}))
+}
+
 func Test_Subnet_BuildEC2Permissions(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 	r := Subnet{}
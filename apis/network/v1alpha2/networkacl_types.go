@@ -0,0 +1,296 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+)
+
+// Error strings
+const (
+	errResourceIsNotNetworkACL       = "The managed resource is not a NetworkACL"
+	errNetworkACLAssociationNotFound = "Could not find a network ACL association in the array with the referred object name"
+)
+
+// VPCIDReferencerForNetworkACL is an attribute referencer that resolves VPCID from a referenced VPC
+type VPCIDReferencerForNetworkACL struct {
+	VPCIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved vpcId to the managed resource
+func (v *VPCIDReferencerForNetworkACL) Assign(res resource.CanReference, value string) error {
+	acl, ok := res.(*NetworkACL)
+	if !ok {
+		return errors.New(errResourceIsNotNetworkACL)
+	}
+
+	acl.Spec.VPCID = value
+	return nil
+}
+
+// SubnetIDReferencerForNetworkACL is an attribute referencer that resolves SubnetID from a referenced Subnet
+type SubnetIDReferencerForNetworkACL struct {
+	SubnetIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved subnetId to the managed resource
+func (v *SubnetIDReferencerForNetworkACL) Assign(res resource.CanReference, value string) error {
+	acl, ok := res.(*NetworkACL)
+	if !ok {
+		return errors.New(errResourceIsNotNetworkACL)
+	}
+
+	// find the association that this field belongs to, and assign its subnetID
+	for i := 0; i < len(acl.Spec.Associations); i++ {
+		if acl.Spec.Associations[i].SubnetIDRef != nil && acl.Spec.Associations[i].SubnetIDRef.Name == v.Name {
+			acl.Spec.Associations[i].SubnetID = value
+			return nil
+		}
+	}
+
+	return errors.New(errNetworkACLAssociationNotFound)
+}
+
+// NetworkACLPortRange describes a range of ports.
+type NetworkACLPortRange struct {
+	// The first port in the range.
+	From int64 `json:"from"`
+
+	// The last port in the range.
+	To int64 `json:"to"`
+}
+
+// NetworkACLICMPTypeCode describes the ICMP type and code.
+type NetworkACLICMPTypeCode struct {
+	// The ICMP type. A value of -1 means all types.
+	Type int64 `json:"type"`
+
+	// The ICMP code. A value of -1 means all codes for the specified ICMP
+	// type.
+	Code int64 `json:"code"`
+}
+
+// NetworkACLEntry describes a rule of a network ACL. The rules of each
+// direction are evaluated in order of their rule number, starting with the
+// lowest, and the first rule that matches the traffic decides whether it is
+// allowed or denied.
+type NetworkACLEntry struct {
+	// The rule number of the entry. Rule numbers range from 1 to 32766 and
+	// must be unique per direction. Rule number 32767 (and, for IPv6, 32768)
+	// is the default rule that denies all traffic not matched by another
+	// rule; it cannot be changed.
+	RuleNumber int64 `json:"ruleNumber"`
+
+	// The protocol name (tcp, udp, icmp, icmpv6) or number (see Protocol
+	// Numbers (http://www.iana.org/assignments/protocol-numbers/protocol-numbers.xhtml)).
+	// A value of -1 means all protocols. If you specify -1 or a protocol other
+	// than tcp, udp, icmp or icmpv6, traffic on all ports is matched,
+	// regardless of any port range or ICMP type you specify.
+	Protocol string `json:"protocol"`
+
+	// Indicates whether to allow or deny the traffic that matches the rule.
+	// +kubebuilder:validation:Enum=allow;deny
+	RuleAction string `json:"ruleAction"`
+
+	// The IPv4 network range to allow or deny, in CIDR notation. Either
+	// cidrBlock or ipv6CidrBlock must be specified.
+	CIDRBlock string `json:"cidrBlock,omitempty"`
+
+	// The IPv6 network range to allow or deny, in CIDR notation.
+	IPv6CIDRBlock string `json:"ipv6CidrBlock,omitempty"`
+
+	// The range of ports the rule applies to. Required for the tcp and udp
+	// protocols.
+	PortRange *NetworkACLPortRange `json:"portRange,omitempty"`
+
+	// The ICMP type and code the rule applies to. Required for the icmp and
+	// icmpv6 protocols.
+	ICMPTypeCode *NetworkACLICMPTypeCode `json:"icmpTypeCode,omitempty"`
+}
+
+// NetworkACLAssociation describes an association between a network ACL and a
+// subnet.
+type NetworkACLAssociation struct {
+	// The ID of the subnet.
+	SubnetID string `json:"subnetId,omitempty"`
+
+	// A referencer to retrieve the ID of a subnet
+	SubnetIDRef *SubnetIDReferencerForNetworkACL `json:"subnetIdRef,omitempty" resource:"attributereferencer"`
+}
+
+// NetworkACLAssociationState describes an association state in the network
+// ACL.
+type NetworkACLAssociationState struct {
+	// The ID of the association between a network ACL and a subnet.
+	AssociationID string `json:"associationId"`
+
+	NetworkACLAssociation `json:",inline"`
+}
+
+// NetworkACLParameters define the desired state of an AWS VPC Network ACL.
+type NetworkACLParameters struct {
+	// VPCID is the ID of the VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references to a VPC to and retrieves its vpcId
+	VPCIDRef *VPCIDReferencerForNetworkACL `json:"vpcIdRef,omitempty" resource:"attributereferencer"`
+
+	// The inbound rules of the network ACL. Inbound traffic not matched by
+	// a rule is denied.
+	IngressEntries []NetworkACLEntry `json:"ingress,omitempty"`
+
+	// The outbound rules of the network ACL. Outbound traffic not matched by
+	// a rule is denied.
+	EgressEntries []NetworkACLEntry `json:"egress,omitempty"`
+
+	// The associations between the network ACL and one or more subnets. A
+	// subnet is associated with exactly one network ACL; subnets that are no
+	// longer associated with this network ACL are associated with the default
+	// network ACL of the VPC again.
+	Associations []NetworkACLAssociation `json:"associations,omitempty"`
+
+	// Tags to apply to the NetworkACL. Tags that are not specified here are
+	// left untouched. Tags that record the UID, name and namespace of the
+	// NetworkACL are added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the NetworkACL will be created. Defaults to the region of
+	// the referenced Provider. It cannot be changed after the NetworkACL is
+	// created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+}
+
+// A NetworkACLSpec defines the desired state of a NetworkACL.
+type NetworkACLSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	NetworkACLParameters         `json:",inline"`
+}
+
+// NetworkACLExternalStatus keeps the state for the external resource
+type NetworkACLExternalStatus struct {
+	// NetworkACLID is the ID of the network ACL.
+	NetworkACLID string `json:"networkAclId"`
+
+	// IsDefault indicates whether this is the default network ACL of the VPC.
+	IsDefault bool `json:"isDefault,omitempty"`
+
+	// The actual inbound rules of the network ACL, including its default rule.
+	IngressEntries []NetworkACLEntry `json:"ingress,omitempty"`
+
+	// The actual outbound rules of the network ACL, including its default
+	// rule.
+	EgressEntries []NetworkACLEntry `json:"egress,omitempty"`
+
+	// The actual associations created for the network ACL.
+	Associations []NetworkACLAssociationState `json:"associations,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A NetworkACLStatus represents the observed state of a NetworkACL.
+type NetworkACLStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	NetworkACLExternalStatus       `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A NetworkACL is a managed resource that represents an AWS VPC Network ACL.
+// +kubebuilder:printcolumn:name="ACLID",type="string",JSONPath=".status.networkAclId"
+// +kubebuilder:printcolumn:name="VPCID",type="string",JSONPath=".spec.vpcId"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+type NetworkACL struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkACLSpec   `json:"spec,omitempty"`
+	Status NetworkACLStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkACLList contains a list of NetworkACLs
+type NetworkACLList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkACL `json:"items"`
+}
+
+// UpdateExternalStatus updates the external status object, given the observation
+func (a *NetworkACL) UpdateExternalStatus(observation ec2.NetworkAcl) {
+	st := NetworkACLExternalStatus{
+		NetworkACLID:   aws.StringValue(observation.NetworkAclId),
+		IsDefault:      aws.BoolValue(observation.IsDefault),
+		IngressEntries: []NetworkACLEntry{},
+		EgressEntries:  []NetworkACLEntry{},
+		Tags:           BuildFromEC2Tags(observation.Tags),
+	}
+
+	for _, e := range observation.Entries {
+		entry := NetworkACLEntry{
+			RuleNumber:    aws.Int64Value(e.RuleNumber),
+			Protocol:      aws.StringValue(e.Protocol),
+			RuleAction:    string(e.RuleAction),
+			CIDRBlock:     aws.StringValue(e.CidrBlock),
+			IPv6CIDRBlock: aws.StringValue(e.Ipv6CidrBlock),
+		}
+		if e.PortRange != nil {
+			entry.PortRange = &NetworkACLPortRange{
+				From: aws.Int64Value(e.PortRange.From),
+				To:   aws.Int64Value(e.PortRange.To),
+			}
+		}
+		if e.IcmpTypeCode != nil {
+			entry.ICMPTypeCode = &NetworkACLICMPTypeCode{
+				Type: aws.Int64Value(e.IcmpTypeCode.Type),
+				Code: aws.Int64Value(e.IcmpTypeCode.Code),
+			}
+		}
+
+		if aws.BoolValue(e.Egress) {
+			st.EgressEntries = append(st.EgressEntries, entry)
+		} else {
+			st.IngressEntries = append(st.IngressEntries, entry)
+		}
+	}
+
+	st.Associations = make([]NetworkACLAssociationState, len(observation.Associations))
+	for i, asc := range observation.Associations {
+		st.Associations[i] = NetworkACLAssociationState{
+			AssociationID: aws.StringValue(asc.NetworkAclAssociationId),
+			NetworkACLAssociation: NetworkACLAssociation{
+				SubnetID: aws.StringValue(asc.SubnetId),
+			},
+		}
+	}
+
+	a.Status.NetworkACLExternalStatus = st
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+	"github.com/crossplaneio/crossplane-runtime/pkg/test"
+)
+
+var _ resource.AttributeReferencer = (*VPCIDReferencerForNetworkACL)(nil)
+var _ resource.AttributeReferencer = (*SubnetIDReferencerForNetworkACL)(nil)
+
+func TestVPCIDReferencerForNetworkACL_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &VPCIDReferencerForNetworkACL{}
+	expectedErr := errors.New(errResourceIsNotNetworkACL)
+
+	err := r.Assign(&mockCanReference{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestVPCIDReferencerForNetworkACL_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r := &VPCIDReferencerForNetworkACL{}
+	res := &NetworkACL{}
+	var expectedErr error
+
+	err := r.Assign(res, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.VPCID, "mockValue"); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
+
+func TestSubnetIDReferencerForNetworkACL_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &SubnetIDReferencerForNetworkACL{}
+	expectedErr := errors.New(errResourceIsNotNetworkACL)
+
+	err := r.Assign(&mockCanReference{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestSubnetIDReferencerForNetworkACL_AssociationWithSameNameNotExist_ReturnsErr(t *testing.T) {
+
+	r := &SubnetIDReferencerForNetworkACL{
+		SubnetIDReferencer: SubnetIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName1"},
+		},
+	}
+
+	res := &NetworkACL{
+		Spec: NetworkACLSpec{
+			NetworkACLParameters: NetworkACLParameters{
+				Associations: []NetworkACLAssociation{{SubnetID: "subnet-1"}},
+			},
+		},
+	}
+
+	expectedErr := errors.New(errNetworkACLAssociationNotFound)
+
+	err := r.Assign(res, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestSubnetIDReferencerForNetworkACL_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r1 := &SubnetIDReferencerForNetworkACL{
+		SubnetIDReferencer: SubnetIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName1"},
+		},
+	}
+
+	r2 := &SubnetIDReferencerForNetworkACL{
+		SubnetIDReferencer: SubnetIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName2"},
+		},
+	}
+
+	res := &NetworkACL{
+		Spec: NetworkACLSpec{
+			NetworkACLParameters: NetworkACLParameters{
+				Associations: []NetworkACLAssociation{{SubnetIDRef: r2}, {SubnetIDRef: r1}},
+			},
+		},
+	}
+
+	var expectedErr error
+
+	err := r1.Assign(res, "mockSubnetID")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.Associations[1].SubnetID, "mockSubnetID"); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
@@ -75,10 +75,66 @@ var (
 	RouteTableGroupVersionKind = SchemeGroupVersion.WithKind(RouteTableKind)
 )
 
+// ElasticIP type metadata.
+var (
+	ElasticIPKind             = reflect.TypeOf(ElasticIP{}).Name()
+	ElasticIPKindAPIVersion   = ElasticIPKind + "." + SchemeGroupVersion.String()
+	ElasticIPGroupVersionKind = SchemeGroupVersion.WithKind(ElasticIPKind)
+)
+
+// NATGateway type metadata.
+var (
+	NATGatewayKind             = reflect.TypeOf(NATGateway{}).Name()
+	NATGatewayKindAPIVersion   = NATGatewayKind + "." + SchemeGroupVersion.String()
+	NATGatewayGroupVersionKind = SchemeGroupVersion.WithKind(NATGatewayKind)
+)
+
+// VPCEndpoint type metadata.
+var (
+	VPCEndpointKind             = reflect.TypeOf(VPCEndpoint{}).Name()
+	VPCEndpointKindAPIVersion   = VPCEndpointKind + "." + SchemeGroupVersion.String()
+	VPCEndpointGroupVersionKind = SchemeGroupVersion.WithKind(VPCEndpointKind)
+)
+
+// VPCPeeringConnection type metadata.
+var (
+	VPCPeeringConnectionKind             = reflect.TypeOf(VPCPeeringConnection{}).Name()
+	VPCPeeringConnectionKindAPIVersion   = VPCPeeringConnectionKind + "." + SchemeGroupVersion.String()
+	VPCPeeringConnectionGroupVersionKind = SchemeGroupVersion.WithKind(VPCPeeringConnectionKind)
+)
+
+// TransitGateway type metadata.
+var (
+	TransitGatewayKind             = reflect.TypeOf(TransitGateway{}).Name()
+	TransitGatewayKindAPIVersion   = TransitGatewayKind + "." + SchemeGroupVersion.String()
+	TransitGatewayGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayKind)
+)
+
+// TransitGatewayVPCAttachment type metadata.
+var (
+	TransitGatewayVPCAttachmentKind             = reflect.TypeOf(TransitGatewayVPCAttachment{}).Name()
+	TransitGatewayVPCAttachmentKindAPIVersion   = TransitGatewayVPCAttachmentKind + "." + SchemeGroupVersion.String()
+	TransitGatewayVPCAttachmentGroupVersionKind = SchemeGroupVersion.WithKind(TransitGatewayVPCAttachmentKind)
+)
+
+// NetworkACL type metadata.
+var (
+	NetworkACLKind             = reflect.TypeOf(NetworkACL{}).Name()
+	NetworkACLKindAPIVersion   = NetworkACLKind + "." + SchemeGroupVersion.String()
+	NetworkACLGroupVersionKind = SchemeGroupVersion.WithKind(NetworkACLKind)
+)
+
 func init() {
 	SchemeBuilder.Register(&VPC{}, &VPCList{})
 	SchemeBuilder.Register(&Subnet{}, &SubnetList{})
 	SchemeBuilder.Register(&SecurityGroup{}, &SecurityGroupList{})
 	SchemeBuilder.Register(&InternetGateway{}, &InternetGatewayList{})
 	SchemeBuilder.Register(&RouteTable{}, &RouteTableList{})
+	SchemeBuilder.Register(&ElasticIP{}, &ElasticIPList{})
+	SchemeBuilder.Register(&NATGateway{}, &NATGatewayList{})
+	SchemeBuilder.Register(&VPCEndpoint{}, &VPCEndpointList{})
+	SchemeBuilder.Register(&VPCPeeringConnection{}, &VPCPeeringConnectionList{})
+	SchemeBuilder.Register(&TransitGateway{}, &TransitGatewayList{})
+	SchemeBuilder.Register(&TransitGatewayVPCAttachment{}, &TransitGatewayVPCAttachmentList{})
+	SchemeBuilder.Register(&NetworkACL{}, &NetworkACLList{})
 }
@@ -86,7 +86,7 @@ func (v *InternetGatewayIDReferencerForRouteTable) Assign(res resource.CanRefere
 
 	// find the route that this field belongs to, and assign its gatewayID
 	for i := 0; i < len(rt.Spec.Routes); i++ {
-		if rt.Spec.Routes[i].GatewayIDRef.Name == v.Name {
+		if rt.Spec.Routes[i].GatewayIDRef != nil && rt.Spec.Routes[i].GatewayIDRef.Name == v.Name {
 			rt.Spec.Routes[i].GatewayID = value
 			return nil
 		}
@@ -95,11 +95,108 @@ func (v *InternetGatewayIDReferencerForRouteTable) Assign(res resource.CanRefere
 	return errors.New(errRouteNotFound)
 }
 
-// Route describes a route in a route table.
+// NATGatewayIDReferencerForRouteTable is an attribute referencer that resolves NATGatewayID from a referenced NATGateway
+type NATGatewayIDReferencerForRouteTable struct {
+	NATGatewayIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved value to the managed resource
+func (v *NATGatewayIDReferencerForRouteTable) Assign(res resource.CanReference, value string) error {
+	rt, ok := res.(*RouteTable)
+	if !ok {
+		return errors.New(errResourceIsNotRouteTable)
+	}
+
+	// find the route that this field belongs to, and assign its natGatewayID
+	for i := 0; i < len(rt.Spec.Routes); i++ {
+		if rt.Spec.Routes[i].NATGatewayIDRef != nil && rt.Spec.Routes[i].NATGatewayIDRef.Name == v.Name {
+			rt.Spec.Routes[i].NATGatewayID = value
+			return nil
+		}
+	}
+
+	return errors.New(errRouteNotFound)
+}
+
+// VPCPeeringConnectionIDReferencerForRouteTable is an attribute referencer that resolves VPCPeeringConnectionID from a referenced VPCPeeringConnection
+type VPCPeeringConnectionIDReferencerForRouteTable struct {
+	VPCPeeringConnectionIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved value to the managed resource
+func (v *VPCPeeringConnectionIDReferencerForRouteTable) Assign(res resource.CanReference, value string) error {
+	rt, ok := res.(*RouteTable)
+	if !ok {
+		return errors.New(errResourceIsNotRouteTable)
+	}
+
+	// find the route that this field belongs to, and assign its vpcPeeringConnectionID
+	for i := 0; i < len(rt.Spec.Routes); i++ {
+		if rt.Spec.Routes[i].VPCPeeringConnectionIDRef != nil && rt.Spec.Routes[i].VPCPeeringConnectionIDRef.Name == v.Name {
+			rt.Spec.Routes[i].VPCPeeringConnectionID = value
+			return nil
+		}
+	}
+
+	return errors.New(errRouteNotFound)
+}
+
+// TransitGatewayIDReferencerForRouteTable is an attribute referencer that resolves TransitGatewayID from a referenced TransitGateway
+type TransitGatewayIDReferencerForRouteTable struct {
+	TransitGatewayIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved value to the managed resource
+func (v *TransitGatewayIDReferencerForRouteTable) Assign(res resource.CanReference, value string) error {
+	rt, ok := res.(*RouteTable)
+	if !ok {
+		return errors.New(errResourceIsNotRouteTable)
+	}
+
+	// find the route that this field belongs to, and assign its transitGatewayID
+	for i := 0; i < len(rt.Spec.Routes); i++ {
+		if rt.Spec.Routes[i].TransitGatewayIDRef != nil && rt.Spec.Routes[i].TransitGatewayIDRef.Name == v.Name {
+			rt.Spec.Routes[i].TransitGatewayID = value
+			return nil
+		}
+	}
+
+	return errors.New(errRouteNotFound)
+}
+
+// VPCEndpointIDReferencerForRouteTable is an attribute referencer that resolves VPCEndpointID from a referenced VPCEndpoint
+type VPCEndpointIDReferencerForRouteTable struct {
+	VPCEndpointIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved value to the managed resource
+func (v *VPCEndpointIDReferencerForRouteTable) Assign(res resource.CanReference, value string) error {
+	rt, ok := res.(*RouteTable)
+	if !ok {
+		return errors.New(errResourceIsNotRouteTable)
+	}
+
+	// find the route that this field belongs to, and assign its vpcEndpointID
+	for i := 0; i < len(rt.Spec.Routes); i++ {
+		if rt.Spec.Routes[i].VPCEndpointIDRef != nil && rt.Spec.Routes[i].VPCEndpointIDRef.Name == v.Name {
+			rt.Spec.Routes[i].VPCEndpointID = value
+			return nil
+		}
+	}
+
+	return errors.New(errRouteNotFound)
+}
+
+// Route describes a route in a route table. A route has exactly one
+// destination and one target.
 type Route struct {
 	// The IPv4 CIDR address block used for the destination match. Routing
 	// decisions are based on the most specific match.
-	DestinationCIDRBlock string `json:"destinationCidrBlock"`
+	DestinationCIDRBlock string `json:"destinationCidrBlock,omitempty"`
+
+	// The IPv6 CIDR address block used for the destination match. Routing
+	// decisions are based on the most specific match.
+	DestinationIPv6CIDRBlock string `json:"destinationIpv6CidrBlock,omitempty"`
 
 	// The ID of an internet gateway or virtual private gateway attached to your
 	// VPC.
@@ -107,6 +204,37 @@ type Route struct {
 
 	// A referencer to retrieve the ID of a gateway
 	GatewayIDRef *InternetGatewayIDReferencerForRouteTable `json:"gatewayIdRef,omitempty" resource:"attributereferencer"`
+
+	// [IPv6 traffic only] The ID of an egress-only internet gateway.
+	EgressOnlyInternetGatewayID string `json:"egressOnlyInternetGatewayId,omitempty"`
+
+	// [IPv4 traffic only] The ID of a NAT gateway.
+	NATGatewayID string `json:"natGatewayId,omitempty"`
+
+	// NATGatewayIDRef references to a NATGateway to retrieve its natGatewayId
+	NATGatewayIDRef *NATGatewayIDReferencerForRouteTable `json:"natGatewayIdRef,omitempty" resource:"attributereferencer"`
+
+	// The ID of a network interface.
+	NetworkInterfaceID string `json:"networkInterfaceId,omitempty"`
+
+	// The ID of a transit gateway.
+	TransitGatewayID string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef references to a TransitGateway to retrieve its transitGatewayId
+	TransitGatewayIDRef *TransitGatewayIDReferencerForRouteTable `json:"transitGatewayIdRef,omitempty" resource:"attributereferencer"`
+
+	// The ID of a VPC endpoint. Supported for Gateway Load Balancer endpoints
+	// only; the routes of gateway endpoints are managed by the endpoint.
+	VPCEndpointID string `json:"vpcEndpointId,omitempty"`
+
+	// VPCEndpointIDRef references to a VPCEndpoint to retrieve its vpcEndpointId
+	VPCEndpointIDRef *VPCEndpointIDReferencerForRouteTable `json:"vpcEndpointIdRef,omitempty" resource:"attributereferencer"`
+
+	// The ID of a VPC peering connection.
+	VPCPeeringConnectionID string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// VPCPeeringConnectionIDRef references to a VPCPeeringConnection to retrieve its vpcPeeringConnectionId
+	VPCPeeringConnectionIDRef *VPCPeeringConnectionIDReferencerForRouteTable `json:"vpcPeeringConnectionIdRef,omitempty" resource:"attributereferencer"`
 }
 
 // RouteState describes a route state in the route table.
@@ -116,6 +244,15 @@ type RouteState struct {
 	// to the VPC, or the specified NAT instance has been terminated).
 	RouteState string `json:"routeState,omitempty"`
 
+	// Describes how the route was created: CreateRouteTable for the local
+	// route, CreateRoute for routes created by a CreateRoute call and
+	// EnableVgwRoutePropagation for propagated routes.
+	Origin string `json:"origin,omitempty"`
+
+	// The prefix list used for the destination match, for the routes that
+	// gateway VPC endpoints add.
+	DestinationPrefixListID string `json:"destinationPrefixListId,omitempty"`
+
 	Route `json:",inline"`
 }
 
@@ -153,6 +290,19 @@ type RouteTableParameters struct {
 
 	// The associations between the route table and one or more subnets.
 	Associations []Association `json:"associations,omitempty"`
+
+	// Tags to apply to the RouteTable. Tags that are not specified here are
+	// left untouched. Tags that record the UID, name and namespace of the
+	// RouteTable are added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the RouteTable will be created. Defaults to the region of
+	// the referenced Provider. It cannot be changed after the RouteTable is
+	// created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
 }
 
 // A RouteTableSpec defines the desired state of a RouteTable.
@@ -172,6 +322,9 @@ type RouteTableExternalStatus struct {
 
 	// The actual associations created for the route table.
 	Associations []AssociationState `json:"associations,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	Tags []Tag `json:"tags,omitempty"`
 }
 
 // A RouteTableStatus represents the observed state of a RouteTable.
@@ -211,15 +364,23 @@ func (t *RouteTable) UpdateExternalStatus(observation ec2.RouteTable) {
 		RouteTableID: aws.StringValue(observation.RouteTableId),
 		Routes:       []RouteState{},
 		Associations: []AssociationState{},
+		Tags:         BuildFromEC2Tags(observation.Tags),
 	}
 
 	st.Routes = make([]RouteState, len(observation.Routes))
 	for i, rt := range observation.Routes {
 		st.Routes[i] = RouteState{
-			RouteState: string(rt.State),
+			RouteState:              string(rt.State),
+			Origin:                  string(rt.Origin),
+			DestinationPrefixListID: aws.StringValue(rt.DestinationPrefixListId),
 			Route: Route{
-				DestinationCIDRBlock: aws.StringValue(rt.DestinationCidrBlock),
-				GatewayID:            aws.StringValue(rt.GatewayId),
+				DestinationCIDRBlock:        aws.StringValue(rt.DestinationCidrBlock),
+				DestinationIPv6CIDRBlock:    aws.StringValue(rt.DestinationIpv6CidrBlock),
+				GatewayID:                   aws.StringValue(rt.GatewayId),
+				EgressOnlyInternetGatewayID: aws.StringValue(rt.EgressOnlyInternetGatewayId),
+				NATGatewayID:                aws.StringValue(rt.NatGatewayId),
+				NetworkInterfaceID:          aws.StringValue(rt.NetworkInterfaceId),
+				VPCPeeringConnectionID:      aws.StringValue(rt.VpcPeeringConnectionId),
 			},
 		}
 	}
@@ -30,6 +30,10 @@ import (
 var _ resource.AttributeReferencer = (*VPCIDReferencerForRouteTable)(nil)
 var _ resource.AttributeReferencer = (*SubnetIDReferencerForRouteTable)(nil)
 var _ resource.AttributeReferencer = (*InternetGatewayIDReferencerForRouteTable)(nil)
+var _ resource.AttributeReferencer = (*NATGatewayIDReferencerForRouteTable)(nil)
+var _ resource.AttributeReferencer = (*VPCPeeringConnectionIDReferencerForRouteTable)(nil)
+var _ resource.AttributeReferencer = (*TransitGatewayIDReferencerForRouteTable)(nil)
+var _ resource.AttributeReferencer = (*VPCEndpointIDReferencerForRouteTable)(nil)
 
 func TestVPCIDReferencerForRouteTable_AssignInvalidType_ReturnsErr(t *testing.T) {
 
@@ -179,3 +183,247 @@ func TestInternetGatewayIDReferencerForRouteTable_AssignValidType_ReturnsExpecte
 		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
 	}
 }
+
+func TestNATGatewayIDReferencerForRouteTable_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &NATGatewayIDReferencerForRouteTable{}
+	expectedErr := errors.New(errResourceIsNotRouteTable)
+
+	err := r.Assign(&mockCanReference{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestNATGatewayIDReferencerForRouteTable_RouteWithSameNameNotExist_ReturnsErr(t *testing.T) {
+
+	r := &NATGatewayIDReferencerForRouteTable{
+		NATGatewayIDReferencer: NATGatewayIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName1"},
+		},
+	}
+
+	expectedErr := errors.New(errRouteNotFound)
+
+	err := r.Assign(&RouteTable{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestNATGatewayIDReferencerForRouteTable_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r1 := &NATGatewayIDReferencerForRouteTable{
+		NATGatewayIDReferencer: NATGatewayIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName1"},
+		},
+	}
+
+	r2 := &InternetGatewayIDReferencerForRouteTable{
+		InternetGatewayIDReferencer: InternetGatewayIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName2"},
+		},
+	}
+
+	res := &RouteTable{
+		Spec: RouteTableSpec{
+			RouteTableParameters: RouteTableParameters{
+				Routes: []Route{{GatewayIDRef: r2}, {NATGatewayIDRef: r1}},
+			},
+		},
+	}
+
+	var expectedErr error
+
+	err := r1.Assign(res, "mockNATGatewayID")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.Routes[1].NATGatewayID, "mockNATGatewayID"); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
+
+func TestVPCPeeringConnectionIDReferencerForRouteTable_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &VPCPeeringConnectionIDReferencerForRouteTable{}
+	expectedErr := errors.New(errResourceIsNotRouteTable)
+
+	err := r.Assign(&mockCanReference{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestVPCPeeringConnectionIDReferencerForRouteTable_RouteWithSameNameNotExist_ReturnsErr(t *testing.T) {
+
+	r := &VPCPeeringConnectionIDReferencerForRouteTable{
+		VPCPeeringConnectionIDReferencer: VPCPeeringConnectionIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName1"},
+		},
+	}
+
+	expectedErr := errors.New(errRouteNotFound)
+
+	err := r.Assign(&RouteTable{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestVPCPeeringConnectionIDReferencerForRouteTable_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r1 := &VPCPeeringConnectionIDReferencerForRouteTable{
+		VPCPeeringConnectionIDReferencer: VPCPeeringConnectionIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName1"},
+		},
+	}
+
+	r2 := &InternetGatewayIDReferencerForRouteTable{
+		InternetGatewayIDReferencer: InternetGatewayIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName2"},
+		},
+	}
+
+	res := &RouteTable{
+		Spec: RouteTableSpec{
+			RouteTableParameters: RouteTableParameters{
+				Routes: []Route{{GatewayIDRef: r2}, {VPCPeeringConnectionIDRef: r1}},
+			},
+		},
+	}
+
+	var expectedErr error
+
+	err := r1.Assign(res, "mockVPCPeeringConnectionID")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.Routes[1].VPCPeeringConnectionID, "mockVPCPeeringConnectionID"); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
+
+func TestTransitGatewayIDReferencerForRouteTable_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &TransitGatewayIDReferencerForRouteTable{}
+	expectedErr := errors.New(errResourceIsNotRouteTable)
+
+	err := r.Assign(&mockCanReference{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestTransitGatewayIDReferencerForRouteTable_RouteWithSameNameNotExist_ReturnsErr(t *testing.T) {
+
+	r := &TransitGatewayIDReferencerForRouteTable{
+		TransitGatewayIDReferencer: TransitGatewayIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName1"},
+		},
+	}
+
+	expectedErr := errors.New(errRouteNotFound)
+
+	err := r.Assign(&RouteTable{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestTransitGatewayIDReferencerForRouteTable_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r1 := &TransitGatewayIDReferencerForRouteTable{
+		TransitGatewayIDReferencer: TransitGatewayIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName1"},
+		},
+	}
+
+	r2 := &InternetGatewayIDReferencerForRouteTable{
+		InternetGatewayIDReferencer: InternetGatewayIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName2"},
+		},
+	}
+
+	res := &RouteTable{
+		Spec: RouteTableSpec{
+			RouteTableParameters: RouteTableParameters{
+				Routes: []Route{{GatewayIDRef: r2}, {TransitGatewayIDRef: r1}},
+			},
+		},
+	}
+
+	var expectedErr error
+
+	err := r1.Assign(res, "mockTransitGatewayID")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.Routes[1].TransitGatewayID, "mockTransitGatewayID"); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
+
+func TestVPCEndpointIDReferencerForRouteTable_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &VPCEndpointIDReferencerForRouteTable{}
+	expectedErr := errors.New(errResourceIsNotRouteTable)
+
+	err := r.Assign(&mockCanReference{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestVPCEndpointIDReferencerForRouteTable_RouteWithSameNameNotExist_ReturnsErr(t *testing.T) {
+
+	r := &VPCEndpointIDReferencerForRouteTable{
+		VPCEndpointIDReferencer: VPCEndpointIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName1"},
+		},
+	}
+
+	expectedErr := errors.New(errRouteNotFound)
+
+	err := r.Assign(&RouteTable{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestVPCEndpointIDReferencerForRouteTable_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r1 := &VPCEndpointIDReferencerForRouteTable{
+		VPCEndpointIDReferencer: VPCEndpointIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName1"},
+		},
+	}
+
+	r2 := &InternetGatewayIDReferencerForRouteTable{
+		InternetGatewayIDReferencer: InternetGatewayIDReferencer{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "mockObjectName2"},
+		},
+	}
+
+	res := &RouteTable{
+		Spec: RouteTableSpec{
+			RouteTableParameters: RouteTableParameters{
+				Routes: []Route{{GatewayIDRef: r2}, {VPCEndpointIDRef: r1}},
+			},
+		},
+	}
+
+	var expectedErr error
+
+	err := r1.Assign(res, "mockVPCEndpointID")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.Routes[1].VPCEndpointID, "mockVPCEndpointID"); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
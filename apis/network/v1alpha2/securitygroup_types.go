@@ -22,7 +22,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/pkg/errors"
 
-	aws "github.com/crossplaneio/stack-aws/pkg/clients"
+	awsclients "github.com/crossplaneio/stack-aws/pkg/clients"
 
 	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
@@ -31,6 +31,7 @@ import (
 // Error strings
 const (
 	errResourceIsNotSecurityGroup = "The managed resource is not a SecurityGroup"
+	errUserIDGroupPairNotFound    = "Could not find a security group rule source with the referred object name"
 )
 
 // VPCIDReferencerForSecurityGroup is an attribute referencer that resolves VPCID from a referenced VPC
@@ -49,6 +50,40 @@ func (v *VPCIDReferencerForSecurityGroup) Assign(res resource.CanReference, valu
 	return nil
 }
 
+// SecurityGroupIDReferencerForSecurityGroupRule is an attribute referencer that
+// resolves the ID of a security group that is the source or destination of a
+// security group rule
+type SecurityGroupIDReferencerForSecurityGroupRule struct {
+	SecurityGroupIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved groupId to the managed resource
+func (v *SecurityGroupIDReferencerForSecurityGroupRule) Assign(res resource.CanReference, value string) error {
+	sg, ok := res.(*SecurityGroup)
+	if !ok {
+		return errors.New(errResourceIsNotSecurityGroup)
+	}
+
+	// find the rule sources that this field belongs to, and assign their groupID
+	found := false
+	for _, perms := range [][]IPPermission{sg.Spec.IngressPermissions, sg.Spec.EgressPermissions} {
+		for i := range perms {
+			for j := range perms[i].UserIDGroupPairs {
+				pair := &perms[i].UserIDGroupPairs[j]
+				if pair.GroupIDRef != nil && pair.GroupIDRef.Name == v.Name {
+					pair.GroupID = value
+					found = true
+				}
+			}
+		}
+	}
+
+	if !found {
+		return errors.New(errUserIDGroupPairNotFound)
+	}
+	return nil
+}
+
 // IPRange describes an IPv4 range.
 type IPRange struct {
 	// The IPv4 CIDR range. You can either specify a CIDR range or a source
@@ -60,6 +95,46 @@ type IPRange struct {
 	Description string `json:"description,omitempty"`
 }
 
+// IPv6Range describes an IPv6 range.
+type IPv6Range struct {
+	// The IPv6 CIDR range. To specify a single IPv6 address, use the /128
+	// prefix length.
+	CIDRIPv6 string `json:"cidrIpv6"`
+
+	// A description for the ip range
+	Description string `json:"description,omitempty"`
+}
+
+// PrefixListID describes a prefix list, e.g. of an AWS service.
+type PrefixListID struct {
+	// The ID of the prefix list.
+	PrefixListID string `json:"prefixListId"`
+
+	// A description for the prefix list
+	Description string `json:"description,omitempty"`
+}
+
+// UserIDGroupPair describes a security group that is the source of an inbound
+// rule, or the destination of an outbound rule.
+type UserIDGroupPair struct {
+	// The ID of the security group.
+	GroupID string `json:"groupId,omitempty"`
+
+	// A referencer to retrieve the ID of a security group
+	GroupIDRef *SecurityGroupIDReferencerForSecurityGroupRule `json:"groupIdRef,omitempty" resource:"attributereferencer"`
+
+	// Self refers to the security group the rule belongs to. When true,
+	// GroupID and GroupIDRef are ignored.
+	Self bool `json:"self,omitempty"`
+
+	// The ID of the AWS account that owns the security group, if it is not
+	// owned by the account of the provider. Used for peered VPCs.
+	UserID string `json:"userId,omitempty"`
+
+	// A description for the security group rule source
+	Description string `json:"description,omitempty"`
+}
+
 // IPPermission Describes a set of permissions for a security group rule.
 type IPPermission struct {
 	// The start of port range for the TCP and UDP protocols, or an ICMP/ICMPv6
@@ -85,6 +160,15 @@ type IPPermission struct {
 
 	// One or more IPv4 ranges.
 	CIDRBlocks []IPRange `json:"cidrBlocks,omitempty"`
+
+	// One or more IPv6 ranges.
+	IPv6CIDRBlocks []IPv6Range `json:"ipv6CidrBlocks,omitempty"`
+
+	// One or more prefix lists, e.g. of VPC endpoints.
+	PrefixListIDs []PrefixListID `json:"prefixListIds,omitempty"`
+
+	// One or more security groups.
+	UserIDGroupPairs []UserIDGroupPair `json:"userIdGroupPairs,omitempty"`
 }
 
 // SecurityGroupParameters define the desired state of an AWS VPC Security
@@ -106,7 +190,23 @@ type SecurityGroupParameters struct {
 	IngressPermissions []IPPermission `json:"ingress,omitempty"`
 
 	// [EC2-VPC] One or more outbound rules associated with the security group.
+	// The default outbound rule created by EC2 is left untouched unless at
+	// least one outbound rule is specified. Once outbound rules have been
+	// specified, removing all of them restores the default outbound rule.
 	EgressPermissions []IPPermission `json:"egress,omitempty"`
+
+	// Tags to apply to the SecurityGroup. Tags that are not specified here are
+	// left untouched. Tags that record the UID, name and namespace of the
+	// SecurityGroup are added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the SecurityGroup will be created. Defaults to the region of
+	// the referenced Provider. It cannot be changed after the SecurityGroup is
+	// created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
 }
 
 // A SecurityGroupSpec defines the desired state of a SecurityGroup.
@@ -128,6 +228,10 @@ type SecurityGroupExternalStatus struct {
 type SecurityGroupStatus struct {
 	runtimev1alpha1.ResourceStatus `json:",inline"`
 	SecurityGroupExternalStatus    `json:",inline"`
+
+	// EgressManaged is true once outbound rules have been specified for the
+	// SecurityGroup, after which its outbound rules are always reconciled.
+	EgressManaged bool `json:"egressManaged,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -161,27 +265,57 @@ type SecurityGroupList struct {
 // UpdateExternalStatus updates the external status object, given the observation
 func (s *SecurityGroup) UpdateExternalStatus(observation ec2.SecurityGroup) {
 	s.Status.SecurityGroupExternalStatus = SecurityGroupExternalStatus{
-		SecurityGroupID: aws.StringValue(observation.GroupId),
+		SecurityGroupID: awsclients.StringValue(observation.GroupId),
 		Tags:            BuildFromEC2Tags(observation.Tags),
 	}
 }
 
-// BuildEC2Permissions converts object Permissions to ec2 format
-func BuildEC2Permissions(objectPerms []IPPermission) []ec2.IpPermission {
+// BuildEC2Permissions converts object Permissions to ec2 format. Security
+// group sources that refer to their own group resolve to the supplied groupID.
+func BuildEC2Permissions(objectPerms []IPPermission, groupID string) []ec2.IpPermission {
 	permissions := make([]ec2.IpPermission, len(objectPerms))
 	for i, p := range objectPerms {
 
 		ipPerm := ec2.IpPermission{
-			FromPort:   aws.Int64(int(p.FromPort)),
-			ToPort:     aws.Int64(int(p.ToPort)),
-			IpProtocol: aws.String(p.IPProtocol),
+			FromPort:   awsclients.Int64(int(p.FromPort), awsclients.FieldRequired),
+			ToPort:     awsclients.Int64(int(p.ToPort), awsclients.FieldRequired),
+			IpProtocol: awsclients.String(p.IPProtocol, awsclients.FieldRequired),
 		}
 
 		ipPerm.IpRanges = make([]ec2.IpRange, len(p.CIDRBlocks))
 		for j, c := range p.CIDRBlocks {
 			ipPerm.IpRanges[j] = ec2.IpRange{
-				CidrIp:      aws.String(c.CIDRIP),
-				Description: aws.String(c.Description),
+				CidrIp:      awsclients.String(c.CIDRIP),
+				Description: awsclients.String(c.Description),
+			}
+		}
+
+		ipPerm.Ipv6Ranges = make([]ec2.Ipv6Range, len(p.IPv6CIDRBlocks))
+		for j, c := range p.IPv6CIDRBlocks {
+			ipPerm.Ipv6Ranges[j] = ec2.Ipv6Range{
+				CidrIpv6:    awsclients.String(c.CIDRIPv6),
+				Description: awsclients.String(c.Description),
+			}
+		}
+
+		ipPerm.PrefixListIds = make([]ec2.PrefixListId, len(p.PrefixListIDs))
+		for j, l := range p.PrefixListIDs {
+			ipPerm.PrefixListIds[j] = ec2.PrefixListId{
+				PrefixListId: awsclients.String(l.PrefixListID),
+				Description:  awsclients.String(l.Description),
+			}
+		}
+
+		ipPerm.UserIdGroupPairs = make([]ec2.UserIdGroupPair, len(p.UserIDGroupPairs))
+		for j, g := range p.UserIDGroupPairs {
+			id := g.GroupID
+			if g.Self {
+				id = groupID
+			}
+			ipPerm.UserIdGroupPairs[j] = ec2.UserIdGroupPair{
+				GroupId:     awsclients.String(id),
+				UserId:      awsclients.String(g.UserID),
+				Description: awsclients.String(g.Description),
 			}
 		}
 
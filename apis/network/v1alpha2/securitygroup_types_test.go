@@ -27,6 +27,7 @@ import (
 )
 
 var _ resource.AttributeReferencer = (*VPCIDReferencerForSecurityGroup)(nil)
+var _ resource.AttributeReferencer = (*SecurityGroupIDReferencerForSecurityGroupRule)(nil)
 
 func TestVPCIDReferencerForSecurityGroup_AssignInvalidType_ReturnsErr(t *testing.T) {
 
@@ -54,3 +55,65 @@ func TestVPCIDReferencerForSecurityGroup_AssignValidType_ReturnsExpected(t *test
 		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
 	}
 }
+
+func TestSecurityGroupIDReferencerForSecurityGroupRule_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &SecurityGroupIDReferencerForSecurityGroupRule{}
+	expectedErr := errors.New(errResourceIsNotSecurityGroup)
+
+	err := r.Assign(&mockCanReference{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestSecurityGroupIDReferencerForSecurityGroupRule_AssignNotFound_ReturnsErr(t *testing.T) {
+
+	r := &SecurityGroupIDReferencerForSecurityGroupRule{}
+	r.Name = "mockName"
+	expectedErr := errors.New(errUserIDGroupPairNotFound)
+
+	err := r.Assign(&SecurityGroup{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestSecurityGroupIDReferencerForSecurityGroupRule_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r := &SecurityGroupIDReferencerForSecurityGroupRule{}
+	r.Name = "mockName"
+	ref := func(name string) *SecurityGroupIDReferencerForSecurityGroupRule {
+		r := &SecurityGroupIDReferencerForSecurityGroupRule{}
+		r.Name = name
+		return r
+	}
+	res := &SecurityGroup{
+		Spec: SecurityGroupSpec{
+			SecurityGroupParameters: SecurityGroupParameters{
+				IngressPermissions: []IPPermission{
+					{UserIDGroupPairs: []UserIDGroupPair{{GroupIDRef: ref("otherName")}, {GroupIDRef: ref("mockName")}}},
+				},
+				EgressPermissions: []IPPermission{
+					{UserIDGroupPairs: []UserIDGroupPair{{GroupIDRef: ref("mockName")}, {Self: true}}},
+				},
+			},
+		},
+	}
+	var expectedErr error
+
+	err := r.Assign(res, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	got := []string{
+		res.Spec.IngressPermissions[0].UserIDGroupPairs[0].GroupID,
+		res.Spec.IngressPermissions[0].UserIDGroupPairs[1].GroupID,
+		res.Spec.EgressPermissions[0].UserIDGroupPairs[0].GroupID,
+		res.Spec.EgressPermissions[0].UserIDGroupPairs[1].GroupID,
+	}
+	if diff := cmp.Diff([]string{"", "mockValue", "mockValue", ""}, got); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
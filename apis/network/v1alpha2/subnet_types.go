@@ -56,13 +56,52 @@ type SubnetParameters struct {
 	// The Availability Zone for the subnet.
 	// Default: AWS selects one for you. If you create more than one subnet in your
 	// VPC, we may not necessarily select a different zone for each subnet.
-	AvailabilityZone string `json:"availabilityZone"`
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// AvailabilityZoneID is the ID of the Availability Zone for the Subnet,
+	// for example use1-az1. Unlike its name, the ID of an Availability Zone
+	// refers to the same location in every AWS account. It is ignored if
+	// AvailabilityZone is set.
+	// +optional
+	AvailabilityZoneID string `json:"availabilityZoneId,omitempty"`
+
+	// IPv6CIDRBlock is the IPv6 network range for the Subnet, in CIDR
+	// notation. It must be a /64 range of the IPv6 CIDR block of the VPC.
+	// Defaults to the IPv6 CIDR block of the Subnet when omitted. An empty
+	// string removes the IPv6 CIDR block from the Subnet.
+	// +optional
+	IPv6CIDRBlock *string `json:"ipv6CidrBlock,omitempty"`
+
+	// MapPublicIPOnLaunch indicates whether instances launched in the Subnet
+	// receive a public IPv4 address. Defaults to the value of the Subnet when
+	// omitted.
+	// +optional
+	MapPublicIPOnLaunch *bool `json:"mapPublicIpOnLaunch,omitempty"`
+
+	// AssignIPv6AddressOnCreation indicates whether network interfaces created
+	// in the Subnet receive an IPv6 address. Defaults to the value of the
+	// Subnet when omitted.
+	// +optional
+	AssignIPv6AddressOnCreation *bool `json:"assignIpv6AddressOnCreation,omitempty"`
 
 	// VPCID is the ID of the VPC.
 	VPCID string `json:"vpcId,omitempty"`
 
 	// VPCIDRef references to a VPC to and retrieves its vpcId
 	VPCIDRef *VPCIDReferencerForSubnet `json:"vpcIdRef,omitempty" resource:"attributereferencer"`
+
+	// Tags to apply to the Subnet. Tags that are not specified here are left
+	// untouched. Tags that record the UID, name and namespace of the Subnet are
+	// added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the Subnet will be created. Defaults to the region of the
+	// referenced Provider. It cannot be changed after the Subnet is created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
 }
 
 // A SubnetSpec defines the desired state of a Subnet.
@@ -82,6 +121,21 @@ type SubnetExternalStatus struct {
 
 	// SubnetID is the ID of the Subnet.
 	SubnetID string `json:"subnetId,omitempty"`
+
+	// AvailabilityZone of the Subnet.
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// IPv6CIDRBlockAssociations are the IPv6 CIDR blocks associated with the
+	// Subnet.
+	IPv6CIDRBlockAssociations []CIDRBlockAssociation `json:"ipv6CidrBlockAssociations,omitempty"`
+
+	// MapPublicIPOnLaunch indicates whether instances launched in the Subnet
+	// receive a public IPv4 address.
+	MapPublicIPOnLaunch bool `json:"mapPublicIpOnLaunch,omitempty"`
+
+	// AssignIPv6AddressOnCreation indicates whether network interfaces created
+	// in the Subnet receive an IPv6 address.
+	AssignIPv6AddressOnCreation bool `json:"assignIpv6AddressOnCreation,omitempty"`
 }
 
 // A SubnetStatus represents the observed state of a Subnet.
@@ -96,7 +150,7 @@ type SubnetStatus struct {
 // +kubebuilder:printcolumn:name="SUBNETID",type="string",JSONPath=".status.subnetId"
 // +kubebuilder:printcolumn:name="VPCID",type="string",JSONPath=".spec.vpcId"
 // +kubebuilder:printcolumn:name="CIDRBLOCK",type="string",JSONPath=".spec.cidrBlock"
-// +kubebuilder:printcolumn:name="AZ",type="string",JSONPath=".spec.availabilityZone"
+// +kubebuilder:printcolumn:name="AZ",type="string",JSONPath=".status.availabilityZone"
 // +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.subnetState"
 // +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
@@ -122,8 +176,22 @@ type SubnetList struct {
 // UpdateExternalStatus updates the external status object,  given the observation
 func (s *Subnet) UpdateExternalStatus(observation ec2.Subnet) {
 	s.Status.SubnetExternalStatus = SubnetExternalStatus{
-		SubnetID:    aws.StringValue(observation.SubnetId),
-		Tags:        BuildFromEC2Tags(observation.Tags),
-		SubnetState: string(observation.State),
+		SubnetID:                    aws.StringValue(observation.SubnetId),
+		Tags:                        BuildFromEC2Tags(observation.Tags),
+		SubnetState:                 string(observation.State),
+		AvailabilityZone:            aws.StringValue(observation.AvailabilityZone),
+		MapPublicIPOnLaunch:         aws.BoolValue(observation.MapPublicIpOnLaunch),
+		AssignIPv6AddressOnCreation: aws.BoolValue(observation.AssignIpv6AddressOnCreation),
+	}
+
+	for _, a := range observation.Ipv6CidrBlockAssociationSet {
+		ca := CIDRBlockAssociation{
+			AssociationID: aws.StringValue(a.AssociationId),
+			CIDRBlock:     aws.StringValue(a.Ipv6CidrBlock),
+		}
+		if a.Ipv6CidrBlockState != nil {
+			ca.State = string(a.Ipv6CidrBlockState.State)
+		}
+		s.Status.IPv6CIDRBlockAssociations = append(s.Status.IPv6CIDRBlockAssociations, ca)
 	}
 }
@@ -0,0 +1,142 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// TransitGatewayParameters define the desired state of an AWS Transit
+// Gateway.
+type TransitGatewayParameters struct {
+	// Description of the transit gateway.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// AmazonSideASN is the private Autonomous System Number (ASN) for the
+	// Amazon side of a BGP session. Defaults to 64512.
+	// +optional
+	AmazonSideASN *int64 `json:"amazonSideAsn,omitempty"`
+
+	// AutoAcceptSharedAttachments indicates whether attachment requests from
+	// other accounts are automatically accepted. Defaults to false.
+	// +optional
+	AutoAcceptSharedAttachments *bool `json:"autoAcceptSharedAttachments,omitempty"`
+
+	// DefaultRouteTableAssociation indicates whether attachments are
+	// automatically associated with the default association route table.
+	// Defaults to true.
+	// +optional
+	DefaultRouteTableAssociation *bool `json:"defaultRouteTableAssociation,omitempty"`
+
+	// DefaultRouteTablePropagation indicates whether attachments
+	// automatically propagate routes to the default propagation route table.
+	// Defaults to true.
+	// +optional
+	DefaultRouteTablePropagation *bool `json:"defaultRouteTablePropagation,omitempty"`
+
+	// DNSSupport indicates whether DNS support is enabled. Defaults to true.
+	// +optional
+	DNSSupport *bool `json:"dnsSupport,omitempty"`
+
+	// VPNECMPSupport indicates whether Equal Cost Multipath Protocol support
+	// is enabled for VPN attachments. Defaults to true.
+	// +optional
+	VPNECMPSupport *bool `json:"vpnEcmpSupport,omitempty"`
+
+	// Tags to apply to the TransitGateway. Tags that are not specified here are
+	// left untouched. Tags that record the UID, name and namespace of the
+	// TransitGateway are added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the TransitGateway will be created. Defaults to the region
+	// of the referenced Provider. It cannot be changed after the TransitGateway is
+	// created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+}
+
+// A TransitGatewaySpec defines the desired state of a TransitGateway.
+type TransitGatewaySpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	TransitGatewayParameters     `json:",inline"`
+}
+
+// TransitGatewayExternalStatus keeps the state for the external resource
+type TransitGatewayExternalStatus struct {
+	// TransitGatewayID is the ID of the transit gateway.
+	TransitGatewayID string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayARN is the Amazon Resource Name (ARN) of the transit
+	// gateway.
+	TransitGatewayARN string `json:"transitGatewayArn,omitempty"`
+
+	// TransitGatewayState is the current state of the transit gateway.
+	// +kubebuilder:validation:Enum=pending;available;modifying;deleting;deleted
+	TransitGatewayState string `json:"transitGatewayState,omitempty"`
+
+	// OwnerID is the ID of the AWS account that owns the transit gateway.
+	OwnerID string `json:"ownerId,omitempty"`
+
+	// AssociationDefaultRouteTableID is the ID of the default association
+	// route table.
+	AssociationDefaultRouteTableID string `json:"associationDefaultRouteTableId,omitempty"`
+
+	// PropagationDefaultRouteTableID is the ID of the default propagation
+	// route table.
+	PropagationDefaultRouteTableID string `json:"propagationDefaultRouteTableId,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewayStatus represents the observed state of a TransitGateway.
+type TransitGatewayStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	TransitGatewayExternalStatus   `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGateway is a managed resource that represents an AWS Transit
+// Gateway.
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.transitGatewayId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.transitGatewayState"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+type TransitGateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewaySpec   `json:"spec,omitempty"`
+	Status TransitGatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayList contains a list of TransitGateways
+type TransitGatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGateway `json:"items"`
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+)
+
+// Error strings
+const (
+	errResourceIsNotTransitGatewayVPCAttachment = "The managed resource is not a TransitGatewayVPCAttachment"
+)
+
+// TransitGatewayIDReferencerForTransitGatewayVPCAttachment is an attribute referencer that resolves TransitGatewayID from a referenced TransitGateway
+type TransitGatewayIDReferencerForTransitGatewayVPCAttachment struct {
+	TransitGatewayIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved transitGatewayId to the managed resource
+func (v *TransitGatewayIDReferencerForTransitGatewayVPCAttachment) Assign(res resource.CanReference, value string) error {
+	a, ok := res.(*TransitGatewayVPCAttachment)
+	if !ok {
+		return errors.New(errResourceIsNotTransitGatewayVPCAttachment)
+	}
+
+	a.Spec.TransitGatewayID = value
+	return nil
+}
+
+// VPCIDReferencerForTransitGatewayVPCAttachment is an attribute referencer that resolves VPCID from a referenced VPC
+type VPCIDReferencerForTransitGatewayVPCAttachment struct {
+	VPCIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved vpcId to the managed resource
+func (v *VPCIDReferencerForTransitGatewayVPCAttachment) Assign(res resource.CanReference, value string) error {
+	a, ok := res.(*TransitGatewayVPCAttachment)
+	if !ok {
+		return errors.New(errResourceIsNotTransitGatewayVPCAttachment)
+	}
+
+	a.Spec.VPCID = value
+	return nil
+}
+
+// SubnetIDReferencerForTransitGatewayVPCAttachment is an attribute referencer that resolves SubnetID from a referenced Subnet
+type SubnetIDReferencerForTransitGatewayVPCAttachment struct {
+	SubnetIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved subnetId to the managed resource
+func (v *SubnetIDReferencerForTransitGatewayVPCAttachment) Assign(res resource.CanReference, value string) error {
+	a, ok := res.(*TransitGatewayVPCAttachment)
+	if !ok {
+		return errors.New(errResourceIsNotTransitGatewayVPCAttachment)
+	}
+
+	a.Spec.SubnetIDs = append(a.Spec.SubnetIDs, value)
+	return nil
+}
+
+// TransitGatewayVPCAttachmentParameters define the desired state of an AWS
+// Transit Gateway VPC Attachment.
+type TransitGatewayVPCAttachmentParameters struct {
+	// TransitGatewayID is the ID of the transit gateway to attach the VPC to.
+	TransitGatewayID string `json:"transitGatewayId,omitempty"`
+
+	// TransitGatewayIDRef references to a TransitGateway to and retrieves its transitGatewayId
+	TransitGatewayIDRef *TransitGatewayIDReferencerForTransitGatewayVPCAttachment `json:"transitGatewayIdRef,omitempty" resource:"attributereferencer"`
+
+	// VPCID is the ID of the VPC to attach.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references to a VPC to and retrieves its vpcId
+	VPCIDRef *VPCIDReferencerForTransitGatewayVPCAttachment `json:"vpcIdRef,omitempty" resource:"attributereferencer"`
+
+	// SubnetIDs are the IDs of the subnets in which the transit gateway
+	// places a network interface. At most one subnet per availability zone
+	// can be specified.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a set of referencers that each retrieve the subnetId from the referenced Subnet
+	// +optional
+	SubnetIDRefs []*SubnetIDReferencerForTransitGatewayVPCAttachment `json:"subnetIdRefs,omitempty" resource:"attributereferencer"`
+
+	// DNSSupport indicates whether DNS support is enabled for the
+	// attachment. Defaults to true.
+	// +optional
+	DNSSupport *bool `json:"dnsSupport,omitempty"`
+
+	// IPv6Support indicates whether IPv6 support is enabled for the
+	// attachment. Defaults to false.
+	// +optional
+	IPv6Support *bool `json:"ipv6Support,omitempty"`
+
+	// Tags to apply to the TransitGatewayVPCAttachment. Tags that are not
+	// specified here are left untouched. Tags that record the UID, name and
+	// namespace of the TransitGatewayVPCAttachment are added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the TransitGatewayVPCAttachment will be created. Defaults to
+	// the region of the referenced Provider. It cannot be changed after the
+	// TransitGatewayVPCAttachment is created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+}
+
+// A TransitGatewayVPCAttachmentSpec defines the desired state of a
+// TransitGatewayVPCAttachment.
+type TransitGatewayVPCAttachmentSpec struct {
+	runtimev1alpha1.ResourceSpec          `json:",inline"`
+	TransitGatewayVPCAttachmentParameters `json:",inline"`
+}
+
+// TransitGatewayVPCAttachmentExternalStatus keeps the state for the external resource
+type TransitGatewayVPCAttachmentExternalStatus struct {
+	// TransitGatewayAttachmentID is the ID of the attachment.
+	TransitGatewayAttachmentID string `json:"transitGatewayAttachmentId,omitempty"`
+
+	// TransitGatewayAttachmentState is the current state of the attachment.
+	// +kubebuilder:validation:Enum=initiating;pendingAcceptance;rollingBack;pending;available;modifying;deleting;deleted;failed;rejected;rejecting;failing
+	TransitGatewayAttachmentState string `json:"transitGatewayAttachmentState,omitempty"`
+
+	// VPCOwnerID is the ID of the AWS account that owns the VPC.
+	VPCOwnerID string `json:"vpcOwnerId,omitempty"`
+
+	// SubnetIDs are the IDs of the subnets the attachment currently places a
+	// network interface in.
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A TransitGatewayVPCAttachmentStatus represents the observed state of a
+// TransitGatewayVPCAttachment.
+type TransitGatewayVPCAttachmentStatus struct {
+	runtimev1alpha1.ResourceStatus            `json:",inline"`
+	TransitGatewayVPCAttachmentExternalStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A TransitGatewayVPCAttachment is a managed resource that represents an AWS
+// Transit Gateway VPC Attachment.
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.transitGatewayAttachmentId"
+// +kubebuilder:printcolumn:name="TRANSITGATEWAYID",type="string",JSONPath=".spec.transitGatewayId"
+// +kubebuilder:printcolumn:name="VPCID",type="string",JSONPath=".spec.vpcId"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.transitGatewayAttachmentState"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+type TransitGatewayVPCAttachment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TransitGatewayVPCAttachmentSpec   `json:"spec,omitempty"`
+	Status TransitGatewayVPCAttachmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// TransitGatewayVPCAttachmentList contains a list of TransitGatewayVPCAttachments
+type TransitGatewayVPCAttachmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TransitGatewayVPCAttachment `json:"items"`
+}
@@ -31,11 +31,46 @@ type VPCParameters struct {
 	// +kubebuilder:validation:Required
 	CIDRBlock string `json:"cidrBlock"`
 
-	// A boolean flag to enable/disable DNS support in the VPC
-	EnableDNSSupport bool `json:"enableDnsSupport,omitempty"`
+	// A boolean flag to enable/disable DNS support in the VPC. Defaults to
+	// the value of the VPC when omitted.
+	// +optional
+	EnableDNSSupport *bool `json:"enableDnsSupport,omitempty"`
+
+	// A boolean flag to enable/disable DNS hostnames in the VPC. Defaults to
+	// the value of the VPC when omitted.
+	// +optional
+	EnableDNSHostNames *bool `json:"enableDnsHostNames,omitempty"`
+
+	// AdditionalCIDRBlocks are secondary IPv4 network ranges associated with
+	// the VPC, in CIDR notation. Defaults to the secondary network ranges of
+	// the VPC when omitted.
+	// +optional
+	AdditionalCIDRBlocks []string `json:"additionalCidrBlocks,omitempty"`
+
+	// AmazonProvidedIPv6CIDRBlock requests an Amazon-provided IPv6 CIDR block
+	// with a /56 prefix length for the VPC. False removes the IPv6 CIDR block
+	// of the VPC. Defaults to whether the VPC has one when omitted.
+	// +optional
+	AmazonProvidedIPv6CIDRBlock *bool `json:"amazonProvidedIpv6CidrBlock,omitempty"`
+
+	// InstanceTenancy of instances launched into the VPC. A VPC with dedicated
+	// tenancy may be changed to default tenancy, but not vice versa. Defaults
+	// to the tenancy of the VPC when omitted.
+	// +optional
+	// +kubebuilder:validation:Enum=default;dedicated
+	InstanceTenancy string `json:"instanceTenancy,omitempty"`
+
+	// Tags to apply to the VPC. Tags that are not specified here are left
+	// untouched. Tags that record the UID, name and namespace of the VPC are
+	// added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
 
-	// A boolean flag to enable/disable DNS hostnames in the VPC
-	EnableDNSHostNames bool `json:"enableDnsHostNames,omitempty"`
+	// Region in which the VPC will be created. Defaults to the region of the
+	// referenced Provider. It cannot be changed after the VPC is created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
 }
 
 // A VPCSpec defines the desired state of a VPC.
@@ -55,6 +90,29 @@ type VPCExternalStatus struct {
 
 	// VPCID is the ID of the VPC.
 	VPCID string `json:"vpcId,omitempty"`
+
+	// CIDRBlockAssociations are the IPv4 CIDR blocks associated with the VPC.
+	CIDRBlockAssociations []CIDRBlockAssociation `json:"cidrBlockAssociations,omitempty"`
+
+	// IPv6CIDRBlockAssociations are the IPv6 CIDR blocks associated with the
+	// VPC.
+	IPv6CIDRBlockAssociations []CIDRBlockAssociation `json:"ipv6CidrBlockAssociations,omitempty"`
+
+	// InstanceTenancy of instances launched into the VPC.
+	InstanceTenancy string `json:"instanceTenancy,omitempty"`
+}
+
+// A CIDRBlockAssociation describes a CIDR block associated with a VPC or a
+// Subnet.
+type CIDRBlockAssociation struct {
+	// AssociationID is the ID of the association.
+	AssociationID string `json:"associationId"`
+
+	// CIDRBlock is the associated network range, in CIDR notation.
+	CIDRBlock string `json:"cidrBlock"`
+
+	// State of the association.
+	State string `json:"state,omitempty"`
 }
 
 // A VPCStatus represents the observed state of a VPC.
@@ -93,8 +151,31 @@ type VPCList struct {
 // UpdateExternalStatus updates the external status object,  given the observation
 func (v *VPC) UpdateExternalStatus(observation ec2.Vpc) {
 	v.Status.VPCExternalStatus = VPCExternalStatus{
-		VPCID:    aws.StringValue(observation.VpcId),
-		Tags:     BuildFromEC2Tags(observation.Tags),
-		VPCState: string(observation.State),
+		VPCID:           aws.StringValue(observation.VpcId),
+		Tags:            BuildFromEC2Tags(observation.Tags),
+		VPCState:        string(observation.State),
+		InstanceTenancy: string(observation.InstanceTenancy),
+	}
+
+	for _, a := range observation.CidrBlockAssociationSet {
+		ca := CIDRBlockAssociation{
+			AssociationID: aws.StringValue(a.AssociationId),
+			CIDRBlock:     aws.StringValue(a.CidrBlock),
+		}
+		if a.CidrBlockState != nil {
+			ca.State = string(a.CidrBlockState.State)
+		}
+		v.Status.CIDRBlockAssociations = append(v.Status.CIDRBlockAssociations, ca)
+	}
+
+	for _, a := range observation.Ipv6CidrBlockAssociationSet {
+		ca := CIDRBlockAssociation{
+			AssociationID: aws.StringValue(a.AssociationId),
+			CIDRBlock:     aws.StringValue(a.Ipv6CidrBlock),
+		}
+		if a.Ipv6CidrBlockState != nil {
+			ca.State = string(a.Ipv6CidrBlockState.State)
+		}
+		v.Status.IPv6CIDRBlockAssociations = append(v.Status.IPv6CIDRBlockAssociations, ca)
 	}
 }
@@ -0,0 +1,253 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+)
+
+// Error strings
+const (
+	errResourceIsNotVPCEndpoint = "The managed resource is not a VPCEndpoint"
+)
+
+// VPCIDReferencerForVPCEndpoint is an attribute referencer that resolves VPCID from a referenced VPC
+type VPCIDReferencerForVPCEndpoint struct {
+	VPCIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved vpcId to the managed resource
+func (v *VPCIDReferencerForVPCEndpoint) Assign(res resource.CanReference, value string) error {
+	e, ok := res.(*VPCEndpoint)
+	if !ok {
+		return errors.New(errResourceIsNotVPCEndpoint)
+	}
+
+	e.Spec.VPCID = value
+	return nil
+}
+
+// SubnetIDReferencerForVPCEndpoint is an attribute referencer that resolves SubnetID from a referenced Subnet
+type SubnetIDReferencerForVPCEndpoint struct {
+	SubnetIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved subnetId to the managed resource
+func (v *SubnetIDReferencerForVPCEndpoint) Assign(res resource.CanReference, value string) error {
+	e, ok := res.(*VPCEndpoint)
+	if !ok {
+		return errors.New(errResourceIsNotVPCEndpoint)
+	}
+
+	e.Spec.SubnetIDs = append(e.Spec.SubnetIDs, value)
+	return nil
+}
+
+// SecurityGroupIDReferencerForVPCEndpoint is an attribute referencer that resolves GroupID from a referenced SecurityGroup
+type SecurityGroupIDReferencerForVPCEndpoint struct {
+	SecurityGroupIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved groupId to the managed resource
+func (v *SecurityGroupIDReferencerForVPCEndpoint) Assign(res resource.CanReference, value string) error {
+	e, ok := res.(*VPCEndpoint)
+	if !ok {
+		return errors.New(errResourceIsNotVPCEndpoint)
+	}
+
+	e.Spec.SecurityGroupIDs = append(e.Spec.SecurityGroupIDs, value)
+	return nil
+}
+
+// RouteTableIDReferencerForVPCEndpoint is an attribute referencer that resolves RouteTableID from a referenced RouteTable
+type RouteTableIDReferencerForVPCEndpoint struct {
+	RouteTableIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved routeTableId to the managed resource
+func (v *RouteTableIDReferencerForVPCEndpoint) Assign(res resource.CanReference, value string) error {
+	e, ok := res.(*VPCEndpoint)
+	if !ok {
+		return errors.New(errResourceIsNotVPCEndpoint)
+	}
+
+	e.Spec.RouteTableIDs = append(e.Spec.RouteTableIDs, value)
+	return nil
+}
+
+// VPCEndpointParameters define the desired state of an AWS VPC Endpoint.
+type VPCEndpointParameters struct {
+	// VPCID is the ID of the VPC in which the endpoint will be used.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references to a VPC to and retrieves its vpcId
+	VPCIDRef *VPCIDReferencerForVPCEndpoint `json:"vpcIdRef,omitempty" resource:"attributereferencer"`
+
+	// ServiceName is the service name, in the form com.amazonaws.region.service
+	// for AWS services. For example, com.amazonaws.us-east-1.s3.
+	ServiceName string `json:"serviceName"`
+
+	// VPCEndpointType is the type of endpoint. Gateway endpoints are supported
+	// for S3 and DynamoDB; most other services use interface endpoints.
+	// +kubebuilder:validation:Enum=Gateway;Interface
+	// +optional
+	VPCEndpointType string `json:"vpcEndpointType,omitempty"`
+
+	// PolicyDocument is a policy to attach to the endpoint that controls
+	// access to the service, in JSON format. If omitted, a default policy
+	// that allows full access to the service is attached.
+	// +optional
+	PolicyDocument string `json:"policyDocument,omitempty"`
+
+	// PrivateDNSEnabled indicates whether to associate a private hosted zone
+	// with the VPC, so that the default DNS name of the service resolves to
+	// the endpoint. Interface endpoints only.
+	// +optional
+	PrivateDNSEnabled *bool `json:"privateDnsEnabled,omitempty"`
+
+	// RouteTableIDs are the IDs of the route tables that are updated with a
+	// route to the service. Gateway endpoints only.
+	// +optional
+	RouteTableIDs []string `json:"routeTableIds,omitempty"`
+
+	// RouteTableIDRefs is a set of referencers that each retrieve the routeTableId from the referenced RouteTable
+	// +optional
+	RouteTableIDRefs []*RouteTableIDReferencerForVPCEndpoint `json:"routeTableIdRefs,omitempty" resource:"attributereferencer"`
+
+	// SubnetIDs are the IDs of the subnets in which to create an endpoint
+	// network interface. Interface endpoints only.
+	// +optional
+	SubnetIDs []string `json:"subnetIds,omitempty"`
+
+	// SubnetIDRefs is a set of referencers that each retrieve the subnetId from the referenced Subnet
+	// +optional
+	SubnetIDRefs []*SubnetIDReferencerForVPCEndpoint `json:"subnetIdRefs,omitempty" resource:"attributereferencer"`
+
+	// SecurityGroupIDs are the IDs of the security groups to associate with
+	// the endpoint network interfaces. Interface endpoints only.
+	// +optional
+	SecurityGroupIDs []string `json:"securityGroupIds,omitempty"`
+
+	// SecurityGroupIDRefs is a set of referencers that each retrieve the groupId from the referenced SecurityGroup
+	// +optional
+	SecurityGroupIDRefs []*SecurityGroupIDReferencerForVPCEndpoint `json:"securityGroupIdRefs,omitempty" resource:"attributereferencer"`
+
+	// Tags to apply to the VPCEndpoint. Tags that are not specified here are
+	// left untouched. Tags that record the UID, name and namespace of the
+	// VPCEndpoint are added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the VPCEndpoint will be created. Defaults to the region of
+	// the referenced Provider. It cannot be changed after the VPCEndpoint is
+	// created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+}
+
+// A VPCEndpointSpec defines the desired state of a VPCEndpoint.
+type VPCEndpointSpec struct {
+	runtimev1alpha1.ResourceSpec `json:",inline"`
+	VPCEndpointParameters        `json:",inline"`
+}
+
+// DNSEntry describes a DNS entry of an interface endpoint.
+type DNSEntry struct {
+	// DNSName is the DNS name.
+	DNSName string `json:"dnsName,omitempty"`
+
+	// HostedZoneID is the ID of the private hosted zone.
+	HostedZoneID string `json:"hostedZoneId,omitempty"`
+}
+
+// VPCEndpointExternalStatus keeps the state for the external resource
+type VPCEndpointExternalStatus struct {
+	// VPCEndpointID is the ID of the VPC endpoint.
+	VPCEndpointID string `json:"vpcEndpointId,omitempty"`
+
+	// VPCEndpointState is the current state of the VPC endpoint.
+	VPCEndpointState string `json:"vpcEndpointState,omitempty"`
+
+	// DNSEntries are the DNS entries of an interface endpoint.
+	DNSEntries []DNSEntry `json:"dnsEntries,omitempty"`
+
+	// NetworkInterfaceIDs are the IDs of the network interfaces of an
+	// interface endpoint.
+	NetworkInterfaceIDs []string `json:"networkInterfaceIds,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A VPCEndpointStatus represents the observed state of a VPCEndpoint.
+type VPCEndpointStatus struct {
+	runtimev1alpha1.ResourceStatus `json:",inline"`
+	VPCEndpointExternalStatus      `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCEndpoint is a managed resource that represents an AWS VPC Endpoint.
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.vpcEndpointId"
+// +kubebuilder:printcolumn:name="SERVICE",type="string",JSONPath=".spec.serviceName"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.vpcEndpointState"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+type VPCEndpoint struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCEndpointSpec   `json:"spec,omitempty"`
+	Status VPCEndpointStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCEndpointList contains a list of VPCEndpoints
+type VPCEndpointList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCEndpoint `json:"items"`
+}
+
+// UpdateExternalStatus updates the external status object, given the observation
+func (e *VPCEndpoint) UpdateExternalStatus(observation ec2.VpcEndpoint) {
+	entries := make([]DNSEntry, len(observation.DnsEntries))
+	for i, d := range observation.DnsEntries {
+		entries[i] = DNSEntry{
+			DNSName:      aws.StringValue(d.DnsName),
+			HostedZoneID: aws.StringValue(d.HostedZoneId),
+		}
+	}
+
+	e.Status.VPCEndpointExternalStatus = VPCEndpointExternalStatus{
+		VPCEndpointID:       aws.StringValue(observation.VpcEndpointId),
+		VPCEndpointState:    string(observation.State),
+		DNSEntries:          entries,
+		NetworkInterfaceIDs: observation.NetworkInterfaceIds,
+	}
+}
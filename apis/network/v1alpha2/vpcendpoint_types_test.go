@@ -0,0 +1,140 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+	"github.com/crossplaneio/crossplane-runtime/pkg/test"
+)
+
+var _ resource.AttributeReferencer = (*VPCIDReferencerForVPCEndpoint)(nil)
+var _ resource.AttributeReferencer = (*SubnetIDReferencerForVPCEndpoint)(nil)
+var _ resource.AttributeReferencer = (*SecurityGroupIDReferencerForVPCEndpoint)(nil)
+var _ resource.AttributeReferencer = (*RouteTableIDReferencerForVPCEndpoint)(nil)
+
+func TestVPCIDReferencerForVPCEndpoint_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &VPCIDReferencerForVPCEndpoint{}
+	expectedErr := errors.New(errResourceIsNotVPCEndpoint)
+
+	err := r.Assign(&mockCanReference{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestVPCIDReferencerForVPCEndpoint_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r := &VPCIDReferencerForVPCEndpoint{}
+	res := &VPCEndpoint{}
+	var expectedErr error
+
+	err := r.Assign(res, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.VPCID, "mockValue"); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
+
+func TestSubnetIDReferencerForVPCEndpoint_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &SubnetIDReferencerForVPCEndpoint{}
+	expectedErr := errors.New(errResourceIsNotVPCEndpoint)
+
+	err := r.Assign(&mockCanReference{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestSubnetIDReferencerForVPCEndpoint_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r := &SubnetIDReferencerForVPCEndpoint{}
+	res := &VPCEndpoint{}
+	var expectedErr error
+
+	err := r.Assign(res, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.SubnetIDs, []string{"mockValue"}); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
+
+func TestSecurityGroupIDReferencerForVPCEndpoint_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &SecurityGroupIDReferencerForVPCEndpoint{}
+	expectedErr := errors.New(errResourceIsNotVPCEndpoint)
+
+	err := r.Assign(&mockCanReference{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestSecurityGroupIDReferencerForVPCEndpoint_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r := &SecurityGroupIDReferencerForVPCEndpoint{}
+	res := &VPCEndpoint{}
+	var expectedErr error
+
+	err := r.Assign(res, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.SecurityGroupIDs, []string{"mockValue"}); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
+
+func TestRouteTableIDReferencerForVPCEndpoint_AssignInvalidType_ReturnsErr(t *testing.T) {
+
+	r := &RouteTableIDReferencerForVPCEndpoint{}
+	expectedErr := errors.New(errResourceIsNotVPCEndpoint)
+
+	err := r.Assign(&mockCanReference{}, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+}
+
+func TestRouteTableIDReferencerForVPCEndpoint_AssignValidType_ReturnsExpected(t *testing.T) {
+
+	r := &RouteTableIDReferencerForVPCEndpoint{}
+	res := &VPCEndpoint{}
+	var expectedErr error
+
+	err := r.Assign(res, "mockValue")
+	if diff := cmp.Diff(expectedErr, err, test.EquateErrors()); diff != "" {
+		t.Errorf("Assign(...): -want error, +got error:\n%s", diff)
+	}
+
+	if diff := cmp.Diff(res.Spec.RouteTableIDs, []string{"mockValue"}); diff != "" {
+		t.Errorf("Assign(...): -want value, +got value:\n%s", diff)
+	}
+}
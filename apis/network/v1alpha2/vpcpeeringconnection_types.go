@@ -0,0 +1,201 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha2
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+)
+
+// Error strings
+const (
+	errResourceIsNotVPCPeeringConnection = "The managed resource is not a VPCPeeringConnection"
+)
+
+// VPCIDReferencerForVPCPeeringConnection is an attribute referencer that resolves VPCID from a referenced VPC
+type VPCIDReferencerForVPCPeeringConnection struct {
+	VPCIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved vpcId to the managed resource
+func (v *VPCIDReferencerForVPCPeeringConnection) Assign(res resource.CanReference, value string) error {
+	pc, ok := res.(*VPCPeeringConnection)
+	if !ok {
+		return errors.New(errResourceIsNotVPCPeeringConnection)
+	}
+
+	pc.Spec.VPCID = value
+	return nil
+}
+
+// PeerVPCIDReferencerForVPCPeeringConnection is an attribute referencer that resolves PeerVPCID from a referenced VPC
+type PeerVPCIDReferencerForVPCPeeringConnection struct {
+	VPCIDReferencer `json:",inline"`
+}
+
+// Assign assigns the retrieved vpcId to the managed resource
+func (v *PeerVPCIDReferencerForVPCPeeringConnection) Assign(res resource.CanReference, value string) error {
+	pc, ok := res.(*VPCPeeringConnection)
+	if !ok {
+		return errors.New(errResourceIsNotVPCPeeringConnection)
+	}
+
+	pc.Spec.PeerVPCID = value
+	return nil
+}
+
+// VPCPeeringConnectionParameters define the desired state of an AWS VPC
+// Peering Connection.
+type VPCPeeringConnectionParameters struct {
+	// VPCID is the ID of the requester VPC.
+	VPCID string `json:"vpcId,omitempty"`
+
+	// VPCIDRef references to a VPC to and retrieves its vpcId
+	VPCIDRef *VPCIDReferencerForVPCPeeringConnection `json:"vpcIdRef,omitempty" resource:"attributereferencer"`
+
+	// PeerVPCID is the ID of the accepter VPC.
+	PeerVPCID string `json:"peerVpcId,omitempty"`
+
+	// PeerVPCIDRef references to a VPC to and retrieves its vpcId as the
+	// accepter VPC
+	PeerVPCIDRef *PeerVPCIDReferencerForVPCPeeringConnection `json:"peerVpcIdRef,omitempty" resource:"attributereferencer"`
+
+	// PeerOwnerID is the AWS account ID of the owner of the accepter VPC.
+	// Defaults to the account of the accepter provider if one is specified,
+	// or else to the account of the requester.
+	// +optional
+	PeerOwnerID string `json:"peerOwnerId,omitempty"`
+
+	// PeerRegion is the region in which the accepter VPC is located. Defaults
+	// to the region of the requester.
+	// +optional
+	PeerRegion string `json:"peerRegion,omitempty"`
+
+	// AccepterProviderReference specifies the provider whose credentials are
+	// used to accept the peering connection request. Defaults to the provider
+	// of the requester when the peer is owned by the same account. The request
+	// is left pending acceptance when the peer is owned by another account
+	// and no accepter provider is specified.
+	// +optional
+	AccepterProviderReference *corev1.ObjectReference `json:"accepterProviderRef,omitempty"`
+
+	// Tags to apply to the VPCPeeringConnection. Tags that are not specified
+	// here are left untouched. Tags that record the UID, name and namespace of
+	// the VPCPeeringConnection are added automatically.
+	// +optional
+	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the VPCPeeringConnection will be requested. Defaults to the
+	// region of the referenced Provider. It cannot be changed after the
+	// VPCPeeringConnection is created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
+}
+
+// A VPCPeeringConnectionSpec defines the desired state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionSpec struct {
+	runtimev1alpha1.ResourceSpec   `json:",inline"`
+	VPCPeeringConnectionParameters `json:",inline"`
+}
+
+// VPCPeeringConnectionExternalStatus keeps the state for the external resource
+type VPCPeeringConnectionExternalStatus struct {
+	// VPCPeeringConnectionID is the ID of the VPC peering connection.
+	VPCPeeringConnectionID string `json:"vpcPeeringConnectionId,omitempty"`
+
+	// StatusCode is the status of the VPC peering connection.
+	// +kubebuilder:validation:Enum=initiating-request;pending-acceptance;active;deleted;rejected;failed;expired;provisioning;deleting
+	StatusCode string `json:"statusCode,omitempty"`
+
+	// StatusMessage is a message that provides more information about the
+	// status, if applicable.
+	StatusMessage string `json:"statusMessage,omitempty"`
+
+	// RequesterCIDRBlock is the IPv4 CIDR block of the requester VPC.
+	RequesterCIDRBlock string `json:"requesterCidrBlock,omitempty"`
+
+	// AccepterCIDRBlock is the IPv4 CIDR block of the accepter VPC.
+	AccepterCIDRBlock string `json:"accepterCidrBlock,omitempty"`
+
+	// Tags represents to current ec2 tags.
+	Tags []Tag `json:"tags,omitempty"`
+}
+
+// A VPCPeeringConnectionStatus represents the observed state of a
+// VPCPeeringConnection.
+type VPCPeeringConnectionStatus struct {
+	runtimev1alpha1.ResourceStatus     `json:",inline"`
+	VPCPeeringConnectionExternalStatus `json:",inline"`
+}
+
+// +kubebuilder:object:root=true
+
+// A VPCPeeringConnection is a managed resource that represents an AWS VPC
+// Peering Connection.
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.vpcPeeringConnectionId"
+// +kubebuilder:printcolumn:name="VPCID",type="string",JSONPath=".spec.vpcId"
+// +kubebuilder:printcolumn:name="PEERVPCID",type="string",JSONPath=".spec.peerVpcId"
+// +kubebuilder:printcolumn:name="STATUS",type="string",JSONPath=".status.statusCode"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+type VPCPeeringConnection struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   VPCPeeringConnectionSpec   `json:"spec,omitempty"`
+	Status VPCPeeringConnectionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VPCPeeringConnectionList contains a list of VPCPeeringConnections
+type VPCPeeringConnectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VPCPeeringConnection `json:"items"`
+}
+
+// UpdateExternalStatus updates the external status object, given the observation
+func (p *VPCPeeringConnection) UpdateExternalStatus(observation ec2.VpcPeeringConnection) {
+	s := VPCPeeringConnectionExternalStatus{
+		VPCPeeringConnectionID: aws.StringValue(observation.VpcPeeringConnectionId),
+		Tags:                   BuildFromEC2Tags(observation.Tags),
+	}
+	if observation.Status != nil {
+		s.StatusCode = string(observation.Status.Code)
+		s.StatusMessage = aws.StringValue(observation.Status.Message)
+	}
+	if observation.RequesterVpcInfo != nil {
+		s.RequesterCIDRBlock = aws.StringValue(observation.RequesterVpcInfo.CidrBlock)
+	}
+	if observation.AccepterVpcInfo != nil {
+		s.AccepterCIDRBlock = aws.StringValue(observation.AccepterVpcInfo.CidrBlock)
+	}
+
+	p.Status.VPCPeeringConnectionExternalStatus = s
+}
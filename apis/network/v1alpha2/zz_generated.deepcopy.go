@@ -21,9 +21,42 @@ limitations under the License.
 package v1alpha2
 
 import (
+	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllocationIDReferencer) DeepCopyInto(out *AllocationIDReferencer) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllocationIDReferencer.
+func (in *AllocationIDReferencer) DeepCopy() *AllocationIDReferencer {
+	if in == nil {
+		return nil
+	}
+	out := new(AllocationIDReferencer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AllocationIDReferencerForNATGateway) DeepCopyInto(out *AllocationIDReferencerForNATGateway) {
+	*out = *in
+	out.AllocationIDReferencer = in.AllocationIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AllocationIDReferencerForNATGateway.
+func (in *AllocationIDReferencerForNATGateway) DeepCopy() *AllocationIDReferencerForNATGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(AllocationIDReferencerForNATGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Association) DeepCopyInto(out *Association) {
 	*out = *in
@@ -60,6 +93,169 @@ func (in *AssociationState) DeepCopy() *AssociationState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CIDRBlockAssociation) DeepCopyInto(out *CIDRBlockAssociation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CIDRBlockAssociation.
+func (in *CIDRBlockAssociation) DeepCopy() *CIDRBlockAssociation {
+	if in == nil {
+		return nil
+	}
+	out := new(CIDRBlockAssociation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSEntry) DeepCopyInto(out *DNSEntry) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSEntry.
+func (in *DNSEntry) DeepCopy() *DNSEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIP) DeepCopyInto(out *ElasticIP) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIP.
+func (in *ElasticIP) DeepCopy() *ElasticIP {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ElasticIP) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPExternalStatus) DeepCopyInto(out *ElasticIPExternalStatus) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPExternalStatus.
+func (in *ElasticIPExternalStatus) DeepCopy() *ElasticIPExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPList) DeepCopyInto(out *ElasticIPList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ElasticIP, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPList.
+func (in *ElasticIPList) DeepCopy() *ElasticIPList {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ElasticIPList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPParameters) DeepCopyInto(out *ElasticIPParameters) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPParameters.
+func (in *ElasticIPParameters) DeepCopy() *ElasticIPParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPSpec) DeepCopyInto(out *ElasticIPSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	out.ElasticIPParameters = in.ElasticIPParameters
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPSpec.
+func (in *ElasticIPSpec) DeepCopy() *ElasticIPSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticIPStatus) DeepCopyInto(out *ElasticIPStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.ElasticIPExternalStatus.DeepCopyInto(&out.ElasticIPExternalStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticIPStatus.
+func (in *ElasticIPStatus) DeepCopy() *ElasticIPStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticIPStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IPPermission) DeepCopyInto(out *IPPermission) {
 	*out = *in
@@ -68,6 +264,23 @@ func (in *IPPermission) DeepCopyInto(out *IPPermission) {
 		*out = make([]IPRange, len(*in))
 		copy(*out, *in)
 	}
+	if in.IPv6CIDRBlocks != nil {
+		in, out := &in.IPv6CIDRBlocks, &out.IPv6CIDRBlocks
+		*out = make([]IPv6Range, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrefixListIDs != nil {
+		in, out := &in.PrefixListIDs, &out.PrefixListIDs
+		*out = make([]PrefixListID, len(*in))
+		copy(*out, *in)
+	}
+	if in.UserIDGroupPairs != nil {
+		in, out := &in.UserIDGroupPairs, &out.UserIDGroupPairs
+		*out = make([]UserIDGroupPair, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPPermission.
@@ -95,6 +308,21 @@ func (in *IPRange) DeepCopy() *IPRange {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6Range) DeepCopyInto(out *IPv6Range) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6Range.
+func (in *IPv6Range) DeepCopy() *IPv6Range {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6Range)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InternetGateway) DeepCopyInto(out *InternetGateway) {
 	*out = *in
@@ -234,6 +462,11 @@ func (in *InternetGatewayParameters) DeepCopyInto(out *InternetGatewayParameters
 		*out = new(VPCIDReferencerForInternetGateway)
 		**out = **in
 	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternetGatewayParameters.
@@ -281,123 +514,130 @@ func (in *InternetGatewayStatus) DeepCopy() *InternetGatewayStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Route) DeepCopyInto(out *Route) {
+func (in *NATGateway) DeepCopyInto(out *NATGateway) {
 	*out = *in
-	if in.GatewayIDRef != nil {
-		in, out := &in.GatewayIDRef, &out.GatewayIDRef
-		*out = new(InternetGatewayIDReferencerForRouteTable)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
-func (in *Route) DeepCopy() *Route {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGateway.
+func (in *NATGateway) DeepCopy() *NATGateway {
 	if in == nil {
 		return nil
 	}
-	out := new(Route)
+	out := new(NATGateway)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NATGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteState) DeepCopyInto(out *RouteState) {
+func (in *NATGatewayAddress) DeepCopyInto(out *NATGatewayAddress) {
 	*out = *in
-	in.Route.DeepCopyInto(&out.Route)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteState.
-func (in *RouteState) DeepCopy() *RouteState {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayAddress.
+func (in *NATGatewayAddress) DeepCopy() *NATGatewayAddress {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteState)
+	out := new(NATGatewayAddress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTable) DeepCopyInto(out *RouteTable) {
+func (in *NATGatewayExternalStatus) DeepCopyInto(out *NATGatewayExternalStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]NATGatewayAddress, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
-func (in *RouteTable) DeepCopy() *RouteTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayExternalStatus.
+func (in *NATGatewayExternalStatus) DeepCopy() *NATGatewayExternalStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTable)
+	out := new(NATGatewayExternalStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouteTable) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NATGatewayIDReferencer) DeepCopyInto(out *NATGatewayIDReferencer) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayIDReferencer.
+func (in *NATGatewayIDReferencer) DeepCopy() *NATGatewayIDReferencer {
+	if in == nil {
+		return nil
 	}
-	return nil
+	out := new(NATGatewayIDReferencer)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableExternalStatus) DeepCopyInto(out *RouteTableExternalStatus) {
+func (in *NATGatewayIDReferencerForRouteTable) DeepCopyInto(out *NATGatewayIDReferencerForRouteTable) {
 	*out = *in
-	if in.Routes != nil {
-		in, out := &in.Routes, &out.Routes
-		*out = make([]RouteState, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Associations != nil {
-		in, out := &in.Associations, &out.Associations
-		*out = make([]AssociationState, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	out.NATGatewayIDReferencer = in.NATGatewayIDReferencer
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableExternalStatus.
-func (in *RouteTableExternalStatus) DeepCopy() *RouteTableExternalStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayIDReferencerForRouteTable.
+func (in *NATGatewayIDReferencerForRouteTable) DeepCopy() *NATGatewayIDReferencerForRouteTable {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTableExternalStatus)
+	out := new(NATGatewayIDReferencerForRouteTable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableList) DeepCopyInto(out *RouteTableList) {
+func (in *NATGatewayList) DeepCopyInto(out *NATGatewayList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]RouteTable, len(*in))
+		*out = make([]NATGateway, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableList.
-func (in *RouteTableList) DeepCopy() *RouteTableList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayList.
+func (in *NATGatewayList) DeepCopy() *NATGatewayList {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTableList)
+	out := new(NATGatewayList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RouteTableList) DeepCopyObject() runtime.Object {
+func (in *NATGatewayList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -405,75 +645,71 @@ func (in *RouteTableList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableParameters) DeepCopyInto(out *RouteTableParameters) {
+func (in *NATGatewayParameters) DeepCopyInto(out *NATGatewayParameters) {
 	*out = *in
-	if in.VPCIDRef != nil {
-		in, out := &in.VPCIDRef, &out.VPCIDRef
-		*out = new(VPCIDReferencerForRouteTable)
+	if in.AllocationIDRef != nil {
+		in, out := &in.AllocationIDRef, &out.AllocationIDRef
+		*out = new(AllocationIDReferencerForNATGateway)
 		**out = **in
 	}
-	if in.Routes != nil {
-		in, out := &in.Routes, &out.Routes
-		*out = make([]Route, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(SubnetIDReferencerForNATGateway)
+		**out = **in
 	}
-	if in.Associations != nil {
-		in, out := &in.Associations, &out.Associations
-		*out = make([]Association, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableParameters.
-func (in *RouteTableParameters) DeepCopy() *RouteTableParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayParameters.
+func (in *NATGatewayParameters) DeepCopy() *NATGatewayParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTableParameters)
+	out := new(NATGatewayParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
+func (in *NATGatewaySpec) DeepCopyInto(out *NATGatewaySpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.RouteTableParameters.DeepCopyInto(&out.RouteTableParameters)
+	in.NATGatewayParameters.DeepCopyInto(&out.NATGatewayParameters)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableSpec.
-func (in *RouteTableSpec) DeepCopy() *RouteTableSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewaySpec.
+func (in *NATGatewaySpec) DeepCopy() *NATGatewaySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTableSpec)
+	out := new(NATGatewaySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
+func (in *NATGatewayStatus) DeepCopyInto(out *NATGatewayStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.RouteTableExternalStatus.DeepCopyInto(&out.RouteTableExternalStatus)
+	in.NATGatewayExternalStatus.DeepCopyInto(&out.NATGatewayExternalStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableStatus.
-func (in *RouteTableStatus) DeepCopy() *RouteTableStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NATGatewayStatus.
+func (in *NATGatewayStatus) DeepCopy() *NATGatewayStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(RouteTableStatus)
+	out := new(NATGatewayStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
+func (in *NetworkACL) DeepCopyInto(out *NetworkACL) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -481,18 +717,18 @@ func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
-func (in *SecurityGroup) DeepCopy() *SecurityGroup {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACL.
+func (in *NetworkACL) DeepCopy() *NetworkACL {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroup)
+	out := new(NetworkACL)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecurityGroup) DeepCopyObject() runtime.Object {
+func (in *NetworkACL) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -500,162 +736,148 @@ func (in *SecurityGroup) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupExternalStatus) DeepCopyInto(out *SecurityGroupExternalStatus) {
+func (in *NetworkACLAssociation) DeepCopyInto(out *NetworkACLAssociation) {
 	*out = *in
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
-		copy(*out, *in)
+	if in.SubnetIDRef != nil {
+		in, out := &in.SubnetIDRef, &out.SubnetIDRef
+		*out = new(SubnetIDReferencerForNetworkACL)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupExternalStatus.
-func (in *SecurityGroupExternalStatus) DeepCopy() *SecurityGroupExternalStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLAssociation.
+func (in *NetworkACLAssociation) DeepCopy() *NetworkACLAssociation {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupExternalStatus)
+	out := new(NetworkACLAssociation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupIDReferencer) DeepCopyInto(out *SecurityGroupIDReferencer) {
+func (in *NetworkACLAssociationState) DeepCopyInto(out *NetworkACLAssociationState) {
 	*out = *in
-	out.LocalObjectReference = in.LocalObjectReference
+	in.NetworkACLAssociation.DeepCopyInto(&out.NetworkACLAssociation)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupIDReferencer.
-func (in *SecurityGroupIDReferencer) DeepCopy() *SecurityGroupIDReferencer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLAssociationState.
+func (in *NetworkACLAssociationState) DeepCopy() *NetworkACLAssociationState {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupIDReferencer)
+	out := new(NetworkACLAssociationState)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupList) DeepCopyInto(out *SecurityGroupList) {
+func (in *NetworkACLEntry) DeepCopyInto(out *NetworkACLEntry) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]SecurityGroup, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.PortRange != nil {
+		in, out := &in.PortRange, &out.PortRange
+		*out = new(NetworkACLPortRange)
+		**out = **in
+	}
+	if in.ICMPTypeCode != nil {
+		in, out := &in.ICMPTypeCode, &out.ICMPTypeCode
+		*out = new(NetworkACLICMPTypeCode)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupList.
-func (in *SecurityGroupList) DeepCopy() *SecurityGroupList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLEntry.
+func (in *NetworkACLEntry) DeepCopy() *NetworkACLEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupList)
+	out := new(NetworkACLEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SecurityGroupList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupParameters) DeepCopyInto(out *SecurityGroupParameters) {
+func (in *NetworkACLExternalStatus) DeepCopyInto(out *NetworkACLExternalStatus) {
 	*out = *in
-	if in.VPCIDRef != nil {
-		in, out := &in.VPCIDRef, &out.VPCIDRef
-		*out = new(VPCIDReferencerForSecurityGroup)
-		**out = **in
+	if in.IngressEntries != nil {
+		in, out := &in.IngressEntries, &out.IngressEntries
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.IngressPermissions != nil {
-		in, out := &in.IngressPermissions, &out.IngressPermissions
-		*out = make([]IPPermission, len(*in))
+	if in.EgressEntries != nil {
+		in, out := &in.EgressEntries, &out.EgressEntries
+		*out = make([]NetworkACLEntry, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.EgressPermissions != nil {
-		in, out := &in.EgressPermissions, &out.EgressPermissions
-		*out = make([]IPPermission, len(*in))
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]NetworkACLAssociationState, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupParameters.
-func (in *SecurityGroupParameters) DeepCopy() *SecurityGroupParameters {
-	if in == nil {
-		return nil
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
 	}
-	out := new(SecurityGroupParameters)
-	in.DeepCopyInto(out)
-	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupSpec) DeepCopyInto(out *SecurityGroupSpec) {
-	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.SecurityGroupParameters.DeepCopyInto(&out.SecurityGroupParameters)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupSpec.
-func (in *SecurityGroupSpec) DeepCopy() *SecurityGroupSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLExternalStatus.
+func (in *NetworkACLExternalStatus) DeepCopy() *NetworkACLExternalStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupSpec)
+	out := new(NetworkACLExternalStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
+func (in *NetworkACLICMPTypeCode) DeepCopyInto(out *NetworkACLICMPTypeCode) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.SecurityGroupExternalStatus.DeepCopyInto(&out.SecurityGroupExternalStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupStatus.
-func (in *SecurityGroupStatus) DeepCopy() *SecurityGroupStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLICMPTypeCode.
+func (in *NetworkACLICMPTypeCode) DeepCopy() *NetworkACLICMPTypeCode {
 	if in == nil {
 		return nil
 	}
-	out := new(SecurityGroupStatus)
+	out := new(NetworkACLICMPTypeCode)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Subnet) DeepCopyInto(out *Subnet) {
+func (in *NetworkACLList) DeepCopyInto(out *NetworkACLList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NetworkACL, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
-func (in *Subnet) DeepCopy() *Subnet {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLList.
+func (in *NetworkACLList) DeepCopy() *NetworkACLList {
 	if in == nil {
 		return nil
 	}
-	out := new(Subnet)
+	out := new(NetworkACLList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Subnet) DeepCopyObject() runtime.Object {
+func (in *NetworkACLList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -663,8 +885,34 @@ func (in *Subnet) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetExternalStatus) DeepCopyInto(out *SubnetExternalStatus) {
+func (in *NetworkACLParameters) DeepCopyInto(out *NetworkACLParameters) {
 	*out = *in
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(VPCIDReferencerForNetworkACL)
+		**out = **in
+	}
+	if in.IngressEntries != nil {
+		in, out := &in.IngressEntries, &out.IngressEntries
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EgressEntries != nil {
+		in, out := &in.EgressEntries, &out.EgressEntries
+		*out = make([]NetworkACLEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]NetworkACLAssociation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Tags != nil {
 		in, out := &in.Tags, &out.Tags
 		*out = make([]Tag, len(*in))
@@ -672,151 +920,154 @@ func (in *SubnetExternalStatus) DeepCopyInto(out *SubnetExternalStatus) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetExternalStatus.
-func (in *SubnetExternalStatus) DeepCopy() *SubnetExternalStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLParameters.
+func (in *NetworkACLParameters) DeepCopy() *NetworkACLParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetExternalStatus)
+	out := new(NetworkACLParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetIDReferencer) DeepCopyInto(out *SubnetIDReferencer) {
+func (in *NetworkACLPortRange) DeepCopyInto(out *NetworkACLPortRange) {
 	*out = *in
-	out.LocalObjectReference = in.LocalObjectReference
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetIDReferencer.
-func (in *SubnetIDReferencer) DeepCopy() *SubnetIDReferencer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLPortRange.
+func (in *NetworkACLPortRange) DeepCopy() *NetworkACLPortRange {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetIDReferencer)
+	out := new(NetworkACLPortRange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetIDReferencerForRouteTable) DeepCopyInto(out *SubnetIDReferencerForRouteTable) {
+func (in *NetworkACLSpec) DeepCopyInto(out *NetworkACLSpec) {
 	*out = *in
-	out.SubnetIDReferencer = in.SubnetIDReferencer
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.NetworkACLParameters.DeepCopyInto(&out.NetworkACLParameters)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetIDReferencerForRouteTable.
-func (in *SubnetIDReferencerForRouteTable) DeepCopy() *SubnetIDReferencerForRouteTable {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLSpec.
+func (in *NetworkACLSpec) DeepCopy() *NetworkACLSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetIDReferencerForRouteTable)
+	out := new(NetworkACLSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetList) DeepCopyInto(out *SubnetList) {
+func (in *NetworkACLStatus) DeepCopyInto(out *NetworkACLStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Subnet, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.NetworkACLExternalStatus.DeepCopyInto(&out.NetworkACLExternalStatus)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetList.
-func (in *SubnetList) DeepCopy() *SubnetList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkACLStatus.
+func (in *NetworkACLStatus) DeepCopy() *NetworkACLStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetList)
+	out := new(NetworkACLStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *SubnetList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetParameters) DeepCopyInto(out *SubnetParameters) {
+func (in *PeerVPCIDReferencerForVPCPeeringConnection) DeepCopyInto(out *PeerVPCIDReferencerForVPCPeeringConnection) {
 	*out = *in
-	if in.VPCIDRef != nil {
-		in, out := &in.VPCIDRef, &out.VPCIDRef
-		*out = new(VPCIDReferencerForSubnet)
-		**out = **in
-	}
+	out.VPCIDReferencer = in.VPCIDReferencer
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetParameters.
-func (in *SubnetParameters) DeepCopy() *SubnetParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PeerVPCIDReferencerForVPCPeeringConnection.
+func (in *PeerVPCIDReferencerForVPCPeeringConnection) DeepCopy() *PeerVPCIDReferencerForVPCPeeringConnection {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetParameters)
+	out := new(PeerVPCIDReferencerForVPCPeeringConnection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
+func (in *PrefixListID) DeepCopyInto(out *PrefixListID) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.SubnetParameters.DeepCopyInto(&out.SubnetParameters)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
-func (in *SubnetSpec) DeepCopy() *SubnetSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PrefixListID.
+func (in *PrefixListID) DeepCopy() *PrefixListID {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetSpec)
+	out := new(PrefixListID)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
+func (in *Route) DeepCopyInto(out *Route) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	in.SubnetExternalStatus.DeepCopyInto(&out.SubnetExternalStatus)
+	if in.GatewayIDRef != nil {
+		in, out := &in.GatewayIDRef, &out.GatewayIDRef
+		*out = new(InternetGatewayIDReferencerForRouteTable)
+		**out = **in
+	}
+	if in.NATGatewayIDRef != nil {
+		in, out := &in.NATGatewayIDRef, &out.NATGatewayIDRef
+		*out = new(NATGatewayIDReferencerForRouteTable)
+		**out = **in
+	}
+	if in.TransitGatewayIDRef != nil {
+		in, out := &in.TransitGatewayIDRef, &out.TransitGatewayIDRef
+		*out = new(TransitGatewayIDReferencerForRouteTable)
+		**out = **in
+	}
+	if in.VPCEndpointIDRef != nil {
+		in, out := &in.VPCEndpointIDRef, &out.VPCEndpointIDRef
+		*out = new(VPCEndpointIDReferencerForRouteTable)
+		**out = **in
+	}
+	if in.VPCPeeringConnectionIDRef != nil {
+		in, out := &in.VPCPeeringConnectionIDRef, &out.VPCPeeringConnectionIDRef
+		*out = new(VPCPeeringConnectionIDReferencerForRouteTable)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatus.
-func (in *SubnetStatus) DeepCopy() *SubnetStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
 	if in == nil {
 		return nil
 	}
-	out := new(SubnetStatus)
+	out := new(Route)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Tag) DeepCopyInto(out *Tag) {
+func (in *RouteState) DeepCopyInto(out *RouteState) {
 	*out = *in
+	in.Route.DeepCopyInto(&out.Route)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
-func (in *Tag) DeepCopy() *Tag {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteState.
+func (in *RouteState) DeepCopy() *RouteState {
 	if in == nil {
 		return nil
 	}
-	out := new(Tag)
+	out := new(RouteState)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPC) DeepCopyInto(out *VPC) {
+func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -824,18 +1075,18 @@ func (in *VPC) DeepCopyInto(out *VPC) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPC.
-func (in *VPC) DeepCopy() *VPC {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
+func (in *RouteTable) DeepCopy() *RouteTable {
 	if in == nil {
 		return nil
 	}
-	out := new(VPC)
+	out := new(RouteTable)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VPC) DeepCopyObject() runtime.Object {
+func (in *RouteTable) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -843,14 +1094,1329 @@ func (in *VPC) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VPCExternalStatus) DeepCopyInto(out *VPCExternalStatus) {
+func (in *RouteTableExternalStatus) DeepCopyInto(out *RouteTableExternalStatus) {
 	*out = *in
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make([]Tag, len(*in))
-		copy(*out, *in)
-	}
-}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]RouteState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]AssociationState, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableExternalStatus.
+func (in *RouteTableExternalStatus) DeepCopy() *RouteTableExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableIDReferencer) DeepCopyInto(out *RouteTableIDReferencer) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableIDReferencer.
+func (in *RouteTableIDReferencer) DeepCopy() *RouteTableIDReferencer {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableIDReferencer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableIDReferencerForVPCEndpoint) DeepCopyInto(out *RouteTableIDReferencerForVPCEndpoint) {
+	*out = *in
+	out.RouteTableIDReferencer = in.RouteTableIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableIDReferencerForVPCEndpoint.
+func (in *RouteTableIDReferencerForVPCEndpoint) DeepCopy() *RouteTableIDReferencerForVPCEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableIDReferencerForVPCEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableList) DeepCopyInto(out *RouteTableList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RouteTable, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableList.
+func (in *RouteTableList) DeepCopy() *RouteTableList {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RouteTableList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableParameters) DeepCopyInto(out *RouteTableParameters) {
+	*out = *in
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(VPCIDReferencerForRouteTable)
+		**out = **in
+	}
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make([]Route, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Associations != nil {
+		in, out := &in.Associations, &out.Associations
+		*out = make([]Association, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableParameters.
+func (in *RouteTableParameters) DeepCopy() *RouteTableParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableSpec) DeepCopyInto(out *RouteTableSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.RouteTableParameters.DeepCopyInto(&out.RouteTableParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableSpec.
+func (in *RouteTableSpec) DeepCopy() *RouteTableSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RouteTableStatus) DeepCopyInto(out *RouteTableStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.RouteTableExternalStatus.DeepCopyInto(&out.RouteTableExternalStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTableStatus.
+func (in *RouteTableStatus) DeepCopy() *RouteTableStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RouteTableStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroup.
+func (in *SecurityGroup) DeepCopy() *SecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupExternalStatus) DeepCopyInto(out *SecurityGroupExternalStatus) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupExternalStatus.
+func (in *SecurityGroupExternalStatus) DeepCopy() *SecurityGroupExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupIDReferencer) DeepCopyInto(out *SecurityGroupIDReferencer) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupIDReferencer.
+func (in *SecurityGroupIDReferencer) DeepCopy() *SecurityGroupIDReferencer {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupIDReferencer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupIDReferencerForSecurityGroupRule) DeepCopyInto(out *SecurityGroupIDReferencerForSecurityGroupRule) {
+	*out = *in
+	out.SecurityGroupIDReferencer = in.SecurityGroupIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupIDReferencerForSecurityGroupRule.
+func (in *SecurityGroupIDReferencerForSecurityGroupRule) DeepCopy() *SecurityGroupIDReferencerForSecurityGroupRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupIDReferencerForSecurityGroupRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupIDReferencerForVPCEndpoint) DeepCopyInto(out *SecurityGroupIDReferencerForVPCEndpoint) {
+	*out = *in
+	out.SecurityGroupIDReferencer = in.SecurityGroupIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupIDReferencerForVPCEndpoint.
+func (in *SecurityGroupIDReferencerForVPCEndpoint) DeepCopy() *SecurityGroupIDReferencerForVPCEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupIDReferencerForVPCEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupList) DeepCopyInto(out *SecurityGroupList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SecurityGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupList.
+func (in *SecurityGroupList) DeepCopy() *SecurityGroupList {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SecurityGroupList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupParameters) DeepCopyInto(out *SecurityGroupParameters) {
+	*out = *in
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(VPCIDReferencerForSecurityGroup)
+		**out = **in
+	}
+	if in.IngressPermissions != nil {
+		in, out := &in.IngressPermissions, &out.IngressPermissions
+		*out = make([]IPPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EgressPermissions != nil {
+		in, out := &in.EgressPermissions, &out.EgressPermissions
+		*out = make([]IPPermission, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupParameters.
+func (in *SecurityGroupParameters) DeepCopy() *SecurityGroupParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupSpec) DeepCopyInto(out *SecurityGroupSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.SecurityGroupParameters.DeepCopyInto(&out.SecurityGroupParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupSpec.
+func (in *SecurityGroupSpec) DeepCopy() *SecurityGroupSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SecurityGroupExternalStatus.DeepCopyInto(&out.SecurityGroupExternalStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupStatus.
+func (in *SecurityGroupStatus) DeepCopy() *SecurityGroupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityGroupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Subnet) DeepCopyInto(out *Subnet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Subnet.
+func (in *Subnet) DeepCopy() *Subnet {
+	if in == nil {
+		return nil
+	}
+	out := new(Subnet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Subnet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetExternalStatus) DeepCopyInto(out *SubnetExternalStatus) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6CIDRBlockAssociations != nil {
+		in, out := &in.IPv6CIDRBlockAssociations, &out.IPv6CIDRBlockAssociations
+		*out = make([]CIDRBlockAssociation, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetExternalStatus.
+func (in *SubnetExternalStatus) DeepCopy() *SubnetExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetIDReferencer) DeepCopyInto(out *SubnetIDReferencer) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetIDReferencer.
+func (in *SubnetIDReferencer) DeepCopy() *SubnetIDReferencer {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetIDReferencer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetIDReferencerForNATGateway) DeepCopyInto(out *SubnetIDReferencerForNATGateway) {
+	*out = *in
+	out.SubnetIDReferencer = in.SubnetIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetIDReferencerForNATGateway.
+func (in *SubnetIDReferencerForNATGateway) DeepCopy() *SubnetIDReferencerForNATGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetIDReferencerForNATGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetIDReferencerForNetworkACL) DeepCopyInto(out *SubnetIDReferencerForNetworkACL) {
+	*out = *in
+	out.SubnetIDReferencer = in.SubnetIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetIDReferencerForNetworkACL.
+func (in *SubnetIDReferencerForNetworkACL) DeepCopy() *SubnetIDReferencerForNetworkACL {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetIDReferencerForNetworkACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetIDReferencerForRouteTable) DeepCopyInto(out *SubnetIDReferencerForRouteTable) {
+	*out = *in
+	out.SubnetIDReferencer = in.SubnetIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetIDReferencerForRouteTable.
+func (in *SubnetIDReferencerForRouteTable) DeepCopy() *SubnetIDReferencerForRouteTable {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetIDReferencerForRouteTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetIDReferencerForTransitGatewayVPCAttachment) DeepCopyInto(out *SubnetIDReferencerForTransitGatewayVPCAttachment) {
+	*out = *in
+	out.SubnetIDReferencer = in.SubnetIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetIDReferencerForTransitGatewayVPCAttachment.
+func (in *SubnetIDReferencerForTransitGatewayVPCAttachment) DeepCopy() *SubnetIDReferencerForTransitGatewayVPCAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetIDReferencerForTransitGatewayVPCAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetIDReferencerForVPCEndpoint) DeepCopyInto(out *SubnetIDReferencerForVPCEndpoint) {
+	*out = *in
+	out.SubnetIDReferencer = in.SubnetIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetIDReferencerForVPCEndpoint.
+func (in *SubnetIDReferencerForVPCEndpoint) DeepCopy() *SubnetIDReferencerForVPCEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetIDReferencerForVPCEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetList) DeepCopyInto(out *SubnetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Subnet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetList.
+func (in *SubnetList) DeepCopy() *SubnetList {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SubnetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetParameters) DeepCopyInto(out *SubnetParameters) {
+	*out = *in
+	if in.IPv6CIDRBlock != nil {
+		in, out := &in.IPv6CIDRBlock, &out.IPv6CIDRBlock
+		*out = new(string)
+		**out = **in
+	}
+	if in.MapPublicIPOnLaunch != nil {
+		in, out := &in.MapPublicIPOnLaunch, &out.MapPublicIPOnLaunch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AssignIPv6AddressOnCreation != nil {
+		in, out := &in.AssignIPv6AddressOnCreation, &out.AssignIPv6AddressOnCreation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(VPCIDReferencerForSubnet)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetParameters.
+func (in *SubnetParameters) DeepCopy() *SubnetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.SubnetParameters.DeepCopyInto(&out.SubnetParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
+func (in *SubnetSpec) DeepCopy() *SubnetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.SubnetExternalStatus.DeepCopyInto(&out.SubnetExternalStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatus.
+func (in *SubnetStatus) DeepCopy() *SubnetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Tag) DeepCopyInto(out *Tag) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Tag.
+func (in *Tag) DeepCopy() *Tag {
+	if in == nil {
+		return nil
+	}
+	out := new(Tag)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGateway) DeepCopyInto(out *TransitGateway) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGateway.
+func (in *TransitGateway) DeepCopy() *TransitGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGateway) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayExternalStatus) DeepCopyInto(out *TransitGatewayExternalStatus) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayExternalStatus.
+func (in *TransitGatewayExternalStatus) DeepCopy() *TransitGatewayExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayIDReferencer) DeepCopyInto(out *TransitGatewayIDReferencer) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayIDReferencer.
+func (in *TransitGatewayIDReferencer) DeepCopy() *TransitGatewayIDReferencer {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayIDReferencer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayIDReferencerForRouteTable) DeepCopyInto(out *TransitGatewayIDReferencerForRouteTable) {
+	*out = *in
+	out.TransitGatewayIDReferencer = in.TransitGatewayIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayIDReferencerForRouteTable.
+func (in *TransitGatewayIDReferencerForRouteTable) DeepCopy() *TransitGatewayIDReferencerForRouteTable {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayIDReferencerForRouteTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayIDReferencerForTransitGatewayVPCAttachment) DeepCopyInto(out *TransitGatewayIDReferencerForTransitGatewayVPCAttachment) {
+	*out = *in
+	out.TransitGatewayIDReferencer = in.TransitGatewayIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayIDReferencerForTransitGatewayVPCAttachment.
+func (in *TransitGatewayIDReferencerForTransitGatewayVPCAttachment) DeepCopy() *TransitGatewayIDReferencerForTransitGatewayVPCAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayIDReferencerForTransitGatewayVPCAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayList) DeepCopyInto(out *TransitGatewayList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayList.
+func (in *TransitGatewayList) DeepCopy() *TransitGatewayList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayParameters) DeepCopyInto(out *TransitGatewayParameters) {
+	*out = *in
+	if in.AmazonSideASN != nil {
+		in, out := &in.AmazonSideASN, &out.AmazonSideASN
+		*out = new(int64)
+		**out = **in
+	}
+	if in.AutoAcceptSharedAttachments != nil {
+		in, out := &in.AutoAcceptSharedAttachments, &out.AutoAcceptSharedAttachments
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultRouteTableAssociation != nil {
+		in, out := &in.DefaultRouteTableAssociation, &out.DefaultRouteTableAssociation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultRouteTablePropagation != nil {
+		in, out := &in.DefaultRouteTablePropagation, &out.DefaultRouteTablePropagation
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNSSupport != nil {
+		in, out := &in.DNSSupport, &out.DNSSupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.VPNECMPSupport != nil {
+		in, out := &in.VPNECMPSupport, &out.VPNECMPSupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayParameters.
+func (in *TransitGatewayParameters) DeepCopy() *TransitGatewayParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewaySpec) DeepCopyInto(out *TransitGatewaySpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.TransitGatewayParameters.DeepCopyInto(&out.TransitGatewayParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewaySpec.
+func (in *TransitGatewaySpec) DeepCopy() *TransitGatewaySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewaySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayStatus) DeepCopyInto(out *TransitGatewayStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.TransitGatewayExternalStatus.DeepCopyInto(&out.TransitGatewayExternalStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayStatus.
+func (in *TransitGatewayStatus) DeepCopy() *TransitGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachment) DeepCopyInto(out *TransitGatewayVPCAttachment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachment.
+func (in *TransitGatewayVPCAttachment) DeepCopy() *TransitGatewayVPCAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayVPCAttachment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentExternalStatus) DeepCopyInto(out *TransitGatewayVPCAttachmentExternalStatus) {
+	*out = *in
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentExternalStatus.
+func (in *TransitGatewayVPCAttachmentExternalStatus) DeepCopy() *TransitGatewayVPCAttachmentExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentList) DeepCopyInto(out *TransitGatewayVPCAttachmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TransitGatewayVPCAttachment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentList.
+func (in *TransitGatewayVPCAttachmentList) DeepCopy() *TransitGatewayVPCAttachmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TransitGatewayVPCAttachmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentParameters) DeepCopyInto(out *TransitGatewayVPCAttachmentParameters) {
+	*out = *in
+	if in.TransitGatewayIDRef != nil {
+		in, out := &in.TransitGatewayIDRef, &out.TransitGatewayIDRef
+		*out = new(TransitGatewayIDReferencerForTransitGatewayVPCAttachment)
+		**out = **in
+	}
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(VPCIDReferencerForTransitGatewayVPCAttachment)
+		**out = **in
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]*SubnetIDReferencerForTransitGatewayVPCAttachment, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SubnetIDReferencerForTransitGatewayVPCAttachment)
+				**out = **in
+			}
+		}
+	}
+	if in.DNSSupport != nil {
+		in, out := &in.DNSSupport, &out.DNSSupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.IPv6Support != nil {
+		in, out := &in.IPv6Support, &out.IPv6Support
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentParameters.
+func (in *TransitGatewayVPCAttachmentParameters) DeepCopy() *TransitGatewayVPCAttachmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentSpec) DeepCopyInto(out *TransitGatewayVPCAttachmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.TransitGatewayVPCAttachmentParameters.DeepCopyInto(&out.TransitGatewayVPCAttachmentParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentSpec.
+func (in *TransitGatewayVPCAttachmentSpec) DeepCopy() *TransitGatewayVPCAttachmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransitGatewayVPCAttachmentStatus) DeepCopyInto(out *TransitGatewayVPCAttachmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.TransitGatewayVPCAttachmentExternalStatus.DeepCopyInto(&out.TransitGatewayVPCAttachmentExternalStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransitGatewayVPCAttachmentStatus.
+func (in *TransitGatewayVPCAttachmentStatus) DeepCopy() *TransitGatewayVPCAttachmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TransitGatewayVPCAttachmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UserIDGroupPair) DeepCopyInto(out *UserIDGroupPair) {
+	*out = *in
+	if in.GroupIDRef != nil {
+		in, out := &in.GroupIDRef, &out.GroupIDRef
+		*out = new(SecurityGroupIDReferencerForSecurityGroupRule)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserIDGroupPair.
+func (in *UserIDGroupPair) DeepCopy() *UserIDGroupPair {
+	if in == nil {
+		return nil
+	}
+	out := new(UserIDGroupPair)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPC) DeepCopyInto(out *VPC) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPC.
+func (in *VPC) DeepCopy() *VPC {
+	if in == nil {
+		return nil
+	}
+	out := new(VPC)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPC) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpoint) DeepCopyInto(out *VPCEndpoint) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpoint.
+func (in *VPCEndpoint) DeepCopy() *VPCEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCEndpoint) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointExternalStatus) DeepCopyInto(out *VPCEndpointExternalStatus) {
+	*out = *in
+	if in.DNSEntries != nil {
+		in, out := &in.DNSEntries, &out.DNSEntries
+		*out = make([]DNSEntry, len(*in))
+		copy(*out, *in)
+	}
+	if in.NetworkInterfaceIDs != nil {
+		in, out := &in.NetworkInterfaceIDs, &out.NetworkInterfaceIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointExternalStatus.
+func (in *VPCEndpointExternalStatus) DeepCopy() *VPCEndpointExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointIDReferencer) DeepCopyInto(out *VPCEndpointIDReferencer) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointIDReferencer.
+func (in *VPCEndpointIDReferencer) DeepCopy() *VPCEndpointIDReferencer {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointIDReferencer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointIDReferencerForRouteTable) DeepCopyInto(out *VPCEndpointIDReferencerForRouteTable) {
+	*out = *in
+	out.VPCEndpointIDReferencer = in.VPCEndpointIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointIDReferencerForRouteTable.
+func (in *VPCEndpointIDReferencerForRouteTable) DeepCopy() *VPCEndpointIDReferencerForRouteTable {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointIDReferencerForRouteTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointList) DeepCopyInto(out *VPCEndpointList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPCEndpoint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointList.
+func (in *VPCEndpointList) DeepCopy() *VPCEndpointList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCEndpointList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointParameters) DeepCopyInto(out *VPCEndpointParameters) {
+	*out = *in
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(VPCIDReferencerForVPCEndpoint)
+		**out = **in
+	}
+	if in.PrivateDNSEnabled != nil {
+		in, out := &in.PrivateDNSEnabled, &out.PrivateDNSEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RouteTableIDs != nil {
+		in, out := &in.RouteTableIDs, &out.RouteTableIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RouteTableIDRefs != nil {
+		in, out := &in.RouteTableIDRefs, &out.RouteTableIDRefs
+		*out = make([]*RouteTableIDReferencerForVPCEndpoint, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RouteTableIDReferencerForVPCEndpoint)
+				**out = **in
+			}
+		}
+	}
+	if in.SubnetIDs != nil {
+		in, out := &in.SubnetIDs, &out.SubnetIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubnetIDRefs != nil {
+		in, out := &in.SubnetIDRefs, &out.SubnetIDRefs
+		*out = make([]*SubnetIDReferencerForVPCEndpoint, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SubnetIDReferencerForVPCEndpoint)
+				**out = **in
+			}
+		}
+	}
+	if in.SecurityGroupIDs != nil {
+		in, out := &in.SecurityGroupIDs, &out.SecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SecurityGroupIDRefs != nil {
+		in, out := &in.SecurityGroupIDRefs, &out.SecurityGroupIDRefs
+		*out = make([]*SecurityGroupIDReferencerForVPCEndpoint, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SecurityGroupIDReferencerForVPCEndpoint)
+				**out = **in
+			}
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointParameters.
+func (in *VPCEndpointParameters) DeepCopy() *VPCEndpointParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointSpec) DeepCopyInto(out *VPCEndpointSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.VPCEndpointParameters.DeepCopyInto(&out.VPCEndpointParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointSpec.
+func (in *VPCEndpointSpec) DeepCopy() *VPCEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCEndpointStatus) DeepCopyInto(out *VPCEndpointStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.VPCEndpointExternalStatus.DeepCopyInto(&out.VPCEndpointExternalStatus)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCEndpointStatus.
+func (in *VPCEndpointStatus) DeepCopy() *VPCEndpointStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCEndpointStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCExternalStatus) DeepCopyInto(out *VPCExternalStatus) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+	if in.CIDRBlockAssociations != nil {
+		in, out := &in.CIDRBlockAssociations, &out.CIDRBlockAssociations
+		*out = make([]CIDRBlockAssociation, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPv6CIDRBlockAssociations != nil {
+		in, out := &in.IPv6CIDRBlockAssociations, &out.IPv6CIDRBlockAssociations
+		*out = make([]CIDRBlockAssociation, len(*in))
+		copy(*out, *in)
+	}
+}
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCExternalStatus.
 func (in *VPCExternalStatus) DeepCopy() *VPCExternalStatus {
@@ -894,6 +2460,22 @@ func (in *VPCIDReferencerForInternetGateway) DeepCopy() *VPCIDReferencerForInter
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCIDReferencerForNetworkACL) DeepCopyInto(out *VPCIDReferencerForNetworkACL) {
+	*out = *in
+	out.VPCIDReferencer = in.VPCIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCIDReferencerForNetworkACL.
+func (in *VPCIDReferencerForNetworkACL) DeepCopy() *VPCIDReferencerForNetworkACL {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCIDReferencerForNetworkACL)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCIDReferencerForRouteTable) DeepCopyInto(out *VPCIDReferencerForRouteTable) {
 	*out = *in
@@ -942,6 +2524,54 @@ func (in *VPCIDReferencerForSubnet) DeepCopy() *VPCIDReferencerForSubnet {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCIDReferencerForTransitGatewayVPCAttachment) DeepCopyInto(out *VPCIDReferencerForTransitGatewayVPCAttachment) {
+	*out = *in
+	out.VPCIDReferencer = in.VPCIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCIDReferencerForTransitGatewayVPCAttachment.
+func (in *VPCIDReferencerForTransitGatewayVPCAttachment) DeepCopy() *VPCIDReferencerForTransitGatewayVPCAttachment {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCIDReferencerForTransitGatewayVPCAttachment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCIDReferencerForVPCEndpoint) DeepCopyInto(out *VPCIDReferencerForVPCEndpoint) {
+	*out = *in
+	out.VPCIDReferencer = in.VPCIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCIDReferencerForVPCEndpoint.
+func (in *VPCIDReferencerForVPCEndpoint) DeepCopy() *VPCIDReferencerForVPCEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCIDReferencerForVPCEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCIDReferencerForVPCPeeringConnection) DeepCopyInto(out *VPCIDReferencerForVPCPeeringConnection) {
+	*out = *in
+	out.VPCIDReferencer = in.VPCIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCIDReferencerForVPCPeeringConnection.
+func (in *VPCIDReferencerForVPCPeeringConnection) DeepCopy() *VPCIDReferencerForVPCPeeringConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCIDReferencerForVPCPeeringConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCList) DeepCopyInto(out *VPCList) {
 	*out = *in
@@ -977,6 +2607,31 @@ func (in *VPCList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCParameters) DeepCopyInto(out *VPCParameters) {
 	*out = *in
+	if in.EnableDNSSupport != nil {
+		in, out := &in.EnableDNSSupport, &out.EnableDNSSupport
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EnableDNSHostNames != nil {
+		in, out := &in.EnableDNSHostNames, &out.EnableDNSHostNames
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdditionalCIDRBlocks != nil {
+		in, out := &in.AdditionalCIDRBlocks, &out.AdditionalCIDRBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AmazonProvidedIPv6CIDRBlock != nil {
+		in, out := &in.AmazonProvidedIPv6CIDRBlock, &out.AmazonProvidedIPv6CIDRBlock
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCParameters.
@@ -989,11 +2644,191 @@ func (in *VPCParameters) DeepCopy() *VPCParameters {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnection) DeepCopyInto(out *VPCPeeringConnection) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnection.
+func (in *VPCPeeringConnection) DeepCopy() *VPCPeeringConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCPeeringConnection) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionExternalStatus) DeepCopyInto(out *VPCPeeringConnectionExternalStatus) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionExternalStatus.
+func (in *VPCPeeringConnectionExternalStatus) DeepCopy() *VPCPeeringConnectionExternalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionExternalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionIDReferencer) DeepCopyInto(out *VPCPeeringConnectionIDReferencer) {
+	*out = *in
+	out.LocalObjectReference = in.LocalObjectReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionIDReferencer.
+func (in *VPCPeeringConnectionIDReferencer) DeepCopy() *VPCPeeringConnectionIDReferencer {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionIDReferencer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionIDReferencerForRouteTable) DeepCopyInto(out *VPCPeeringConnectionIDReferencerForRouteTable) {
+	*out = *in
+	out.VPCPeeringConnectionIDReferencer = in.VPCPeeringConnectionIDReferencer
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionIDReferencerForRouteTable.
+func (in *VPCPeeringConnectionIDReferencerForRouteTable) DeepCopy() *VPCPeeringConnectionIDReferencerForRouteTable {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionIDReferencerForRouteTable)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionList) DeepCopyInto(out *VPCPeeringConnectionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VPCPeeringConnection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionList.
+func (in *VPCPeeringConnectionList) DeepCopy() *VPCPeeringConnectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VPCPeeringConnectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionParameters) DeepCopyInto(out *VPCPeeringConnectionParameters) {
+	*out = *in
+	if in.VPCIDRef != nil {
+		in, out := &in.VPCIDRef, &out.VPCIDRef
+		*out = new(VPCIDReferencerForVPCPeeringConnection)
+		**out = **in
+	}
+	if in.PeerVPCIDRef != nil {
+		in, out := &in.PeerVPCIDRef, &out.PeerVPCIDRef
+		*out = new(PeerVPCIDReferencerForVPCPeeringConnection)
+		**out = **in
+	}
+	if in.AccepterProviderReference != nil {
+		in, out := &in.AccepterProviderReference, &out.AccepterProviderReference
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make([]Tag, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionParameters.
+func (in *VPCPeeringConnectionParameters) DeepCopy() *VPCPeeringConnectionParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionSpec) DeepCopyInto(out *VPCPeeringConnectionSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.VPCPeeringConnectionParameters.DeepCopyInto(&out.VPCPeeringConnectionParameters)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionSpec.
+func (in *VPCPeeringConnectionSpec) DeepCopy() *VPCPeeringConnectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VPCPeeringConnectionStatus) DeepCopyInto(out *VPCPeeringConnectionStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.VPCPeeringConnectionExternalStatus = in.VPCPeeringConnectionExternalStatus
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCPeeringConnectionStatus.
+func (in *VPCPeeringConnectionStatus) DeepCopy() *VPCPeeringConnectionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VPCPeeringConnectionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPCSpec) DeepCopyInto(out *VPCSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	out.VPCParameters = in.VPCParameters
+	in.VPCParameters.DeepCopyInto(&out.VPCParameters)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VPCSpec.
@@ -23,6 +23,66 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
+// GetBindingPhase of this ElasticIP.
+func (mg *ElasticIP) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this ElasticIP.
+func (mg *ElasticIP) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetCondition of this ElasticIP.
+func (mg *ElasticIP) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetNonPortableClassReference of this ElasticIP.
+func (mg *ElasticIP) GetNonPortableClassReference() *corev1.ObjectReference {
+	return mg.Spec.NonPortableClassReference
+}
+
+// GetReclaimPolicy of this ElasticIP.
+func (mg *ElasticIP) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this ElasticIP.
+func (mg *ElasticIP) GetWriteConnectionSecretToReference() corev1.LocalObjectReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this ElasticIP.
+func (mg *ElasticIP) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this ElasticIP.
+func (mg *ElasticIP) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetConditions of this ElasticIP.
+func (mg *ElasticIP) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetNonPortableClassReference of this ElasticIP.
+func (mg *ElasticIP) SetNonPortableClassReference(r *corev1.ObjectReference) {
+	mg.Spec.NonPortableClassReference = r
+}
+
+// SetReclaimPolicy of this ElasticIP.
+func (mg *ElasticIP) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this ElasticIP.
+func (mg *ElasticIP) SetWriteConnectionSecretToReference(r corev1.LocalObjectReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
 // GetBindingPhase of this InternetGateway.
 func (mg *InternetGateway) GetBindingPhase() runtimev1alpha1.BindingPhase {
 	return mg.Status.GetBindingPhase()
@@ -83,6 +143,126 @@ func (mg *InternetGateway) SetWriteConnectionSecretToReference(r corev1.LocalObj
 	mg.Spec.WriteConnectionSecretToReference = r
 }
 
+// GetBindingPhase of this NATGateway.
+func (mg *NATGateway) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this NATGateway.
+func (mg *NATGateway) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetCondition of this NATGateway.
+func (mg *NATGateway) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetNonPortableClassReference of this NATGateway.
+func (mg *NATGateway) GetNonPortableClassReference() *corev1.ObjectReference {
+	return mg.Spec.NonPortableClassReference
+}
+
+// GetReclaimPolicy of this NATGateway.
+func (mg *NATGateway) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this NATGateway.
+func (mg *NATGateway) GetWriteConnectionSecretToReference() corev1.LocalObjectReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this NATGateway.
+func (mg *NATGateway) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this NATGateway.
+func (mg *NATGateway) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetConditions of this NATGateway.
+func (mg *NATGateway) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetNonPortableClassReference of this NATGateway.
+func (mg *NATGateway) SetNonPortableClassReference(r *corev1.ObjectReference) {
+	mg.Spec.NonPortableClassReference = r
+}
+
+// SetReclaimPolicy of this NATGateway.
+func (mg *NATGateway) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this NATGateway.
+func (mg *NATGateway) SetWriteConnectionSecretToReference(r corev1.LocalObjectReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetBindingPhase of this NetworkACL.
+func (mg *NetworkACL) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this NetworkACL.
+func (mg *NetworkACL) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetCondition of this NetworkACL.
+func (mg *NetworkACL) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetNonPortableClassReference of this NetworkACL.
+func (mg *NetworkACL) GetNonPortableClassReference() *corev1.ObjectReference {
+	return mg.Spec.NonPortableClassReference
+}
+
+// GetReclaimPolicy of this NetworkACL.
+func (mg *NetworkACL) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this NetworkACL.
+func (mg *NetworkACL) GetWriteConnectionSecretToReference() corev1.LocalObjectReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this NetworkACL.
+func (mg *NetworkACL) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this NetworkACL.
+func (mg *NetworkACL) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetConditions of this NetworkACL.
+func (mg *NetworkACL) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetNonPortableClassReference of this NetworkACL.
+func (mg *NetworkACL) SetNonPortableClassReference(r *corev1.ObjectReference) {
+	mg.Spec.NonPortableClassReference = r
+}
+
+// SetReclaimPolicy of this NetworkACL.
+func (mg *NetworkACL) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this NetworkACL.
+func (mg *NetworkACL) SetWriteConnectionSecretToReference(r corev1.LocalObjectReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
 // GetBindingPhase of this RouteTable.
 func (mg *RouteTable) GetBindingPhase() runtimev1alpha1.BindingPhase {
 	return mg.Status.GetBindingPhase()
@@ -263,6 +443,126 @@ func (mg *Subnet) SetWriteConnectionSecretToReference(r corev1.LocalObjectRefere
 	mg.Spec.WriteConnectionSecretToReference = r
 }
 
+// GetBindingPhase of this TransitGateway.
+func (mg *TransitGateway) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this TransitGateway.
+func (mg *TransitGateway) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetCondition of this TransitGateway.
+func (mg *TransitGateway) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetNonPortableClassReference of this TransitGateway.
+func (mg *TransitGateway) GetNonPortableClassReference() *corev1.ObjectReference {
+	return mg.Spec.NonPortableClassReference
+}
+
+// GetReclaimPolicy of this TransitGateway.
+func (mg *TransitGateway) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this TransitGateway.
+func (mg *TransitGateway) GetWriteConnectionSecretToReference() corev1.LocalObjectReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this TransitGateway.
+func (mg *TransitGateway) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this TransitGateway.
+func (mg *TransitGateway) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetConditions of this TransitGateway.
+func (mg *TransitGateway) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetNonPortableClassReference of this TransitGateway.
+func (mg *TransitGateway) SetNonPortableClassReference(r *corev1.ObjectReference) {
+	mg.Spec.NonPortableClassReference = r
+}
+
+// SetReclaimPolicy of this TransitGateway.
+func (mg *TransitGateway) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this TransitGateway.
+func (mg *TransitGateway) SetWriteConnectionSecretToReference(r corev1.LocalObjectReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetBindingPhase of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetCondition of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetNonPortableClassReference of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) GetNonPortableClassReference() *corev1.ObjectReference {
+	return mg.Spec.NonPortableClassReference
+}
+
+// GetReclaimPolicy of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) GetWriteConnectionSecretToReference() corev1.LocalObjectReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetConditions of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetNonPortableClassReference of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) SetNonPortableClassReference(r *corev1.ObjectReference) {
+	mg.Spec.NonPortableClassReference = r
+}
+
+// SetReclaimPolicy of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this TransitGatewayVPCAttachment.
+func (mg *TransitGatewayVPCAttachment) SetWriteConnectionSecretToReference(r corev1.LocalObjectReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
 // GetBindingPhase of this VPC.
 func (mg *VPC) GetBindingPhase() runtimev1alpha1.BindingPhase {
 	return mg.Status.GetBindingPhase()
@@ -322,3 +622,123 @@ func (mg *VPC) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
 func (mg *VPC) SetWriteConnectionSecretToReference(r corev1.LocalObjectReference) {
 	mg.Spec.WriteConnectionSecretToReference = r
 }
+
+// GetBindingPhase of this VPCEndpoint.
+func (mg *VPCEndpoint) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this VPCEndpoint.
+func (mg *VPCEndpoint) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetCondition of this VPCEndpoint.
+func (mg *VPCEndpoint) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetNonPortableClassReference of this VPCEndpoint.
+func (mg *VPCEndpoint) GetNonPortableClassReference() *corev1.ObjectReference {
+	return mg.Spec.NonPortableClassReference
+}
+
+// GetReclaimPolicy of this VPCEndpoint.
+func (mg *VPCEndpoint) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this VPCEndpoint.
+func (mg *VPCEndpoint) GetWriteConnectionSecretToReference() corev1.LocalObjectReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this VPCEndpoint.
+func (mg *VPCEndpoint) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this VPCEndpoint.
+func (mg *VPCEndpoint) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetConditions of this VPCEndpoint.
+func (mg *VPCEndpoint) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetNonPortableClassReference of this VPCEndpoint.
+func (mg *VPCEndpoint) SetNonPortableClassReference(r *corev1.ObjectReference) {
+	mg.Spec.NonPortableClassReference = r
+}
+
+// SetReclaimPolicy of this VPCEndpoint.
+func (mg *VPCEndpoint) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this VPCEndpoint.
+func (mg *VPCEndpoint) SetWriteConnectionSecretToReference(r corev1.LocalObjectReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
+
+// GetBindingPhase of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) GetBindingPhase() runtimev1alpha1.BindingPhase {
+	return mg.Status.GetBindingPhase()
+}
+
+// GetClaimReference of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) GetClaimReference() *corev1.ObjectReference {
+	return mg.Spec.ClaimReference
+}
+
+// GetCondition of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) GetCondition(ct runtimev1alpha1.ConditionType) runtimev1alpha1.Condition {
+	return mg.Status.GetCondition(ct)
+}
+
+// GetNonPortableClassReference of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) GetNonPortableClassReference() *corev1.ObjectReference {
+	return mg.Spec.NonPortableClassReference
+}
+
+// GetReclaimPolicy of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) GetReclaimPolicy() runtimev1alpha1.ReclaimPolicy {
+	return mg.Spec.ReclaimPolicy
+}
+
+// GetWriteConnectionSecretToReference of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) GetWriteConnectionSecretToReference() corev1.LocalObjectReference {
+	return mg.Spec.WriteConnectionSecretToReference
+}
+
+// SetBindingPhase of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) SetBindingPhase(p runtimev1alpha1.BindingPhase) {
+	mg.Status.SetBindingPhase(p)
+}
+
+// SetClaimReference of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) SetClaimReference(r *corev1.ObjectReference) {
+	mg.Spec.ClaimReference = r
+}
+
+// SetConditions of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) SetConditions(c ...runtimev1alpha1.Condition) {
+	mg.Status.SetConditions(c...)
+}
+
+// SetNonPortableClassReference of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) SetNonPortableClassReference(r *corev1.ObjectReference) {
+	mg.Spec.NonPortableClassReference = r
+}
+
+// SetReclaimPolicy of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) SetReclaimPolicy(r runtimev1alpha1.ReclaimPolicy) {
+	mg.Spec.ReclaimPolicy = r
+}
+
+// SetWriteConnectionSecretToReference of this VPCPeeringConnection.
+func (mg *VPCPeeringConnection) SetWriteConnectionSecretToReference(r corev1.LocalObjectReference) {
+	mg.Spec.WriteConnectionSecretToReference = r
+}
@@ -36,7 +36,8 @@ type S3BucketParameters struct {
 	// +optional
 	NameFormat string `json:"nameFormat,omitempty"`
 
-	// Region of the bucket.
+	// Region of the bucket. It cannot be changed after the bucket is created.
+	// +immutable
 	Region string `json:"region"`
 
 	// CannedACL applies a standard AWS built-in ACL for common bucket use
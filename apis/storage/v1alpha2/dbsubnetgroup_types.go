@@ -87,6 +87,13 @@ type DBSubnetGroupParameters struct {
 	// A list of tags. For more information, see Tagging Amazon RDS Resources (http://docs.aws.amazon.com/AmazonRDS/latest/UserGuide/USER_Tagging.html)
 	// in the Amazon RDS User Guide.
 	Tags []Tag `json:"tags,omitempty"`
+
+	// Region in which the DBSubnetGroup will be created. Defaults to the region of
+	// the referenced Provider. It cannot be changed after the DBSubnetGroup is
+	// created.
+	// +immutable
+	// +optional
+	Region string `json:"region,omitempty"`
 }
 
 // A DBSubnetGroupSpec defines the desired state of a DBSubnetGroup.
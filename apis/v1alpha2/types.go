@@ -19,6 +19,29 @@ package v1alpha2
 import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+)
+
+// A CredentialsSource is a source from which a Provider may load the
+// credentials it uses to authenticate to AWS.
+type CredentialsSource string
+
+// Credentials sources.
+const (
+	// CredentialsSourceSecret loads INI encoded credentials from the
+	// Provider's credentials secret.
+	CredentialsSourceSecret CredentialsSource = "Secret"
+
+	// CredentialsSourceInjectedIdentity loads credentials from the ambient
+	// environment of the stack's pod, i.e. environment variables, shared
+	// configuration files, or the EC2 instance profile of the node.
+	CredentialsSourceInjectedIdentity CredentialsSource = "InjectedIdentity"
+
+	// CredentialsSourceWebIdentity exchanges a web identity token, such as a
+	// projected Kubernetes service account token (IRSA), for credentials via
+	// STS AssumeRoleWithWebIdentity.
+	CredentialsSourceWebIdentity CredentialsSource = "WebIdentity"
 )
 
 // A ProviderSpec defines the desired state of a Provider.
@@ -27,9 +50,70 @@ type ProviderSpec struct {
 	// Region for managed resources created using this AWS provider.
 	Region string `json:"region"`
 
+	// CredentialsSource from which this provider loads its credentials.
+	// Defaults to Secret.
+	// +optional
+	// +kubebuilder:validation:Enum=Secret;InjectedIdentity;WebIdentity
+	CredentialsSource CredentialsSource `json:"credentialsSource,omitempty"`
+
 	// A Secret containing INI encoded credentials for an AWS IAM role
-	// that will be used to authenticate to this AWS account.
-	Secret corev1.SecretKeySelector `json:"credentialsSecretRef"`
+	// that will be used to authenticate to this AWS account. Required when
+	// the credentials source is Secret.
+	// +optional
+	Secret corev1.SecretKeySelector `json:"credentialsSecretRef,omitempty"`
+
+	// Profile within the INI encoded credentials secret from which to load
+	// credentials. Defaults to the default profile.
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// WebIdentityTokenFile is the path to a web identity token that will be
+	// exchanged for credentials when the credentials source is WebIdentity.
+	// Defaults to the value of the AWS_WEB_IDENTITY_TOKEN_FILE environment
+	// variable, which is injected into pods using IAM roles for service
+	// accounts.
+	// +optional
+	WebIdentityTokenFile string `json:"webIdentityTokenFile,omitempty"`
+
+	// AssumeRoleARN is the ARN of an IAM role to assume. When the credentials
+	// source is WebIdentity this is the role assumed using the web identity
+	// token, and defaults to the value of the AWS_ROLE_ARN environment
+	// variable. For other credentials sources the role is assumed using the
+	// loaded credentials.
+	// +optional
+	AssumeRoleARN string `json:"assumeRoleARN,omitempty"`
+
+	// ExternalID to pass when assuming AssumeRoleARN.
+	// +optional
+	ExternalID string `json:"externalID,omitempty"`
+
+	// SessionName to use when assuming AssumeRoleARN.
+	// +optional
+	SessionName string `json:"sessionName,omitempty"`
+}
+
+// A ProviderStatus reflects the observed state of a Provider.
+type ProviderStatus struct {
+	runtimev1alpha1.ConditionedStatus `json:",inline"`
+
+	// AccountID of the AWS account this Provider's credentials belong to.
+	AccountID string `json:"accountId,omitempty"`
+
+	// ARN of the IAM identity this Provider authenticates as.
+	ARN string `json:"arn,omitempty"`
+
+	// LastVerifiedTime is the last time this Provider's credentials were
+	// successfully verified.
+	LastVerifiedTime *metav1.Time `json:"lastVerifiedTime,omitempty"`
+}
+
+// GetCredentialsSource returns the credentials source of this Provider,
+// defaulting to Secret.
+func (p *Provider) GetCredentialsSource() CredentialsSource {
+	if p.Spec.CredentialsSource == "" {
+		return CredentialsSourceSecret
+	}
+	return p.Spec.CredentialsSource
 }
 
 // +kubebuilder:object:root=true
@@ -37,13 +121,18 @@ type ProviderSpec struct {
 // A Provider configures an AWS 'provider', i.e. a connection to a particular
 // AWS account using a particular AWS IAM role.
 // +kubebuilder:printcolumn:name="REGION",type="string",JSONPath=".spec.region"
+// +kubebuilder:printcolumn:name="SOURCE",type="string",JSONPath=".spec.credentialsSource"
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentialsSecretRef.name",priority=1
+// +kubebuilder:printcolumn:name="ACCOUNT-ID",type="string",JSONPath=".status.accountId",priority=1
+// +kubebuilder:subresource:status
 type Provider struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec ProviderSpec `json:"spec,omitempty"`
+	Spec   ProviderSpec   `json:"spec,omitempty"`
+	Status ProviderStatus `json:"status,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -30,6 +30,7 @@ func (in *Provider) DeepCopyInto(out *Provider) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Provider.
@@ -97,3 +98,23 @@ func (in *ProviderSpec) DeepCopy() *ProviderSpec {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderStatus) DeepCopyInto(out *ProviderStatus) {
+	*out = *in
+	in.ConditionedStatus.DeepCopyInto(&out.ConditionedStatus)
+	if in.LastVerifiedTime != nil {
+		in, out := &in.LastVerifiedTime, &out.LastVerifiedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderStatus.
+func (in *ProviderStatus) DeepCopy() *ProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
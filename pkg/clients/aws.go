@@ -17,20 +17,48 @@ limitations under the License.
 package aws
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/external"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/aws/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/go-ini/ini"
-	"k8s.io/client-go/kubernetes"
+	"github.com/pkg/errors"
 
 	"github.com/crossplaneio/stack-aws/apis/v1alpha2"
-
-	"github.com/crossplaneio/crossplane-runtime/pkg/util"
 )
 
 // DefaultSection for INI files.
 const DefaultSection = ini.DefaultSection
 
+// Environment variables injected into pods that use IAM roles for service
+// accounts.
+const (
+	WebIdentityTokenFileEnvVar = "AWS_WEB_IDENTITY_TOKEN_FILE"
+	RoleARNEnvVar              = "AWS_ROLE_ARN"
+)
+
+// WebIdentityProviderName is the source reported by credentials retrieved by a
+// WebIdentityRoleProvider.
+const WebIdentityProviderName = "WebIdentityRoleProvider"
+
+// credentialsExpiryWindow is how long before their actual expiry temporary
+// credentials are considered expired and refreshed.
+const credentialsExpiryWindow = 1 * time.Minute
+
+// Error strings.
+const (
+	errUnknownCredentialsSource  = "unknown credentials source %q"
+	errNoWebIdentityTokenFile    = "no web identity token file specified"
+	errNoWebIdentityRoleARN      = "no role ARN specified for web identity"
+	errReadWebIdentityToken      = "cannot read web identity token file"
+	errAssumeRoleWithWebIdentity = "cannot assume role with web identity"
+)
+
 // A FieldOption determines how common Go types are translated to the types
 // required by the AWS Go SDK.
 type FieldOption int
@@ -51,41 +79,57 @@ const (
 // aws_access_key_id = <YOUR_ACCESS_KEY_ID>
 // aws_secret_access_key = <YOUR_SECRET_ACCESS_KEY>
 func CredentialsIDSecret(data []byte, profile string) (string, string, error) {
+	creds, err := CredentialsFromProfile(data, profile)
+	return creds.AccessKeyID, creds.SecretAccessKey, err
+}
+
+// CredentialsFromProfile retrieves AWS credentials from the data which
+// contains aws credentials under given profile. AWS_SESSION_TOKEN is optional,
+// and is only required for temporary credentials.
+// Example:
+// [default]
+// aws_access_key_id = <YOUR_ACCESS_KEY_ID>
+// aws_secret_access_key = <YOUR_SECRET_ACCESS_KEY>
+// aws_session_token = <YOUR_SESSION_TOKEN>
+func CredentialsFromProfile(data []byte, profile string) (aws.Credentials, error) {
 	config, err := ini.InsensitiveLoad(data)
 	if err != nil {
-		return "", "", err
+		return aws.Credentials{}, err
 	}
 
 	iniProfile, err := config.GetSection(profile)
 	if err != nil {
-		return "", "", err
+		return aws.Credentials{}, err
 	}
 
 	id, err := iniProfile.GetKey(external.AWSAccessKeyIDEnvVar)
 	if err != nil {
-		return "", "", err
+		return aws.Credentials{}, err
 	}
 
 	secret, err := iniProfile.GetKey(external.AWSSecreteAccessKeyEnvVar)
 	if err != nil {
-		return "", "", err
+		return aws.Credentials{}, err
+	}
+
+	creds := aws.Credentials{
+		AccessKeyID:     id.Value(),
+		SecretAccessKey: secret.Value(),
+	}
+	if iniProfile.HasKey(external.AWSSessionTokenEnvVar) {
+		creds.SessionToken = iniProfile.Key(external.AWSSessionTokenEnvVar).Value()
 	}
 
-	return id.Value(), secret.Value(), err
+	return creds, nil
 }
 
 // LoadConfig - AWS configuration which can be used to issue requests against AWS API
 func LoadConfig(data []byte, profile, region string) (*aws.Config, error) {
-	id, secret, err := CredentialsIDSecret(data, profile)
+	creds, err := CredentialsFromProfile(data, profile)
 	if err != nil {
 		return nil, err
 	}
 
-	creds := aws.Credentials{
-		AccessKeyID:     id,
-		SecretAccessKey: secret,
-	}
-
 	shared := external.SharedConfig{
 		Credentials: creds,
 		Region:      region,
@@ -95,22 +139,160 @@ func LoadConfig(data []byte, profile, region string) (*aws.Config, error) {
 	return &config, err
 }
 
-// ValidateConfig - validates AWS configuration by issuing list s3 buckets request
-// TODO: find a better way to validate credentials
-func ValidateConfig(config *aws.Config) error {
-	svc := s3.New(*config)
-	_, err := svc.ListBucketsRequest(nil).Send()
-	return err
+// LoadAmbientConfig returns an AWS configuration for the supplied region that
+// resolves credentials from the ambient environment, i.e. environment
+// variables, shared configuration files, or the EC2 instance profile.
+func LoadAmbientConfig(region string) (*aws.Config, error) {
+	config, err := external.LoadDefaultAWSConfig(external.WithRegion(region))
+	return &config, err
 }
 
-// Config - crate AWS Config based on credentials data using [default] profile
-func Config(client kubernetes.Interface, p *v1alpha2.Provider) (*aws.Config, error) {
-	data, err := util.SecretData(client, p.Namespace, p.Spec.Secret)
+// LoadWebIdentityConfig returns an AWS configuration for the supplied region
+// that exchanges the web identity token at tokenFile for credentials of the
+// supplied role.
+func LoadWebIdentityConfig(tokenFile, roleARN, sessionName, region string) (*aws.Config, error) {
+	if tokenFile == "" {
+		return nil, errors.New(errNoWebIdentityTokenFile)
+	}
+	if roleARN == "" {
+		return nil, errors.New(errNoWebIdentityRoleARN)
+	}
+
+	config, err := external.LoadDefaultAWSConfig(external.WithRegion(region))
 	if err != nil {
 		return nil, err
 	}
 
-	return LoadConfig(data, DefaultSection, p.Spec.Region)
+	// AssumeRoleWithWebIdentity requests are unsigned, so the STS client does
+	// not need any credentials of its own.
+	stsConfig := config.Copy()
+	stsConfig.Credentials = aws.AnonymousCredentials
+	config.Credentials = NewWebIdentityRoleProvider(sts.New(stsConfig), roleARN, sessionName, tokenFile)
+	return &config, nil
+}
+
+// AssumeRole returns a copy of the supplied AWS configuration that uses its
+// credentials to assume the supplied role.
+func AssumeRole(config *aws.Config, roleARN, externalID, sessionName string) *aws.Config {
+	cfg := config.Copy()
+	p := stscreds.NewAssumeRoleProvider(sts.New(*config), roleARN)
+	p.ExternalID = String(externalID)
+	p.RoleSessionName = sessionName
+	p.ExpiryWindow = credentialsExpiryWindow
+	cfg.Credentials = p
+	return &cfg
+}
+
+// ProviderConfig returns an AWS configuration for the supplied region that
+// authenticates using the credentials source of the supplied Provider. data
+// is the content of the Provider's credentials secret, which is only used
+// when the Provider loads its credentials from a secret.
+func ProviderConfig(p *v1alpha2.Provider, data []byte, region string) (*aws.Config, error) {
+	switch p.GetCredentialsSource() {
+	case v1alpha2.CredentialsSourceSecret:
+		profile := p.Spec.Profile
+		if profile == "" {
+			profile = DefaultSection
+		}
+		cfg, err := LoadConfig(data, profile, region)
+		if err != nil {
+			return nil, err
+		}
+		return assumeProviderRole(p, cfg), nil
+	case v1alpha2.CredentialsSourceInjectedIdentity:
+		cfg, err := LoadAmbientConfig(region)
+		if err != nil {
+			return nil, err
+		}
+		return assumeProviderRole(p, cfg), nil
+	case v1alpha2.CredentialsSourceWebIdentity:
+		tokenFile := p.Spec.WebIdentityTokenFile
+		if tokenFile == "" {
+			tokenFile = os.Getenv(WebIdentityTokenFileEnvVar)
+		}
+		roleARN := p.Spec.AssumeRoleARN
+		if roleARN == "" {
+			roleARN = os.Getenv(RoleARNEnvVar)
+		}
+		return LoadWebIdentityConfig(tokenFile, roleARN, p.Spec.SessionName, region)
+	default:
+		return nil, errors.Errorf(errUnknownCredentialsSource, p.Spec.CredentialsSource)
+	}
+}
+
+// ProviderUsesSecret returns true if the supplied Provider loads its
+// credentials from its credentials secret.
+func ProviderUsesSecret(p *v1alpha2.Provider) bool {
+	return p.GetCredentialsSource() == v1alpha2.CredentialsSourceSecret
+}
+
+func assumeProviderRole(p *v1alpha2.Provider, cfg *aws.Config) *aws.Config {
+	if p.Spec.AssumeRoleARN == "" {
+		return cfg
+	}
+	return AssumeRole(cfg, p.Spec.AssumeRoleARN, p.Spec.ExternalID, p.Spec.SessionName)
+}
+
+// A WebIdentityRoleAssumer can assume an IAM role using a web identity token.
+type WebIdentityRoleAssumer interface {
+	AssumeRoleWithWebIdentityRequest(*sts.AssumeRoleWithWebIdentityInput) sts.AssumeRoleWithWebIdentityRequest
+}
+
+// A WebIdentityRoleProvider retrieves temporary credentials by exchanging a
+// web identity token read from a file for the credentials of an IAM role. The
+// token file is re-read each time credentials are retrieved, so that tokens
+// rotated by the kubelet are picked up.
+type WebIdentityRoleProvider struct {
+	aws.SafeCredentialsProvider
+
+	client      WebIdentityRoleAssumer
+	roleARN     string
+	sessionName string
+	tokenFile   string
+}
+
+// NewWebIdentityRoleProvider returns a credentials provider that assumes the
+// supplied role using the web identity token at tokenFile.
+func NewWebIdentityRoleProvider(client WebIdentityRoleAssumer, roleARN, sessionName, tokenFile string) *WebIdentityRoleProvider {
+	p := &WebIdentityRoleProvider{
+		client:      client,
+		roleARN:     roleARN,
+		sessionName: sessionName,
+		tokenFile:   tokenFile,
+	}
+	p.RetrieveFn = p.retrieveFn
+	return p
+}
+
+func (p *WebIdentityRoleProvider) retrieveFn() (aws.Credentials, error) {
+	token, err := ioutil.ReadFile(p.tokenFile)
+	if err != nil {
+		return aws.Credentials{Source: WebIdentityProviderName}, errors.Wrap(err, errReadWebIdentityToken)
+	}
+
+	sessionName := p.sessionName
+	if sessionName == "" {
+		sessionName = fmt.Sprintf("%d", time.Now().UTC().UnixNano())
+	}
+
+	req := p.client.AssumeRoleWithWebIdentityRequest(&sts.AssumeRoleWithWebIdentityInput{
+		RoleArn:          aws.String(p.roleARN),
+		RoleSessionName:  aws.String(sessionName),
+		WebIdentityToken: aws.String(string(token)),
+	})
+	rsp, err := req.Send()
+	if err != nil {
+		return aws.Credentials{Source: WebIdentityProviderName}, errors.Wrap(err, errAssumeRoleWithWebIdentity)
+	}
+
+	return aws.Credentials{
+		AccessKeyID:     aws.StringValue(rsp.Credentials.AccessKeyId),
+		SecretAccessKey: aws.StringValue(rsp.Credentials.SecretAccessKey),
+		SessionToken:    aws.StringValue(rsp.Credentials.SessionToken),
+		Source:          WebIdentityProviderName,
+		CanExpire:       true,
+		Expires:         aws.TimeValue(rsp.Credentials.Expiration).Add(-credentialsExpiryWindow),
+	}, nil
 }
 
 // String converts the supplied string for use with the AWS Go SDK.
@@ -18,11 +18,21 @@ package aws
 
 import (
 	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
-	"github.com/go-ini/ini"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
 
 	. "github.com/onsi/gomega"
+
+	"github.com/crossplaneio/stack-aws/apis/v1alpha2"
 )
 
 const (
@@ -50,6 +60,28 @@ func TestCredentialsIdSecret(t *testing.T) {
 	g.Expect(secret).To(Equal(""))
 }
 
+func TestCredentialsFromProfile(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	credentials := []byte(fmt.Sprintf(awsCredentialsFileFormat, "default", "defaultID", "defaultSecret") +
+		"\n\n" + fmt.Sprintf(awsCredentialsFileFormat, "temporary", "tempID", "tempSecret") +
+		"\naws_session_token = tempToken")
+
+	// profile without a session token
+	creds, err := CredentialsFromProfile(credentials, "default")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(creds).To(Equal(aws.Credentials{AccessKeyID: "defaultID", SecretAccessKey: "defaultSecret"}))
+
+	// named profile with a session token
+	creds, err = CredentialsFromProfile(credentials, "temporary")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(creds).To(Equal(aws.Credentials{AccessKeyID: "tempID", SecretAccessKey: "tempSecret", SessionToken: "tempToken"}))
+
+	// invalid profile - foo does not exist
+	_, err = CredentialsFromProfile(credentials, "foo")
+	g.Expect(err).To(HaveOccurred())
+}
+
 func TestLoadConfig(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -64,14 +96,127 @@ func TestLoadConfig(t *testing.T) {
 	g.Expect(config).NotTo(BeNil())
 }
 
-func TestValidateInvalid(t *testing.T) {
+func TestProviderConfig(t *testing.T) {
 	g := NewGomegaWithT(t)
 
-	data := []byte(fmt.Sprintf("[%s]\naws_access_key_id = %s\naws_secret_access_key = %s", "default", "foo", "barr"))
+	testRegion := "us-west-2"
+	credentials := []byte(fmt.Sprintf(awsCredentialsFileFormat, "default", "testID", "testSecret"))
+
+	// secret is the default credentials source
+	p := &v1alpha2.Provider{}
+	g.Expect(ProviderUsesSecret(p)).To(BeTrue())
+	config, err := ProviderConfig(p, credentials, testRegion)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(config.Region).To(Equal(testRegion))
+	creds, err := config.Credentials.Retrieve()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(creds.AccessKeyID).To(Equal("testID"))
 
-	config, err := LoadConfig(data, ini.DefaultSection, "us-west-2")
+	// named profiles are loaded from the secret
+	p.Spec.Profile = "other"
+	_, err = ProviderConfig(p, credentials, testRegion)
+	g.Expect(err).To(HaveOccurred())
+	config, err = ProviderConfig(p, []byte(fmt.Sprintf(awsCredentialsFileFormat, "other", "otherID", "otherSecret")), testRegion)
+	g.Expect(err).NotTo(HaveOccurred())
+	creds, err = config.Credentials.Retrieve()
 	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(creds.AccessKeyID).To(Equal("otherID"))
+	p.Spec.Profile = ""
+
+	// invalid secret data
+	_, err = ProviderConfig(p, []byte("definitelynotini"), testRegion)
+	g.Expect(err).To(HaveOccurred())
+
+	// assumed roles wrap the loaded credentials
+	p.Spec.AssumeRoleARN = "arn:aws:iam::123456789012:role/cool"
+	config, err = ProviderConfig(p, credentials, testRegion)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(config.Credentials).To(BeAssignableToTypeOf(&stscreds.AssumeRoleProvider{}))
+
+	// injected identity does not use the secret
+	p = &v1alpha2.Provider{Spec: v1alpha2.ProviderSpec{CredentialsSource: v1alpha2.CredentialsSourceInjectedIdentity}}
+	g.Expect(ProviderUsesSecret(p)).To(BeFalse())
+	config, err = ProviderConfig(p, nil, testRegion)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(config.Region).To(Equal(testRegion))
+
+	// web identity requires a token file and role
+	p = &v1alpha2.Provider{Spec: v1alpha2.ProviderSpec{CredentialsSource: v1alpha2.CredentialsSourceWebIdentity}}
+	os.Unsetenv(WebIdentityTokenFileEnvVar)
+	os.Unsetenv(RoleARNEnvVar)
+	_, err = ProviderConfig(p, nil, testRegion)
+	g.Expect(err).To(HaveOccurred())
+
+	p.Spec.WebIdentityTokenFile = "/var/run/secrets/token"
+	p.Spec.AssumeRoleARN = "arn:aws:iam::123456789012:role/cool"
+	config, err = ProviderConfig(p, nil, testRegion)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(config.Credentials).To(BeAssignableToTypeOf(&WebIdentityRoleProvider{}))
+
+	// unknown credentials source
+	p = &v1alpha2.Provider{Spec: v1alpha2.ProviderSpec{CredentialsSource: "Carrier Pigeon"}}
+	_, err = ProviderConfig(p, nil, testRegion)
+	g.Expect(err).To(HaveOccurred())
+}
+
+type mockWebIdentityRoleAssumer struct {
+	input  *sts.AssumeRoleWithWebIdentityInput
+	output *sts.AssumeRoleWithWebIdentityOutput
+	err    error
+}
+
+func (m *mockWebIdentityRoleAssumer) AssumeRoleWithWebIdentityRequest(i *sts.AssumeRoleWithWebIdentityInput) sts.AssumeRoleWithWebIdentityRequest {
+	m.input = i
+	return sts.AssumeRoleWithWebIdentityRequest{
+		Request: &aws.Request{
+			HTTPRequest: &http.Request{},
+			Data:        m.output,
+			Error:       m.err,
+		},
+	}
+}
+
+func TestWebIdentityRoleProvider(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	dir, err := ioutil.TempDir("", "webidentity")
+	g.Expect(err).NotTo(HaveOccurred())
+	defer os.RemoveAll(dir)
+
+	tokenFile := filepath.Join(dir, "token")
+	g.Expect(ioutil.WriteFile(tokenFile, []byte("cooltoken"), 0600)).To(Succeed())
+
+	expiration := time.Now().Add(1 * time.Hour)
+	m := &mockWebIdentityRoleAssumer{
+		output: &sts.AssumeRoleWithWebIdentityOutput{
+			Credentials: &sts.Credentials{
+				AccessKeyId:     aws.String("id"),
+				SecretAccessKey: aws.String("secret"),
+				SessionToken:    aws.String("session"),
+				Expiration:      &expiration,
+			},
+		},
+	}
+
+	p := NewWebIdentityRoleProvider(m, "arn:aws:iam::123456789012:role/cool", "coolsession", tokenFile)
+	creds, err := p.Retrieve()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(creds.AccessKeyID).To(Equal("id"))
+	g.Expect(creds.SecretAccessKey).To(Equal("secret"))
+	g.Expect(creds.SessionToken).To(Equal("session"))
+	g.Expect(creds.CanExpire).To(BeTrue())
+	g.Expect(creds.Expires).To(Equal(expiration.Add(-credentialsExpiryWindow)))
+	g.Expect(aws.StringValue(m.input.WebIdentityToken)).To(Equal("cooltoken"))
+	g.Expect(aws.StringValue(m.input.RoleSessionName)).To(Equal("coolsession"))
+
+	// missing token file
+	p = NewWebIdentityRoleProvider(m, "arn:aws:iam::123456789012:role/cool", "", filepath.Join(dir, "nope"))
+	_, err = p.Retrieve()
+	g.Expect(err).To(HaveOccurred())
 
-	err = ValidateConfig(config)
+	// failed to assume role
+	m = &mockWebIdentityRoleAssumer{err: errors.New("boom")}
+	p = NewWebIdentityRoleProvider(m, "arn:aws:iam::123456789012:role/cool", "", tokenFile)
+	_, err = p.Retrieve()
 	g.Expect(err).To(HaveOccurred())
 }
@@ -17,14 +17,27 @@ limitations under the License.
 package ec2
 
 import (
+	"context"
 	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/aws/defaults"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
 	"github.com/crossplaneio/crossplane-runtime/pkg/test"
+
+	"github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
 )
 
 func TestMain(m *testing.M) {
@@ -269,3 +282,1523 @@ func Test_IsVPCNotFoundErr(t *testing.T) {
 		})
 	}
 }
+
+func Test_IsVPCUpToDate(t *testing.T) {
+	associated := &ec2.VpcCidrBlockState{State: ec2.VpcCidrBlockStateCodeAssociated}
+	vpc := ec2.Vpc{
+		CidrBlock: aws.String("10.0.0.0/16"),
+		CidrBlockAssociationSet: []ec2.VpcCidrBlockAssociation{
+			{AssociationId: aws.String("primary"), CidrBlock: aws.String("10.0.0.0/16"), CidrBlockState: associated},
+			{AssociationId: aws.String("secondary"), CidrBlock: aws.String("10.1.0.0/16"), CidrBlockState: associated},
+		},
+		InstanceTenancy: ec2.TenancyDefault,
+	}
+	attrs := VPCAttributes{EnableDNSSupport: true}
+	params := v1alpha2.VPCParameters{
+		CIDRBlock:            "10.0.0.0/16",
+		EnableDNSSupport:     aws.Bool(true),
+		AdditionalCIDRBlocks: []string{"10.1.0.0/16"},
+	}
+
+	testCases := []struct {
+		name   string
+		params func(p v1alpha2.VPCParameters) v1alpha2.VPCParameters
+		want   bool
+	}{
+		{
+			"matching parameters are up to date",
+			func(p v1alpha2.VPCParameters) v1alpha2.VPCParameters { return p },
+			true,
+		},
+		{
+			"different DNS attributes are not up to date",
+			func(p v1alpha2.VPCParameters) v1alpha2.VPCParameters { p.EnableDNSHostNames = aws.Bool(true); return p },
+			false,
+		},
+		{
+			"unset DNS attributes are up to date",
+			func(p v1alpha2.VPCParameters) v1alpha2.VPCParameters { p.EnableDNSSupport = nil; return p },
+			true,
+		},
+		{
+			"a missing CIDR block is not up to date",
+			func(p v1alpha2.VPCParameters) v1alpha2.VPCParameters {
+				p.AdditionalCIDRBlocks = []string{"10.1.0.0/16", "10.2.0.0/16"}
+				return p
+			},
+			false,
+		},
+		{
+			"an undesired CIDR block is not up to date",
+			func(p v1alpha2.VPCParameters) v1alpha2.VPCParameters { p.AdditionalCIDRBlocks = nil; return p },
+			false,
+		},
+		{
+			"a missing IPv6 CIDR block is not up to date",
+			func(p v1alpha2.VPCParameters) v1alpha2.VPCParameters {
+				p.AmazonProvidedIPv6CIDRBlock = aws.Bool(true)
+				return p
+			},
+			false,
+		},
+		{
+			"dedicated tenancy cannot be applied to a default tenancy VPC",
+			func(p v1alpha2.VPCParameters) v1alpha2.VPCParameters { p.InstanceTenancy = "dedicated"; return p },
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsVPCUpToDate(tc.params(*params.DeepCopy()), vpc, attrs)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsSubnetUpToDate(t *testing.T) {
+	subnet := ec2.Subnet{
+		MapPublicIpOnLaunch: aws.Bool(true),
+		Ipv6CidrBlockAssociationSet: []ec2.SubnetIpv6CidrBlockAssociation{
+			{
+				AssociationId:      aws.String("disassociated"),
+				Ipv6CidrBlock:      aws.String("2600:1f14:0:1::/64"),
+				Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{State: ec2.SubnetCidrBlockStateCodeDisassociated},
+			},
+			{
+				AssociationId:      aws.String("associated"),
+				Ipv6CidrBlock:      aws.String("2600:1f14::/64"),
+				Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{State: ec2.SubnetCidrBlockStateCodeAssociated},
+			},
+		},
+	}
+	params := v1alpha2.SubnetParameters{
+		CIDRBlock:           "10.0.0.0/24",
+		IPv6CIDRBlock:       aws.String("2600:1f14::/64"),
+		MapPublicIPOnLaunch: aws.Bool(true),
+	}
+
+	testCases := []struct {
+		name   string
+		params func(p v1alpha2.SubnetParameters) v1alpha2.SubnetParameters
+		want   bool
+	}{
+		{
+			"matching parameters are up to date",
+			func(p v1alpha2.SubnetParameters) v1alpha2.SubnetParameters { return p },
+			true,
+		},
+		{
+			"a different public IP mapping is not up to date",
+			func(p v1alpha2.SubnetParameters) v1alpha2.SubnetParameters {
+				p.MapPublicIPOnLaunch = aws.Bool(false)
+				return p
+			},
+			false,
+		},
+		{
+			"a different IPv6 address assignment is not up to date",
+			func(p v1alpha2.SubnetParameters) v1alpha2.SubnetParameters {
+				p.AssignIPv6AddressOnCreation = aws.Bool(true)
+				return p
+			},
+			false,
+		},
+		{
+			"a different IPv6 CIDR block is not up to date",
+			func(p v1alpha2.SubnetParameters) v1alpha2.SubnetParameters {
+				p.IPv6CIDRBlock = aws.String("2600:1f14:0:2::/64")
+				return p
+			},
+			false,
+		},
+		{
+			"an IPv6 CIDR block in another form is up to date",
+			func(p v1alpha2.SubnetParameters) v1alpha2.SubnetParameters {
+				p.IPv6CIDRBlock = aws.String("2600:1F14:0000:0000::/64")
+				return p
+			},
+			true,
+		},
+		{
+			"unset parameters are up to date",
+			func(p v1alpha2.SubnetParameters) v1alpha2.SubnetParameters {
+				return v1alpha2.SubnetParameters{CIDRBlock: p.CIDRBlock}
+			},
+			true,
+		},
+		{
+			"an undesired IPv6 CIDR block is not up to date",
+			func(p v1alpha2.SubnetParameters) v1alpha2.SubnetParameters {
+				p.IPv6CIDRBlock = aws.String("")
+				return p
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsSubnetUpToDate(tc.params(*params.DeepCopy()), subnet)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_LateInitializeVPC(t *testing.T) {
+	associated := &ec2.VpcCidrBlockState{State: ec2.VpcCidrBlockStateCodeAssociated}
+	vpc := ec2.Vpc{
+		CidrBlock: aws.String("10.0.0.0/16"),
+		CidrBlockAssociationSet: []ec2.VpcCidrBlockAssociation{
+			{AssociationId: aws.String("primary"), CidrBlock: aws.String("10.0.0.0/16"), CidrBlockState: associated},
+			{AssociationId: aws.String("secondary"), CidrBlock: aws.String("10.1.0.0/16"), CidrBlockState: associated},
+			{AssociationId: aws.String("disassociated"), CidrBlock: aws.String("10.2.0.0/16"), CidrBlockState: &ec2.VpcCidrBlockState{State: ec2.VpcCidrBlockStateCodeDisassociated}},
+		},
+		Ipv6CidrBlockAssociationSet: []ec2.VpcIpv6CidrBlockAssociation{
+			{AssociationId: aws.String("ipv6"), Ipv6CidrBlock: aws.String("2600:1f14::/56"), Ipv6CidrBlockState: associated},
+		},
+		InstanceTenancy: ec2.TenancyDedicated,
+	}
+	attrs := VPCAttributes{EnableDNSSupport: true}
+
+	testCases := []struct {
+		name   string
+		params v1alpha2.VPCParameters
+		vpc    ec2.Vpc
+		want   v1alpha2.VPCParameters
+	}{
+		{
+			"unset parameters are filled with the observed ones",
+			v1alpha2.VPCParameters{},
+			vpc,
+			v1alpha2.VPCParameters{
+				EnableDNSSupport:            aws.Bool(true),
+				EnableDNSHostNames:          aws.Bool(false),
+				AdditionalCIDRBlocks:        []string{"10.1.0.0/16"},
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(true),
+				InstanceTenancy:             "dedicated",
+			},
+		},
+		{
+			"set parameters are kept",
+			v1alpha2.VPCParameters{
+				EnableDNSSupport:            aws.Bool(false),
+				EnableDNSHostNames:          aws.Bool(true),
+				AdditionalCIDRBlocks:        []string{"10.3.0.0/16"},
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(false),
+				InstanceTenancy:             "default",
+			},
+			vpc,
+			v1alpha2.VPCParameters{
+				EnableDNSSupport:            aws.Bool(false),
+				EnableDNSHostNames:          aws.Bool(true),
+				AdditionalCIDRBlocks:        []string{"10.3.0.0/16"},
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(false),
+				InstanceTenancy:             "default",
+			},
+		},
+		{
+			"additional CIDR blocks are left unset without secondary ones",
+			v1alpha2.VPCParameters{},
+			ec2.Vpc{CidrBlock: aws.String("10.0.0.0/16"), CidrBlockAssociationSet: vpc.CidrBlockAssociationSet[:1], InstanceTenancy: ec2.TenancyDefault},
+			v1alpha2.VPCParameters{
+				EnableDNSSupport:            aws.Bool(true),
+				EnableDNSHostNames:          aws.Bool(false),
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(false),
+				InstanceTenancy:             "default",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			LateInitializeVPC(&tc.params, tc.vpc, attrs)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_LateInitializeSubnet(t *testing.T) {
+	subnet := ec2.Subnet{
+		MapPublicIpOnLaunch:         aws.Bool(true),
+		AssignIpv6AddressOnCreation: aws.Bool(false),
+		Ipv6CidrBlockAssociationSet: []ec2.SubnetIpv6CidrBlockAssociation{{
+			AssociationId:      aws.String("associated"),
+			Ipv6CidrBlock:      aws.String("2600:1f14::/64"),
+			Ipv6CidrBlockState: &ec2.SubnetCidrBlockState{State: ec2.SubnetCidrBlockStateCodeAssociated},
+		}},
+	}
+
+	testCases := []struct {
+		name   string
+		params v1alpha2.SubnetParameters
+		subnet ec2.Subnet
+		want   v1alpha2.SubnetParameters
+	}{
+		{
+			"unset parameters are filled with the observed ones",
+			v1alpha2.SubnetParameters{},
+			subnet,
+			v1alpha2.SubnetParameters{
+				IPv6CIDRBlock:               aws.String("2600:1f14::/64"),
+				MapPublicIPOnLaunch:         aws.Bool(true),
+				AssignIPv6AddressOnCreation: aws.Bool(false),
+			},
+		},
+		{
+			"set parameters are kept",
+			v1alpha2.SubnetParameters{
+				IPv6CIDRBlock:               aws.String(""),
+				MapPublicIPOnLaunch:         aws.Bool(false),
+				AssignIPv6AddressOnCreation: aws.Bool(true),
+			},
+			subnet,
+			v1alpha2.SubnetParameters{
+				IPv6CIDRBlock:               aws.String(""),
+				MapPublicIPOnLaunch:         aws.Bool(false),
+				AssignIPv6AddressOnCreation: aws.Bool(true),
+			},
+		},
+		{
+			"the IPv6 CIDR block is left unset without an associated one",
+			v1alpha2.SubnetParameters{},
+			ec2.Subnet{MapPublicIpOnLaunch: aws.Bool(false), AssignIpv6AddressOnCreation: aws.Bool(false)},
+			v1alpha2.SubnetParameters{
+				MapPublicIPOnLaunch:         aws.Bool(false),
+				AssignIPv6AddressOnCreation: aws.Bool(false),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			LateInitializeSubnet(&tc.params, tc.subnet)
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_TagsToCreate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		desired  []v1alpha2.Tag
+		observed []ec2.Tag
+		want     []ec2.Tag
+	}{
+		{
+			"missing and changed tags are created",
+			[]v1alpha2.Tag{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}, {Key: "c", Value: "3"}},
+			[]ec2.Tag{{Key: aws.String("a"), Value: aws.String("1")}, {Key: aws.String("b"), Value: aws.String("old")}},
+			[]ec2.Tag{{Key: aws.String("b"), Value: aws.String("2")}, {Key: aws.String("c"), Value: aws.String("3")}},
+		},
+		{
+			"undesired tags are ignored",
+			nil,
+			[]ec2.Tag{{Key: aws.String("a"), Value: aws.String("1")}},
+			[]ec2.Tag{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, TagsToCreate(tc.desired, tc.observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_ExternalNameID(t *testing.T) {
+	testCases := []struct {
+		name         string
+		externalName string
+		want         string
+	}{
+		{"a short ID is returned", "vpc-0123abcd", "vpc-0123abcd"},
+		{"a long ID is returned", "vpc-0123456789abcdef0", "vpc-0123456789abcdef0"},
+		{"the default external name is ignored", "some-name", ""},
+		{"an ID with another prefix is ignored", "subnet-0123abcd", ""},
+		{"a name with a matching prefix is ignored", "vpc-production", ""},
+		{"an empty external name is ignored", "", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := &metav1.ObjectMeta{}
+			meta.SetExternalName(o, tc.externalName)
+			if diff := cmp.Diff(tc.want, ExternalNameID(o, VPCIDPrefix)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_GenerateTags(t *testing.T) {
+	o := &metav1.ObjectMeta{UID: types.UID("some-uid"), Name: "some-name", Namespace: "some-namespace"}
+
+	testCases := []struct {
+		name string
+		o    metav1.Object
+		tags []v1alpha2.Tag
+		want []v1alpha2.Tag
+	}{
+		{
+			"ownership tags follow the supplied tags",
+			o,
+			[]v1alpha2.Tag{{Key: "k", Value: "v"}},
+			[]v1alpha2.Tag{
+				{Key: "k", Value: "v"},
+				{Key: TagKeyUID, Value: "some-uid"},
+				{Key: TagKeyName, Value: "some-name"},
+				{Key: TagKeyNamespace, Value: "some-namespace"},
+			},
+		},
+		{
+			"supplied ownership tags are ignored",
+			o,
+			[]v1alpha2.Tag{{Key: TagKeyUID, Value: "other-uid"}},
+			[]v1alpha2.Tag{
+				{Key: TagKeyUID, Value: "some-uid"},
+				{Key: TagKeyName, Value: "some-name"},
+				{Key: TagKeyNamespace, Value: "some-namespace"},
+			},
+		},
+		{
+			"empty ownership values are omitted",
+			&metav1.ObjectMeta{Name: "some-name"},
+			nil,
+			[]v1alpha2.Tag{{Key: TagKeyName, Value: "some-name"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, GenerateTags(tc.o, tc.tags)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_AreTagsUpToDate(t *testing.T) {
+	o := &metav1.ObjectMeta{Name: "some-name"}
+	observed := []ec2.Tag{
+		{Key: aws.String("k"), Value: aws.String("v")},
+		{Key: aws.String("other"), Value: aws.String("v")},
+		{Key: aws.String(TagKeyName), Value: aws.String("some-name")},
+	}
+
+	testCases := []struct {
+		name string
+		o    metav1.Object
+		tags []v1alpha2.Tag
+		want bool
+	}{
+		{"matching tags are up to date", o, []v1alpha2.Tag{{Key: "k", Value: "v"}}, true},
+		{"a changed tag value is not up to date", o, []v1alpha2.Tag{{Key: "k", Value: "changed"}}, false},
+		{"a missing tag is not up to date", o, []v1alpha2.Tag{{Key: "missing", Value: "v"}}, false},
+		{"a changed ownership tag is not up to date", &metav1.ObjectMeta{Name: "other-name"}, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, AreTagsUpToDate(tc.o, tc.tags, observed)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_OwnerTagFilters(t *testing.T) {
+	o := &metav1.ObjectMeta{UID: "some-uid"}
+	state := ec2.Filter{Name: aws.String("state"), Values: []string{"available"}}
+
+	testCases := []struct {
+		name    string
+		filters []ec2.Filter
+		want    []ec2.Filter
+	}{
+		{
+			"only the owner filter is returned without other filters",
+			nil,
+			[]ec2.Filter{{Name: aws.String("tag:" + TagKeyUID), Values: []string{"some-uid"}}},
+		},
+		{
+			"other filters follow the owner filter",
+			[]ec2.Filter{state},
+			[]ec2.Filter{{Name: aws.String("tag:" + TagKeyUID), Values: []string{"some-uid"}}, state},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, OwnerTagFilters(o, tc.filters...)); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_ReplacementClientToken(t *testing.T) {
+	o := &metav1.ObjectMeta{UID: "some-uid"}
+
+	cases := map[string]struct {
+		recordedID string
+		want       *string
+	}{
+		"NothingRecorded": {
+			want: aws.String("some-uid"),
+		},
+		"Recorded": {
+			recordedID: "nat-0123abcd",
+			want:       aws.String("some-uid-nat-0123abcd"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, ReplacementClientToken(o, tc.recordedID)); diff != "" {
+				t.Errorf("ReplacementClientToken(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_Identify(t *testing.T) {
+	state := ec2.Filter{Name: aws.String("state"), Values: []string{"available"}}
+	adopted := &metav1.ObjectMeta{UID: "some-uid"}
+	meta.SetExternalName(adopted, "vpc-0123abcd")
+
+	type args struct {
+		o      metav1.Object
+		id     string
+		prefix string
+		ids    []string
+		err    error
+	}
+	type want struct {
+		id      string
+		err     bool
+		filters []ec2.Filter
+	}
+
+	testCases := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			"the recorded ID is returned",
+			args{o: adopted, id: "vpc-1", prefix: VPCIDPrefix},
+			want{id: "vpc-1"},
+		},
+		{
+			"the ID named by the external name is returned",
+			args{o: adopted, prefix: VPCIDPrefix},
+			want{id: "vpc-0123abcd"},
+		},
+		{
+			"the external name is ignored without a prefix",
+			args{o: adopted, ids: []string{"vpc-2"}},
+			want{id: "vpc-2", filters: []ec2.Filter{{Name: aws.String("tag:" + TagKeyUID), Values: []string{"some-uid"}}, state}},
+		},
+		{
+			"no ID is returned if no resource is tagged with the UID",
+			args{o: &metav1.ObjectMeta{UID: "some-uid"}, prefix: VPCIDPrefix},
+			want{filters: []ec2.Filter{{Name: aws.String("tag:" + TagKeyUID), Values: []string{"some-uid"}}, state}},
+		},
+		{
+			"multiple resources tagged with the UID are an error",
+			args{o: &metav1.ObjectMeta{UID: "some-uid"}, ids: []string{"vpc-2", "vpc-3"}},
+			want{err: true, filters: []ec2.Filter{{Name: aws.String("tag:" + TagKeyUID), Values: []string{"some-uid"}}, state}},
+		},
+		{
+			"a failure to describe the tagged resources is an error",
+			args{o: &metav1.ObjectMeta{UID: "some-uid"}, err: errors.New("some error")},
+			want{err: true, filters: []ec2.Filter{{Name: aws.String("tag:" + TagKeyUID), Values: []string{"some-uid"}}, state}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var filters []ec2.Filter
+			describe := func(_ context.Context, f []ec2.Filter) ([]string, error) {
+				filters = f
+				return tc.args.ids, tc.args.err
+			}
+
+			id, err := Identify(context.Background(), tc.args.o, tc.args.id, tc.args.prefix, describe, state)
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("r: -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.id, id); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.filters, filters); diff != "" {
+				t.Errorf("r: -want filters, +got filters:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_DiffPermissions(t *testing.T) {
+	tcp443 := func(cidr string) ec2.IpPermission {
+		return ec2.IpPermission{
+			FromPort:   aws.Int64(443),
+			ToPort:     aws.Int64(443),
+			IpProtocol: aws.String("tcp"),
+			IpRanges:   []ec2.IpRange{{CidrIp: aws.String(cidr)}},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		desired       []ec2.IpPermission
+		observed      []ec2.IpPermission
+		wantAuthorize []ec2.IpPermission
+		wantRevoke    []ec2.IpPermission
+	}{
+		{
+			"matching permissions produce no delta",
+			[]ec2.IpPermission{tcp443("10.0.0.0/8")},
+			[]ec2.IpPermission{tcp443("10.0.0.0/8")},
+			[]ec2.IpPermission{},
+			[]ec2.IpPermission{},
+		},
+		{
+			"protocol numbers and names are equivalent",
+			[]ec2.IpPermission{{FromPort: aws.Int64(443), ToPort: aws.Int64(443), IpProtocol: aws.String("6"), IpRanges: []ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}}}},
+			[]ec2.IpPermission{tcp443("10.0.0.0/8")},
+			[]ec2.IpPermission{},
+			[]ec2.IpPermission{},
+		},
+		{
+			"CIDR blocks are compared in their canonical form",
+			[]ec2.IpPermission{tcp443("10.0.0.1/8"), {FromPort: aws.Int64(443), ToPort: aws.Int64(443), IpProtocol: aws.String("tcp"), Ipv6Ranges: []ec2.Ipv6Range{{CidrIpv6: aws.String("2001:DB8::/32")}}}},
+			[]ec2.IpPermission{tcp443("10.0.0.0/8"), {FromPort: aws.Int64(443), ToPort: aws.Int64(443), IpProtocol: aws.String("tcp"), Ipv6Ranges: []ec2.Ipv6Range{{CidrIpv6: aws.String("2001:db8::/32")}}}},
+			[]ec2.IpPermission{},
+			[]ec2.IpPermission{},
+		},
+		{
+			"ports of all-protocol rules are ignored",
+			[]ec2.IpPermission{{FromPort: aws.Int64(0), ToPort: aws.Int64(0), IpProtocol: aws.String("-1"), IpRanges: []ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}}},
+			[]ec2.IpPermission{{IpProtocol: aws.String("-1"), IpRanges: []ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}}},
+			[]ec2.IpPermission{},
+			[]ec2.IpPermission{},
+		},
+		{
+			"only the changed sources are authorized and revoked",
+			[]ec2.IpPermission{{
+				FromPort:   aws.Int64(443),
+				ToPort:     aws.Int64(443),
+				IpProtocol: aws.String("tcp"),
+				IpRanges:   []ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}, {CidrIp: aws.String("172.16.0.0/12")}},
+			}},
+			[]ec2.IpPermission{{
+				FromPort:   aws.Int64(443),
+				ToPort:     aws.Int64(443),
+				IpProtocol: aws.String("tcp"),
+				IpRanges:   []ec2.IpRange{{CidrIp: aws.String("10.0.0.0/8")}, {CidrIp: aws.String("192.168.0.0/16")}},
+			}},
+			[]ec2.IpPermission{tcp443("172.16.0.0/12")},
+			[]ec2.IpPermission{tcp443("192.168.0.0/16")},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			authorize, revoke := DiffPermissions(tc.desired, tc.observed)
+			if diff := cmp.Diff(tc.wantAuthorize, authorize); diff != "" {
+				t.Errorf("authorize: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRevoke, revoke); diff != "" {
+				t.Errorf("revoke: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_DiffRoutes(t *testing.T) {
+	observed := []ec2.Route{
+		{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String(LocalGatewayID), Origin: ec2.RouteOriginCreateRouteTable},
+		{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-1"), Origin: ec2.RouteOriginCreateRoute},
+		{DestinationIpv6CidrBlock: aws.String("::/0"), EgressOnlyInternetGatewayId: aws.String("eigw-1"), Origin: ec2.RouteOriginCreateRoute},
+		{DestinationCidrBlock: aws.String("172.16.0.0/12"), Origin: ec2.RouteOriginCreateRoute},
+		{DestinationPrefixListId: aws.String("pl-1"), GatewayId: aws.String("vpce-1"), Origin: ec2.RouteOriginCreateRoute},
+		{DestinationCidrBlock: aws.String("192.168.0.0/16"), VpcPeeringConnectionId: aws.String("pcx-1"), Origin: ec2.RouteOriginCreateRoute},
+		{DestinationCidrBlock: aws.String("10.9.0.0/16"), GatewayId: aws.String("vpce-2"), Origin: ec2.RouteOriginCreateRoute},
+	}
+	transitGateways := map[string]string{"172.16.0.0/12": "tgw-1"}
+
+	testCases := []struct {
+		name        string
+		desired     []v1alpha2.Route
+		wantCreate  []v1alpha2.Route
+		wantReplace []v1alpha2.Route
+		wantRemove  []ec2.Route
+	}{
+		{
+			"matching routes produce no delta",
+			[]v1alpha2.Route{
+				{DestinationCIDRBlock: "0.0.0.0/0", NATGatewayID: "nat-1"},
+				{DestinationIPv6CIDRBlock: "::/0", EgressOnlyInternetGatewayID: "eigw-1"},
+				{DestinationCIDRBlock: "172.16.0.0/12", TransitGatewayID: "tgw-1"},
+				{DestinationCIDRBlock: "192.168.0.0/16", VPCPeeringConnectionID: "pcx-1"},
+				{DestinationCIDRBlock: "10.9.0.0/16", VPCEndpointID: "vpce-2"},
+			},
+			[]v1alpha2.Route{},
+			[]v1alpha2.Route{},
+			[]ec2.Route{},
+		},
+		{
+			"a route to another transit gateway is replaced",
+			[]v1alpha2.Route{
+				{DestinationCIDRBlock: "0.0.0.0/0", NATGatewayID: "nat-1"},
+				{DestinationIPv6CIDRBlock: "::/0", EgressOnlyInternetGatewayID: "eigw-1"},
+				{DestinationCIDRBlock: "172.16.0.0/12", TransitGatewayID: "tgw-2"},
+				{DestinationCIDRBlock: "192.168.0.0/16", VPCPeeringConnectionID: "pcx-1"},
+				{DestinationCIDRBlock: "10.9.0.0/16", VPCEndpointID: "vpce-2"},
+			},
+			[]v1alpha2.Route{},
+			[]v1alpha2.Route{{DestinationCIDRBlock: "172.16.0.0/12", TransitGatewayID: "tgw-2"}},
+			[]ec2.Route{},
+		},
+		{
+			"changed targets are replaced, new routes created and stale routes removed",
+			[]v1alpha2.Route{
+				{DestinationCIDRBlock: "0.0.0.0/0", NATGatewayID: "nat-2"},
+				{DestinationIPv6CIDRBlock: "::/0", EgressOnlyInternetGatewayID: "eigw-1"},
+				{DestinationCIDRBlock: "172.16.0.0/12", TransitGatewayID: "tgw-1"},
+				{DestinationIPv6CIDRBlock: "2600::/16", NetworkInterfaceID: "eni-1"},
+			},
+			[]v1alpha2.Route{{DestinationIPv6CIDRBlock: "2600::/16", NetworkInterfaceID: "eni-1"}},
+			[]v1alpha2.Route{{DestinationCIDRBlock: "0.0.0.0/0", NATGatewayID: "nat-2"}},
+			[]ec2.Route{observed[5], observed[6]},
+		},
+		{
+			"destinations are compared in their canonical form",
+			[]v1alpha2.Route{
+				{DestinationCIDRBlock: "0.0.0.1/0", NATGatewayID: "nat-1"},
+				{DestinationIPv6CIDRBlock: "0:0::/0", EgressOnlyInternetGatewayID: "eigw-1"},
+				{DestinationCIDRBlock: "172.16.0.1/12", TransitGatewayID: "tgw-1"},
+				{DestinationCIDRBlock: "192.168.0.1/16", VPCPeeringConnectionID: "pcx-1"},
+				{DestinationCIDRBlock: "10.9.0.1/16", VPCEndpointID: "vpce-2"},
+			},
+			[]v1alpha2.Route{},
+			[]v1alpha2.Route{},
+			[]ec2.Route{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			create, replace, remove := DiffRoutes(tc.desired, observed, transitGateways)
+			if diff := cmp.Diff(tc.wantCreate, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantReplace, replace); diff != "" {
+				t.Errorf("replace: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRemove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsAddressNotFoundErr(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		got  error
+		want bool
+	}{
+		{
+			"nil error is not",
+			nil,
+			false,
+		},
+		{
+			"other error is not",
+			errors.New("some error"),
+			false,
+		},
+		{
+			"AllocationIDNotFound is",
+			awserr.New(AllocationIDNotFound, "", nil),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			if diff := cmp.Diff(tc.want, IsAddressNotFoundErr(tc.got), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsNATGatewayNotFoundErr(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		got  error
+		want bool
+	}{
+		{
+			"nil error is not",
+			nil,
+			false,
+		},
+		{
+			"other error is not",
+			errors.New("some error"),
+			false,
+		},
+		{
+			"NATGatewayNotFound is",
+			awserr.New(NATGatewayNotFound, "", nil),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			if diff := cmp.Diff(tc.want, IsNATGatewayNotFoundErr(tc.got), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsVPCEndpointNotFoundErr(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		got  error
+		want bool
+	}{
+		{
+			"nil error is not",
+			nil,
+			false,
+		},
+		{
+			"other error is not",
+			errors.New("some error"),
+			false,
+		},
+		{
+			"VPCEndpointIDNotFound is",
+			awserr.New(VPCEndpointIDNotFound, "", nil),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			if diff := cmp.Diff(tc.want, IsVPCEndpointNotFoundErr(tc.got), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_GenerateModifyVPCEndpointInput(t *testing.T) {
+	observed := ec2.VpcEndpoint{
+		PolicyDocument:    aws.String(`{"Version": "2008-10-17", "Statement": [{"Effect": "Allow", "Principal": "*", "Action": "*", "Resource": "*"}]}`),
+		PrivateDnsEnabled: aws.Bool(false),
+		RouteTableIds:     []string{"rtb-1", "rtb-2"},
+		SubnetIds:         []string{"subnet-1"},
+		Groups:            []ec2.SecurityGroupIdentifier{{GroupId: aws.String("sg-1")}},
+	}
+
+	testCases := []struct {
+		name string
+		p    v1alpha2.VPCEndpointParameters
+		want *ec2.ModifyVpcEndpointInput
+	}{
+		{
+			"matching parameters need no modification",
+			v1alpha2.VPCEndpointParameters{
+				PolicyDocument:   `{"Statement":[{"Action":"*","Effect":"Allow","Principal":"*","Resource":"*"}],"Version":"2008-10-17"}`,
+				RouteTableIDs:    []string{"rtb-2", "rtb-1"},
+				SubnetIDs:        []string{"subnet-1"},
+				SecurityGroupIDs: []string{"sg-1"},
+			},
+			nil,
+		},
+		{
+			"changed sets and settings are modified",
+			v1alpha2.VPCEndpointParameters{
+				PolicyDocument:    `{"Statement":[]}`,
+				PrivateDNSEnabled: aws.Bool(true),
+				RouteTableIDs:     []string{"rtb-1", "rtb-3"},
+				SubnetIDs:         []string{"subnet-1"},
+				SecurityGroupIDs:  []string{"sg-2"},
+			},
+			&ec2.ModifyVpcEndpointInput{
+				VpcEndpointId:          aws.String("vpce-1"),
+				AddRouteTableIds:       []string{"rtb-3"},
+				RemoveRouteTableIds:    []string{"rtb-2"},
+				AddSecurityGroupIds:    []string{"sg-2"},
+				RemoveSecurityGroupIds: []string{"sg-1"},
+				PolicyDocument:         aws.String(`{"Statement":[]}`),
+				PrivateDnsEnabled:      aws.Bool(true),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := GenerateModifyVPCEndpointInput("vpce-1", tc.p, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want == nil, IsVPCEndpointUpToDate(tc.p, observed)); diff != "" {
+				t.Errorf("IsVPCEndpointUpToDate: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsVPCPeeringConnectionNotFoundErr(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		got  error
+		want bool
+	}{
+		{
+			"nil error is not",
+			nil,
+			false,
+		},
+		{
+			"other error is not",
+			errors.New("some error"),
+			false,
+		},
+		{
+			"VPCPeeringConnectionIDNotFound is",
+			awserr.New(VPCPeeringConnectionIDNotFound, "", nil),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			if diff := cmp.Diff(tc.want, IsVPCPeeringConnectionNotFoundErr(tc.got), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsTransitGatewayNotFoundErr(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		got  error
+		want bool
+	}{
+		{
+			"nil error is not",
+			nil,
+			false,
+		},
+		{
+			"other error is not",
+			errors.New("some error"),
+			false,
+		},
+		{
+			"TransitGatewayIDNotFound is",
+			awserr.New(TransitGatewayIDNotFound, "", nil),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			if diff := cmp.Diff(tc.want, IsTransitGatewayNotFoundErr(tc.got), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsTransitGatewayAttachmentNotFoundErr(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		got  error
+		want bool
+	}{
+		{
+			"nil error is not",
+			nil,
+			false,
+		},
+		{
+			"other error is not",
+			errors.New("some error"),
+			false,
+		},
+		{
+			"TransitGatewayAttachmentIDNotFound is",
+			awserr.New(TransitGatewayAttachmentIDNotFound, "", nil),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			if diff := cmp.Diff(tc.want, IsTransitGatewayAttachmentNotFoundErr(tc.got), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+// testEC2Server returns an AWS config whose EC2 requests are served by a
+// server that records the form of the request and responds with the supplied
+// XML body.
+func testEC2Server(t *testing.T, body string, form *url.Values) (*aws.Config, func()) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		v, err := url.ParseQuery(string(b))
+		if err != nil {
+			t.Fatal(err)
+		}
+		*form = v
+		_, _ = w.Write([]byte(body))
+	}))
+
+	cfg := defaults.Config()
+	cfg.Region = "us-east-1"
+	cfg.Credentials = aws.NewStaticCredentialsProvider("AKID", "SECRET", "")
+	cfg.EndpointResolver = aws.ResolveWithEndpointURL(srv.URL)
+	return &cfg, srv.Close
+}
+
+func Test_TransitGatewayRequests(t *testing.T) {
+	var form url.Values
+	cfg, stop := testEC2Server(t, `<CreateTransitGatewayResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+    <requestId>a7a4a5a1-0000-0000-0000-000000000000</requestId>
+    <transitGateway>
+        <creationTime>2019-11-01T10:00:00.000Z</creationTime>
+        <options>
+            <amazonSideAsn>64513</amazonSideAsn>
+            <associationDefaultRouteTableId>tgw-rtb-1</associationDefaultRouteTableId>
+            <dnsSupport>enable</dnsSupport>
+        </options>
+        <ownerId>123456789012</ownerId>
+        <state>pending</state>
+        <tagSet>
+            <item><key>Name</key><value>hub</value></item>
+        </tagSet>
+        <transitGatewayArn>arn:aws:ec2:us-east-1:123456789012:transit-gateway/tgw-1</transitGatewayArn>
+        <transitGatewayId>tgw-1</transitGatewayId>
+    </transitGateway>
+</CreateTransitGatewayResponse>`, &form)
+	defer stop()
+
+	c, _ := NewTransitGatewayClient(cfg)
+	req := c.CreateTransitGatewayRequest(GenerateCreateTransitGatewayInput(v1alpha2.TransitGatewayParameters{
+		Description:    "hub",
+		AmazonSideASN:  aws.Int64(64513),
+		DNSSupport:     aws.Bool(true),
+		VPNECMPSupport: aws.Bool(false),
+	}, []v1alpha2.Tag{{Key: "Name", Value: "hub"}, {Key: TagKeyUID, Value: "uid-1"}}))
+	rsp, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantForm := url.Values{
+		"Action":                 {"CreateTransitGateway"},
+		"Version":                {"2016-11-15"},
+		"Description":            {"hub"},
+		"Options.AmazonSideAsn":  {"64513"},
+		"Options.DnsSupport":     {"enable"},
+		"Options.VpnEcmpSupport": {"disable"},
+
+		"TagSpecification.1.ResourceType": {"transit-gateway"},
+		"TagSpecification.1.Tag.1.Key":    {"Name"},
+		"TagSpecification.1.Tag.1.Value":  {"hub"},
+		"TagSpecification.1.Tag.2.Key":    {TagKeyUID},
+		"TagSpecification.1.Tag.2.Value":  {"uid-1"},
+	}
+	if diff := cmp.Diff(wantForm, form); diff != "" {
+		t.Errorf("CreateTransitGateway: -want form, +got form:\n%s", diff)
+	}
+
+	want := v1alpha2.TransitGatewayExternalStatus{
+		TransitGatewayID:               "tgw-1",
+		TransitGatewayARN:              "arn:aws:ec2:us-east-1:123456789012:transit-gateway/tgw-1",
+		TransitGatewayState:            "pending",
+		OwnerID:                        "123456789012",
+		AssociationDefaultRouteTableID: "tgw-rtb-1",
+		Tags:                           []v1alpha2.Tag{{Key: "Name", Value: "hub"}},
+	}
+	if diff := cmp.Diff(want, GenerateTransitGatewayObservation(*rsp.TransitGateway)); diff != "" {
+		t.Errorf("CreateTransitGateway: -want observation, +got observation:\n%s", diff)
+	}
+}
+
+func Test_TransitGatewayVPCAttachmentRequests(t *testing.T) {
+	var form url.Values
+	cfg, stop := testEC2Server(t, `<DescribeTransitGatewayVpcAttachmentsResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+    <requestId>a7a4a5a1-0000-0000-0000-000000000000</requestId>
+    <transitGatewayVpcAttachments>
+        <item>
+            <options>
+                <dnsSupport>enable</dnsSupport>
+                <ipv6Support>disable</ipv6Support>
+            </options>
+            <state>available</state>
+            <subnetIds>
+                <item>subnet-1</item>
+                <item>subnet-2</item>
+            </subnetIds>
+            <transitGatewayAttachmentId>tgw-attach-1</transitGatewayAttachmentId>
+            <transitGatewayId>tgw-1</transitGatewayId>
+            <vpcId>vpc-1</vpcId>
+            <vpcOwnerId>123456789012</vpcOwnerId>
+        </item>
+    </transitGatewayVpcAttachments>
+</DescribeTransitGatewayVpcAttachmentsResponse>`, &form)
+	defer stop()
+
+	c, _ := NewTransitGatewayVPCAttachmentClient(cfg)
+	req := c.DescribeTransitGatewayVPCAttachmentsRequest(&DescribeTransitGatewayVPCAttachmentsInput{
+		TransitGatewayAttachmentIDs: []string{"tgw-attach-1"},
+	})
+	rsp, err := req.Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantForm := url.Values{
+		"Action":                        {"DescribeTransitGatewayVpcAttachments"},
+		"Version":                       {"2016-11-15"},
+		"TransitGatewayAttachmentIds.1": {"tgw-attach-1"},
+	}
+	if diff := cmp.Diff(wantForm, form); diff != "" {
+		t.Errorf("DescribeTransitGatewayVpcAttachments: -want form, +got form:\n%s", diff)
+	}
+
+	if len(rsp.TransitGatewayVPCAttachments) != 1 {
+		t.Fatalf("DescribeTransitGatewayVpcAttachments: want 1 attachment, got %d", len(rsp.TransitGatewayVPCAttachments))
+	}
+	a := rsp.TransitGatewayVPCAttachments[0]
+	want := v1alpha2.TransitGatewayVPCAttachmentExternalStatus{
+		TransitGatewayAttachmentID:    "tgw-attach-1",
+		TransitGatewayAttachmentState: "available",
+		VPCOwnerID:                    "123456789012",
+		SubnetIDs:                     []string{"subnet-1", "subnet-2"},
+		Tags:                          []v1alpha2.Tag{},
+	}
+	if diff := cmp.Diff(want, GenerateTransitGatewayVPCAttachmentObservation(a)); diff != "" {
+		t.Errorf("DescribeTransitGatewayVpcAttachments: -want observation, +got observation:\n%s", diff)
+	}
+	if diff := cmp.Diff("enable", aws.StringValue(a.Options.DNSSupport)); diff != "" {
+		t.Errorf("DescribeTransitGatewayVpcAttachments: -want dnsSupport, +got dnsSupport:\n%s", diff)
+	}
+
+	cfg, stop = testEC2Server(t, `<CreateTransitGatewayVpcAttachmentResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+    <requestId>a7a4a5a1-0000-0000-0000-000000000000</requestId>
+    <transitGatewayVpcAttachment>
+        <state>pending</state>
+        <subnetIds>
+            <item>subnet-1</item>
+        </subnetIds>
+        <tagSet>
+            <item><key>crossplane-uid</key><value>uid-1</value></item>
+        </tagSet>
+        <transitGatewayAttachmentId>tgw-attach-1</transitGatewayAttachmentId>
+        <transitGatewayId>tgw-1</transitGatewayId>
+        <vpcId>vpc-1</vpcId>
+        <vpcOwnerId>123456789012</vpcOwnerId>
+    </transitGatewayVpcAttachment>
+</CreateTransitGatewayVpcAttachmentResponse>`, &form)
+	defer stop()
+
+	c, _ = NewTransitGatewayVPCAttachmentClient(cfg)
+	created, err := c.CreateTransitGatewayVPCAttachmentRequest(GenerateCreateTransitGatewayVPCAttachmentInput(v1alpha2.TransitGatewayVPCAttachmentParameters{
+		TransitGatewayID: "tgw-1",
+		VPCID:            "vpc-1",
+		SubnetIDs:        []string{"subnet-1"},
+		DNSSupport:       aws.Bool(true),
+	}, []v1alpha2.Tag{{Key: TagKeyUID, Value: "uid-1"}})).Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantForm = url.Values{
+		"Action":                           {"CreateTransitGatewayVpcAttachment"},
+		"Version":                          {"2016-11-15"},
+		"TransitGatewayId":                 {"tgw-1"},
+		"VpcId":                            {"vpc-1"},
+		"SubnetIds.1":                      {"subnet-1"},
+		"Options.DnsSupport":               {"enable"},
+		"TagSpecifications.1.ResourceType": {"transit-gateway-attachment"},
+		"TagSpecifications.1.Tag.1.Key":    {TagKeyUID},
+		"TagSpecifications.1.Tag.1.Value":  {"uid-1"},
+	}
+	if diff := cmp.Diff(wantForm, form); diff != "" {
+		t.Errorf("CreateTransitGatewayVpcAttachment: -want form, +got form:\n%s", diff)
+	}
+	if diff := cmp.Diff([]v1alpha2.Tag{{Key: TagKeyUID, Value: "uid-1"}}, GenerateTransitGatewayVPCAttachmentObservation(*created.TransitGatewayVPCAttachment).Tags); diff != "" {
+		t.Errorf("CreateTransitGatewayVpcAttachment: -want tags, +got tags:\n%s", diff)
+	}
+}
+
+func Test_RouteRequests(t *testing.T) {
+	route := v1alpha2.Route{DestinationCIDRBlock: "172.16.0.0/12", TransitGatewayID: "tgw-1"}
+	wantForm := func(action string) url.Values {
+		return url.Values{
+			"Action":               {action},
+			"Version":              {"2016-11-15"},
+			"RouteTableId":         {"rtb-1"},
+			"DestinationCidrBlock": {"172.16.0.0/12"},
+			"TransitGatewayId":     {"tgw-1"},
+		}
+	}
+
+	var form url.Values
+	cfg, stop := testEC2Server(t, `<CreateRouteResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+    <requestId>a7a4a5a1-0000-0000-0000-000000000000</requestId>
+    <return>true</return>
+</CreateRouteResponse>`, &form)
+	defer stop()
+
+	c, _ := NewRouteTableClient(cfg)
+	if _, err := c.CreateRouteRequest(GenerateCreateRouteInput("rtb-1", route)).Send(); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantForm("CreateRoute"), form); diff != "" {
+		t.Errorf("CreateRoute: -want form, +got form:\n%s", diff)
+	}
+
+	cfg, stop = testEC2Server(t, `<ReplaceRouteResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+    <requestId>a7a4a5a1-0000-0000-0000-000000000000</requestId>
+    <return>true</return>
+</ReplaceRouteResponse>`, &form)
+	defer stop()
+
+	c, _ = NewRouteTableClient(cfg)
+	if _, err := c.ReplaceRouteRequest(GenerateReplaceRouteInput("rtb-1", route)).Send(); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(wantForm("ReplaceRoute"), form); diff != "" {
+		t.Errorf("ReplaceRoute: -want form, +got form:\n%s", diff)
+	}
+}
+
+func Test_DescribeRouteTransitGatewaysRequest(t *testing.T) {
+	var form url.Values
+	cfg, stop := testEC2Server(t, `<DescribeRouteTablesResponse xmlns="http://ec2.amazonaws.com/doc/2016-11-15/">
+    <requestId>a7a4a5a1-0000-0000-0000-000000000000</requestId>
+    <routeTableSet>
+        <item>
+            <routeTableId>rtb-1</routeTableId>
+            <vpcId>vpc-1</vpcId>
+            <routeSet>
+                <item>
+                    <destinationCidrBlock>10.0.0.0/16</destinationCidrBlock>
+                    <gatewayId>local</gatewayId>
+                    <state>active</state>
+                    <origin>CreateRouteTable</origin>
+                </item>
+                <item>
+                    <destinationCidrBlock>172.16.0.0/12</destinationCidrBlock>
+                    <transitGatewayId>tgw-1</transitGatewayId>
+                    <state>active</state>
+                    <origin>CreateRoute</origin>
+                </item>
+                <item>
+                    <destinationIpv6CidrBlock>2600::/16</destinationIpv6CidrBlock>
+                    <transitGatewayId>tgw-2</transitGatewayId>
+                    <state>active</state>
+                    <origin>CreateRoute</origin>
+                </item>
+            </routeSet>
+            <associationSet/>
+            <tagSet/>
+        </item>
+    </routeTableSet>
+</DescribeRouteTablesResponse>`, &form)
+	defer stop()
+
+	c, _ := NewRouteTableClient(cfg)
+	rsp, err := c.DescribeRouteTransitGatewaysRequest(&ec2.DescribeRouteTablesInput{RouteTableIds: []string{"rtb-1"}}).Send()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantForm := url.Values{
+		"Action":         {"DescribeRouteTables"},
+		"Version":        {"2016-11-15"},
+		"RouteTableId.1": {"rtb-1"},
+	}
+	if diff := cmp.Diff(wantForm, form); diff != "" {
+		t.Errorf("DescribeRouteTables: -want form, +got form:\n%s", diff)
+	}
+
+	want := map[string]string{"172.16.0.0/12": "tgw-1", "ipv6:2600::/16": "tgw-2"}
+	if diff := cmp.Diff(want, TransitGatewayTargets(rsp)); diff != "" {
+		t.Errorf("DescribeRouteTables: -want targets, +got targets:\n%s", diff)
+	}
+}
+
+func Test_GenerateModifyTransitGatewayVPCAttachmentInput(t *testing.T) {
+	observed := TransitGatewayVPCAttachment{
+		SubnetIDs: []string{"subnet-1", "subnet-2"},
+		Options: &TransitGatewayVPCAttachmentOptions{
+			DNSSupport:  aws.String(optionEnable),
+			IPv6Support: aws.String(optionDisable),
+		},
+	}
+
+	cases := map[string]struct {
+		params v1alpha2.TransitGatewayVPCAttachmentParameters
+		want   *ModifyTransitGatewayVPCAttachmentInput
+	}{
+		"UpToDate": {
+			params: v1alpha2.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs:  []string{"subnet-2", "subnet-1"},
+				DNSSupport: aws.Bool(true),
+			},
+			want: nil,
+		},
+		"SubnetsChanged": {
+			params: v1alpha2.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs: []string{"subnet-1", "subnet-3"},
+			},
+			want: &ModifyTransitGatewayVPCAttachmentInput{
+				TransitGatewayAttachmentID: aws.String("tgw-attach-1"),
+				AddSubnetIDs:               []string{"subnet-3"},
+				RemoveSubnetIDs:            []string{"subnet-2"},
+			},
+		},
+		"OptionsChanged": {
+			params: v1alpha2.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs:   []string{"subnet-1", "subnet-2"},
+				IPv6Support: aws.Bool(true),
+			},
+			want: &ModifyTransitGatewayVPCAttachmentInput{
+				TransitGatewayAttachmentID: aws.String("tgw-attach-1"),
+				Options: &TransitGatewayVPCAttachmentRequestOptions{
+					IPv6Support: aws.String(optionEnable),
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GenerateModifyTransitGatewayVPCAttachmentInput("tgw-attach-1", tc.params, observed)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsNetworkACLNotFoundErr(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		got  error
+		want bool
+	}{
+		{
+			"nil error is not",
+			nil,
+			false,
+		},
+		{
+			"other error is not",
+			errors.New("some error"),
+			false,
+		},
+		{
+			"NetworkACLIDNotFound is",
+			awserr.New(NetworkACLIDNotFound, "", nil),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			if diff := cmp.Diff(tc.want, IsNetworkACLNotFoundErr(tc.got), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsNetworkACLEntryNotFoundErr(t *testing.T) {
+
+	testCases := []struct {
+		name string
+		got  error
+		want bool
+	}{
+		{
+			"nil error is not",
+			nil,
+			false,
+		},
+		{
+			"other error is not",
+			errors.New("some error"),
+			false,
+		},
+		{
+			"NetworkACLEntryNotFound is",
+			awserr.New(NetworkACLEntryNotFound, "", nil),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+
+			if diff := cmp.Diff(tc.want, IsNetworkACLEntryNotFoundErr(tc.got), test.EquateConditions()); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_DiffNetworkACLEntries(t *testing.T) {
+	observed := []ec2.NetworkAclEntry{
+		{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), Protocol: aws.String("6"), RuleAction: ec2.RuleActionAllow, CidrBlock: aws.String("0.0.0.0/0"), PortRange: &ec2.PortRange{From: aws.Int64(443), To: aws.Int64(443)}},
+		{RuleNumber: aws.Int64(110), Egress: aws.Bool(false), Protocol: aws.String("1"), RuleAction: ec2.RuleActionDeny, CidrBlock: aws.String("10.0.0.0/8"), IcmpTypeCode: &ec2.IcmpTypeCode{Type: aws.Int64(-1), Code: aws.Int64(-1)}},
+		{RuleNumber: aws.Int64(120), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: ec2.RuleActionAllow, Ipv6CidrBlock: aws.String("::/0")},
+		{RuleNumber: aws.Int64(32767), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: ec2.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+		{RuleNumber: aws.Int64(100), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: ec2.RuleActionAllow, CidrBlock: aws.String("0.0.0.0/0")},
+	}
+
+	testCases := []struct {
+		name        string
+		desired     []v1alpha2.NetworkACLEntry
+		wantCreate  []v1alpha2.NetworkACLEntry
+		wantReplace []v1alpha2.NetworkACLEntry
+		wantRemove  []ec2.NetworkAclEntry
+	}{
+		{
+			"matching entries produce no delta",
+			[]v1alpha2.NetworkACLEntry{
+				{RuleNumber: 100, Protocol: "tcp", RuleAction: "allow", CIDRBlock: "0.0.0.0/0", PortRange: &v1alpha2.NetworkACLPortRange{From: 443, To: 443}},
+				{RuleNumber: 110, Protocol: "icmp", RuleAction: "deny", CIDRBlock: "10.0.0.0/8", ICMPTypeCode: &v1alpha2.NetworkACLICMPTypeCode{Type: -1, Code: -1}},
+				{RuleNumber: 120, Protocol: "-1", RuleAction: "allow", IPv6CIDRBlock: "::/0", PortRange: &v1alpha2.NetworkACLPortRange{From: 1, To: 2}},
+			},
+			[]v1alpha2.NetworkACLEntry{},
+			[]v1alpha2.NetworkACLEntry{},
+			[]ec2.NetworkAclEntry{},
+		},
+		{
+			"equivalent CIDR blocks produce no delta",
+			[]v1alpha2.NetworkACLEntry{
+				{RuleNumber: 100, Protocol: "tcp", RuleAction: "allow", CIDRBlock: "0.0.0.0/0", PortRange: &v1alpha2.NetworkACLPortRange{From: 443, To: 443}},
+				{RuleNumber: 110, Protocol: "icmp", RuleAction: "deny", CIDRBlock: "10.1.2.3/8", ICMPTypeCode: &v1alpha2.NetworkACLICMPTypeCode{Type: -1, Code: -1}},
+				{RuleNumber: 120, Protocol: "-1", RuleAction: "allow", IPv6CIDRBlock: "0:0::0/0"},
+			},
+			[]v1alpha2.NetworkACLEntry{},
+			[]v1alpha2.NetworkACLEntry{},
+			[]ec2.NetworkAclEntry{},
+		},
+		{
+			"changed entries are replaced, new entries created and stale entries removed",
+			[]v1alpha2.NetworkACLEntry{
+				{RuleNumber: 100, Protocol: "tcp", RuleAction: "allow", CIDRBlock: "0.0.0.0/0", PortRange: &v1alpha2.NetworkACLPortRange{From: 80, To: 80}},
+				{RuleNumber: 110, Protocol: "1", RuleAction: "allow", CIDRBlock: "10.0.0.0/8", ICMPTypeCode: &v1alpha2.NetworkACLICMPTypeCode{Type: -1, Code: -1}},
+				{RuleNumber: 200, Protocol: "udp", RuleAction: "deny", CIDRBlock: "192.168.0.0/16", PortRange: &v1alpha2.NetworkACLPortRange{From: 53, To: 53}},
+			},
+			[]v1alpha2.NetworkACLEntry{
+				{RuleNumber: 200, Protocol: "udp", RuleAction: "deny", CIDRBlock: "192.168.0.0/16", PortRange: &v1alpha2.NetworkACLPortRange{From: 53, To: 53}},
+			},
+			[]v1alpha2.NetworkACLEntry{
+				{RuleNumber: 100, Protocol: "tcp", RuleAction: "allow", CIDRBlock: "0.0.0.0/0", PortRange: &v1alpha2.NetworkACLPortRange{From: 80, To: 80}},
+				{RuleNumber: 110, Protocol: "1", RuleAction: "allow", CIDRBlock: "10.0.0.0/8", ICMPTypeCode: &v1alpha2.NetworkACLICMPTypeCode{Type: -1, Code: -1}},
+			},
+			[]ec2.NetworkAclEntry{observed[2]},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			create, replace, remove := DiffNetworkACLEntries(tc.desired, observed, false)
+			if diff := cmp.Diff(tc.wantCreate, create); diff != "" {
+				t.Errorf("create: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantReplace, replace); diff != "" {
+				t.Errorf("replace: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRemove, remove); diff != "" {
+				t.Errorf("remove: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_DiffNetworkACLAssociations(t *testing.T) {
+	observed := []ec2.NetworkAclAssociation{
+		{NetworkAclAssociationId: aws.String("aclassoc-1"), SubnetId: aws.String("subnet-1")},
+		{NetworkAclAssociationId: aws.String("aclassoc-2"), SubnetId: aws.String("subnet-2")},
+	}
+
+	associate, disassociate := DiffNetworkACLAssociations([]v1alpha2.NetworkACLAssociation{
+		{SubnetID: "subnet-1"},
+		{SubnetID: "subnet-3"},
+	}, observed)
+
+	if diff := cmp.Diff([]string{"subnet-3"}, associate); diff != "" {
+		t.Errorf("associate: -want, +got:\n%s", diff)
+	}
+	if diff := cmp.Diff([]ec2.NetworkAclAssociation{observed[1]}, disassociate); diff != "" {
+		t.Errorf("disassociate: -want, +got:\n%s", diff)
+	}
+}
+
+func Test_GenerateNetworkACLEntryInputs(t *testing.T) {
+	entry := v1alpha2.NetworkACLEntry{
+		RuleNumber:   100,
+		Protocol:     "icmpv6",
+		RuleAction:   "deny",
+		ICMPTypeCode: &v1alpha2.NetworkACLICMPTypeCode{Type: 128, Code: 0},
+	}
+
+	want := &ec2.ReplaceNetworkAclEntryInput{
+		NetworkAclId: aws.String("acl-1"),
+		Egress:       aws.Bool(true),
+		RuleNumber:   aws.Int64(100),
+		Protocol:     aws.String("58"),
+		RuleAction:   ec2.RuleActionDeny,
+		IcmpTypeCode: &ec2.IcmpTypeCode{Type: aws.Int64(128), Code: aws.Int64(0)},
+	}
+
+	if diff := cmp.Diff(want, GenerateReplaceNetworkACLEntryInput("acl-1", true, entry)); diff != "" {
+		t.Errorf("GenerateReplaceNetworkACLEntryInput(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,36 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+const (
+	// AllocationIDNotFound is the code that is returned by ec2 when the given AllocationID is not valid
+	AllocationIDNotFound = "InvalidAllocationID.NotFound"
+)
+
+// ElasticIPClient is the external client used for ElasticIP Custom Resource
+type ElasticIPClient interface {
+	AllocateAddressRequest(input *ec2.AllocateAddressInput) ec2.AllocateAddressRequest
+	DescribeAddressesRequest(input *ec2.DescribeAddressesInput) ec2.DescribeAddressesRequest
+	ReleaseAddressRequest(input *ec2.ReleaseAddressInput) ec2.ReleaseAddressRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewElasticIPClient returns a new client using AWS credentials as JSON encoded data.
+func NewElasticIPClient(cfg *aws.Config) (ElasticIPClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsAddressNotFoundErr returns true if the error is because the item doesn't exist
+func IsAddressNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == AllocationIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,27 @@
+package ec2
+
+import (
+	"regexp"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
+)
+
+// the part of an EC2 resource ID that follows its prefix; older resources
+// have 8 character IDs, newer ones 17 character IDs.
+var resourceIDSuffix = regexp.MustCompile(`^[0-9a-f]{8}([0-9a-f]{9})?$`)
+
+// ExternalNameID returns the ID of an existing EC2 resource that should be
+// adopted by the supplied object, or an empty string if there is none. The ID
+// is read from the external name annotation of the object. The managed
+// reconciler defaults that annotation to the name of the object, so it is only
+// considered an ID if it has the supplied prefix followed by a valid suffix.
+func ExternalNameID(o metav1.Object, prefix string) string {
+	name := meta.GetExternalName(o)
+	if !strings.HasPrefix(name, prefix) || !resourceIDSuffix.MatchString(strings.TrimPrefix(name, prefix)) {
+		return ""
+	}
+	return name
+}
@@ -32,6 +32,7 @@ type MockInternetGatewayClient struct {
 	MockDescribeInternetGatewaysRequest func(*ec2.DescribeInternetGatewaysInput) ec2.DescribeInternetGatewaysRequest
 	MockAttachInternetGatewayRequest    func(*ec2.AttachInternetGatewayInput) ec2.AttachInternetGatewayRequest
 	MockDetachInternetGatewayRequest    func(*ec2.DetachInternetGatewayInput) ec2.DetachInternetGatewayRequest
+	MockCreateTagsRequest               func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
 }
 
 // CreateInternetGatewayRequest mocks CreateInternetGatewayRequest method
@@ -58,3 +59,8 @@ func (m *MockInternetGatewayClient) AttachInternetGatewayRequest(input *ec2.Atta
 func (m *MockInternetGatewayClient) DetachInternetGatewayRequest(input *ec2.DetachInternetGatewayInput) ec2.DetachInternetGatewayRequest {
 	return m.MockDetachInternetGatewayRequest(input)
 }
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockInternetGatewayClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTagsRequest(input)
+}
@@ -27,13 +27,17 @@ var _ clientset.RouteTableClient = (*MockRouteTableClient)(nil)
 
 // MockRouteTableClient is a type that implements all the methods for RouteTableClient interface
 type MockRouteTableClient struct {
-	MockCreateRouteTableRequest       func(*ec2.CreateRouteTableInput) ec2.CreateRouteTableRequest
-	MockDeleteRouteTableRequest       func(*ec2.DeleteRouteTableInput) ec2.DeleteRouteTableRequest
-	MockDescribeRouteTablesRequest    func(*ec2.DescribeRouteTablesInput) ec2.DescribeRouteTablesRequest
-	MockCreateRouteRequest            func(*ec2.CreateRouteInput) ec2.CreateRouteRequest
-	MockDeleteRouteRequest            func(*ec2.DeleteRouteInput) ec2.DeleteRouteRequest
-	MockAssociateRouteTableRequest    func(*ec2.AssociateRouteTableInput) ec2.AssociateRouteTableRequest
-	MockDisassociateRouteTableRequest func(*ec2.DisassociateRouteTableInput) ec2.DisassociateRouteTableRequest
+	MockCreateRouteTableRequest             func(*ec2.CreateRouteTableInput) ec2.CreateRouteTableRequest
+	MockDeleteRouteTableRequest             func(*ec2.DeleteRouteTableInput) ec2.DeleteRouteTableRequest
+	MockDescribeRouteTablesRequest          func(*ec2.DescribeRouteTablesInput) ec2.DescribeRouteTablesRequest
+	MockDescribeRouteTransitGatewaysRequest func(*ec2.DescribeRouteTablesInput) clientset.DescribeRouteTransitGatewaysRequest
+	MockCreateRouteRequest                  func(*clientset.CreateRouteInput) ec2.CreateRouteRequest
+	MockReplaceRouteRequest                 func(*clientset.ReplaceRouteInput) ec2.ReplaceRouteRequest
+	MockDeleteRouteRequest                  func(*ec2.DeleteRouteInput) ec2.DeleteRouteRequest
+	MockAssociateRouteTableRequest          func(*ec2.AssociateRouteTableInput) ec2.AssociateRouteTableRequest
+	MockReplaceRouteTableAssociationRequest func(*ec2.ReplaceRouteTableAssociationInput) ec2.ReplaceRouteTableAssociationRequest
+	MockDisassociateRouteTableRequest       func(*ec2.DisassociateRouteTableInput) ec2.DisassociateRouteTableRequest
+	MockCreateTagsRequest                   func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
 }
 
 // CreateRouteTableRequest mocks CreateRouteTableRequest method
@@ -51,18 +55,28 @@ func (m *MockRouteTableClient) DescribeRouteTablesRequest(input *ec2.DescribeRou
 	return m.MockDescribeRouteTablesRequest(input)
 }
 
+// DescribeRouteTransitGatewaysRequest mocks DescribeRouteTransitGatewaysRequest method
+func (m *MockRouteTableClient) DescribeRouteTransitGatewaysRequest(input *ec2.DescribeRouteTablesInput) clientset.DescribeRouteTransitGatewaysRequest {
+	return m.MockDescribeRouteTransitGatewaysRequest(input)
+}
+
 // AssociateRouteTableRequest mocks AssociateRouteTableRequest method
 func (m *MockRouteTableClient) AssociateRouteTableRequest(input *ec2.AssociateRouteTableInput) ec2.AssociateRouteTableRequest {
 	return m.MockAssociateRouteTableRequest(input)
 }
 
+// ReplaceRouteTableAssociationRequest mocks ReplaceRouteTableAssociationRequest method
+func (m *MockRouteTableClient) ReplaceRouteTableAssociationRequest(input *ec2.ReplaceRouteTableAssociationInput) ec2.ReplaceRouteTableAssociationRequest {
+	return m.MockReplaceRouteTableAssociationRequest(input)
+}
+
 // DisassociateRouteTableRequest mocks DisassociateRouteTableRequest method
 func (m *MockRouteTableClient) DisassociateRouteTableRequest(input *ec2.DisassociateRouteTableInput) ec2.DisassociateRouteTableRequest {
 	return m.MockDisassociateRouteTableRequest(input)
 }
 
 // CreateRouteRequest mocks CreateRouteRequest method
-func (m *MockRouteTableClient) CreateRouteRequest(input *ec2.CreateRouteInput) ec2.CreateRouteRequest {
+func (m *MockRouteTableClient) CreateRouteRequest(input *clientset.CreateRouteInput) ec2.CreateRouteRequest {
 	return m.MockCreateRouteRequest(input)
 }
 
@@ -70,3 +84,13 @@ func (m *MockRouteTableClient) CreateRouteRequest(input *ec2.CreateRouteInput) e
 func (m *MockRouteTableClient) DeleteRouteRequest(input *ec2.DeleteRouteInput) ec2.DeleteRouteRequest {
 	return m.MockDeleteRouteRequest(input)
 }
+
+// ReplaceRouteRequest mocks ReplaceRouteRequest method
+func (m *MockRouteTableClient) ReplaceRouteRequest(input *clientset.ReplaceRouteInput) ec2.ReplaceRouteRequest {
+	return m.MockReplaceRouteRequest(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockRouteTableClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTagsRequest(input)
+}
@@ -32,6 +32,9 @@ type MockSecurityGroupClient struct {
 	MockDescribeSecurityGroupsRequest        func(*ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest
 	MockAuthorizeSecurityGroupIngressRequest func(*ec2.AuthorizeSecurityGroupIngressInput) ec2.AuthorizeSecurityGroupIngressRequest
 	MockAuthorizeSecurityGroupEgressRequest  func(*ec2.AuthorizeSecurityGroupEgressInput) ec2.AuthorizeSecurityGroupEgressRequest
+	MockRevokeSecurityGroupIngressRequest    func(*ec2.RevokeSecurityGroupIngressInput) ec2.RevokeSecurityGroupIngressRequest
+	MockRevokeSecurityGroupEgressRequest     func(*ec2.RevokeSecurityGroupEgressInput) ec2.RevokeSecurityGroupEgressRequest
+	MockCreateTagsRequest                    func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
 }
 
 // CreateSecurityGroupRequest mocks CreateSecurityGroupRequest method
@@ -58,3 +61,18 @@ func (m *MockSecurityGroupClient) AuthorizeSecurityGroupIngressRequest(input *ec
 func (m *MockSecurityGroupClient) AuthorizeSecurityGroupEgressRequest(input *ec2.AuthorizeSecurityGroupEgressInput) ec2.AuthorizeSecurityGroupEgressRequest {
 	return m.MockAuthorizeSecurityGroupEgressRequest(input)
 }
+
+// RevokeSecurityGroupIngressRequest mocks RevokeSecurityGroupIngressRequest method
+func (m *MockSecurityGroupClient) RevokeSecurityGroupIngressRequest(input *ec2.RevokeSecurityGroupIngressInput) ec2.RevokeSecurityGroupIngressRequest {
+	return m.MockRevokeSecurityGroupIngressRequest(input)
+}
+
+// RevokeSecurityGroupEgressRequest mocks RevokeSecurityGroupEgressRequest method
+func (m *MockSecurityGroupClient) RevokeSecurityGroupEgressRequest(input *ec2.RevokeSecurityGroupEgressInput) ec2.RevokeSecurityGroupEgressRequest {
+	return m.MockRevokeSecurityGroupEgressRequest(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockSecurityGroupClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTagsRequest(input)
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	clientset "github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.TransitGatewayVPCAttachmentClient = (*MockTransitGatewayVPCAttachmentClient)(nil)
+
+// MockTransitGatewayVPCAttachmentClient is a type that implements all the methods for TransitGatewayVPCAttachmentClient interface
+type MockTransitGatewayVPCAttachmentClient struct {
+	MockCreateTransitGatewayVPCAttachmentRequest    func(*clientset.CreateTransitGatewayVPCAttachmentInput) clientset.CreateTransitGatewayVPCAttachmentRequest
+	MockDescribeTransitGatewayVPCAttachmentsRequest func(*clientset.DescribeTransitGatewayVPCAttachmentsInput) clientset.DescribeTransitGatewayVPCAttachmentsRequest
+	MockModifyTransitGatewayVPCAttachmentRequest    func(*clientset.ModifyTransitGatewayVPCAttachmentInput) clientset.ModifyTransitGatewayVPCAttachmentRequest
+	MockDeleteTransitGatewayVPCAttachmentRequest    func(*clientset.DeleteTransitGatewayVPCAttachmentInput) clientset.DeleteTransitGatewayVPCAttachmentRequest
+	MockCreateTagsRequest                           func(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// CreateTransitGatewayVPCAttachmentRequest mocks CreateTransitGatewayVPCAttachmentRequest method
+func (m *MockTransitGatewayVPCAttachmentClient) CreateTransitGatewayVPCAttachmentRequest(input *clientset.CreateTransitGatewayVPCAttachmentInput) clientset.CreateTransitGatewayVPCAttachmentRequest {
+	return m.MockCreateTransitGatewayVPCAttachmentRequest(input)
+}
+
+// DescribeTransitGatewayVPCAttachmentsRequest mocks DescribeTransitGatewayVPCAttachmentsRequest method
+func (m *MockTransitGatewayVPCAttachmentClient) DescribeTransitGatewayVPCAttachmentsRequest(input *clientset.DescribeTransitGatewayVPCAttachmentsInput) clientset.DescribeTransitGatewayVPCAttachmentsRequest {
+	return m.MockDescribeTransitGatewayVPCAttachmentsRequest(input)
+}
+
+// ModifyTransitGatewayVPCAttachmentRequest mocks ModifyTransitGatewayVPCAttachmentRequest method
+func (m *MockTransitGatewayVPCAttachmentClient) ModifyTransitGatewayVPCAttachmentRequest(input *clientset.ModifyTransitGatewayVPCAttachmentInput) clientset.ModifyTransitGatewayVPCAttachmentRequest {
+	return m.MockModifyTransitGatewayVPCAttachmentRequest(input)
+}
+
+// DeleteTransitGatewayVPCAttachmentRequest mocks DeleteTransitGatewayVPCAttachmentRequest method
+func (m *MockTransitGatewayVPCAttachmentClient) DeleteTransitGatewayVPCAttachmentRequest(input *clientset.DeleteTransitGatewayVPCAttachmentInput) clientset.DeleteTransitGatewayVPCAttachmentRequest {
+	return m.MockDeleteTransitGatewayVPCAttachmentRequest(input)
+}
+
+// CreateTagsRequest mocks CreateTagsRequest method
+func (m *MockTransitGatewayVPCAttachmentClient) CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest {
+	return m.MockCreateTagsRequest(input)
+}
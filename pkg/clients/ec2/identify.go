@@ -0,0 +1,72 @@
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clients "github.com/crossplaneio/stack-aws/pkg/clients"
+)
+
+const (
+	errDescribeOwned = "cannot describe the EC2 resources tagged with the UID %v"
+	errMultipleOwned = "retrieved multiple EC2 resources tagged with the UID %v"
+)
+
+// ClientToken returns a token derived from the UID of the supplied object that
+// makes a request to create its EC2 resource idempotent: retrying a create
+// whose result couldn't be recorded returns the existing resource rather than
+// creating another one.
+func ClientToken(o metav1.Object) *string {
+	return clients.String(string(o.GetUID()))
+}
+
+// ReplacementClientToken returns the ClientToken of the supplied object if no
+// EC2 resource was recorded for it yet, and otherwise a token that also derives
+// from the supplied ID of the recorded resource. Resources that can't be used
+// anymore once deleted, but remain visible for a while, are thus replaced by a
+// new resource rather than returned again for the token they were created by.
+func ReplacementClientToken(o metav1.Object, recordedID string) *string {
+	if recordedID == "" {
+		return ClientToken(o)
+	}
+	return clients.String(string(o.GetUID()) + "-" + recordedID)
+}
+
+// A DescribeIDsFn returns the IDs of the EC2 resources that match all of the
+// supplied filters.
+type DescribeIDsFn func(ctx context.Context, filters []ec2.Filter) ([]string, error)
+
+// Identify returns the ID of the EC2 resource that belongs to the supplied
+// object, or an empty string if there is none: the supplied ID recorded in its
+// status, the one named by its external name if it has the supplied prefix, or
+// the one tagged with its UID in case its ID couldn't be recorded after
+// creating it. An empty prefix disables adoption by external name. The
+// supplied filters restrict the tagged resources that are described, for
+// example to the ones that are not deleted.
+func Identify(ctx context.Context, o metav1.Object, id, prefix string, describe DescribeIDsFn, filters ...ec2.Filter) (string, error) {
+	if id != "" {
+		return id, nil
+	}
+	if prefix != "" {
+		if id := ExternalNameID(o, prefix); id != "" {
+			return id, nil
+		}
+	}
+
+	ids, err := describe(ctx, OwnerTagFilters(o, filters...))
+	if err != nil {
+		return "", errors.Wrapf(err, errDescribeOwned, o.GetUID())
+	}
+
+	switch len(ids) {
+	case 0:
+		return "", nil
+	case 1:
+		return ids[0], nil
+	default:
+		return "", errors.Errorf(errMultipleOwned, o.GetUID())
+	}
+}
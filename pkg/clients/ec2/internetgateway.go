@@ -9,6 +9,9 @@ import (
 const (
 	// InternetGatewayIDNotFound is the code that is returned by ec2 when the given SubnetID is not valid
 	InternetGatewayIDNotFound = "InvalidInternetGatewayID.NotFound"
+
+	// InternetGatewayIDPrefix is the prefix of the IDs EC2 assigns to internet gateways
+	InternetGatewayIDPrefix = "igw-"
 )
 
 // InternetGatewayClient is the external client used for InternetGateway Custom Resource
@@ -18,6 +21,7 @@ type InternetGatewayClient interface {
 	DescribeInternetGatewaysRequest(input *ec2.DescribeInternetGatewaysInput) ec2.DescribeInternetGatewaysRequest
 	AttachInternetGatewayRequest(input *ec2.AttachInternetGatewayInput) ec2.AttachInternetGatewayRequest
 	DetachInternetGatewayRequest(input *ec2.DetachInternetGatewayInput) ec2.DetachInternetGatewayRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
 }
 
 // NewInternetGatewayClient returns a new client using AWS credentials as JSON encoded data.
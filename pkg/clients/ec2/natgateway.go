@@ -0,0 +1,36 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+const (
+	// NATGatewayNotFound is the code that is returned by ec2 when the given NATGatewayID is not valid
+	NATGatewayNotFound = "NatGatewayNotFound"
+)
+
+// NATGatewayClient is the external client used for NATGateway Custom Resource
+type NATGatewayClient interface {
+	CreateNatGatewayRequest(input *ec2.CreateNatGatewayInput) ec2.CreateNatGatewayRequest
+	DescribeNatGatewaysRequest(input *ec2.DescribeNatGatewaysInput) ec2.DescribeNatGatewaysRequest
+	DeleteNatGatewayRequest(input *ec2.DeleteNatGatewayInput) ec2.DeleteNatGatewayRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewNATGatewayClient returns a new client using AWS credentials as JSON encoded data.
+func NewNATGatewayClient(cfg *aws.Config) (NATGatewayClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsNATGatewayNotFoundErr returns true if the error is because the item doesn't exist
+func IsNATGatewayNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == NATGatewayNotFound {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,237 @@
+package ec2
+
+import (
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	clients "github.com/crossplaneio/stack-aws/pkg/clients"
+)
+
+const (
+	// NetworkACLIDNotFound is the code that is returned by ec2 when the given NetworkACLID is invalid
+	NetworkACLIDNotFound = "InvalidNetworkAclID.NotFound"
+
+	// NetworkACLEntryNotFound is the code that is returned when the given network ACL entry is not found
+	NetworkACLEntryNotFound = "InvalidNetworkAclEntry.NotFound"
+
+	// NetworkACLMaxRuleNumber is the highest rule number of an entry that can
+	// be managed. Entries with a higher rule number are the default entries
+	// of a network ACL.
+	NetworkACLMaxRuleNumber = 32766
+)
+
+// NetworkACLClient is the external client used for NetworkACL Custom Resource
+type NetworkACLClient interface {
+	CreateNetworkAclRequest(*ec2.CreateNetworkAclInput) ec2.CreateNetworkAclRequest
+	DeleteNetworkAclRequest(*ec2.DeleteNetworkAclInput) ec2.DeleteNetworkAclRequest
+	DescribeNetworkAclsRequest(*ec2.DescribeNetworkAclsInput) ec2.DescribeNetworkAclsRequest
+
+	CreateNetworkAclEntryRequest(*ec2.CreateNetworkAclEntryInput) ec2.CreateNetworkAclEntryRequest
+	ReplaceNetworkAclEntryRequest(*ec2.ReplaceNetworkAclEntryInput) ec2.ReplaceNetworkAclEntryRequest
+	DeleteNetworkAclEntryRequest(*ec2.DeleteNetworkAclEntryInput) ec2.DeleteNetworkAclEntryRequest
+
+	ReplaceNetworkAclAssociationRequest(*ec2.ReplaceNetworkAclAssociationInput) ec2.ReplaceNetworkAclAssociationRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewNetworkACLClient returns a new client using AWS credentials as JSON encoded data.
+func NewNetworkACLClient(cfg *aws.Config) (NetworkACLClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsNetworkACLNotFoundErr returns true if the error is because the network ACL doesn't exist
+func IsNetworkACLNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == NetworkACLIDNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNetworkACLEntryNotFoundErr returns true if the error is because the network ACL entry doesn't exist
+func IsNetworkACLEntryNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == NetworkACLEntryNotFound {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNetworkACLUpToDate returns true if the entries and subnet associations of
+// the supplied network ACL match the desired parameters.
+func IsNetworkACLUpToDate(p v1alpha2.NetworkACLParameters, acl ec2.NetworkAcl) bool {
+	for _, egress := range []bool{false, true} {
+		desired := p.IngressEntries
+		if egress {
+			desired = p.EgressEntries
+		}
+		create, replace, remove := DiffNetworkACLEntries(desired, acl.Entries, egress)
+		if len(create) != 0 || len(replace) != 0 || len(remove) != 0 {
+			return false
+		}
+	}
+
+	associate, disassociate := DiffNetworkACLAssociations(p.Associations, acl.Associations)
+	return len(associate) == 0 && len(disassociate) == 0
+}
+
+// DiffNetworkACLEntries compares the desired entries of one direction of a
+// network ACL to the observed ones, by rule number. It returns the entries
+// that must be created, the entries that must be replaced, and the observed
+// entries that must be deleted. The default entries of a network ACL are
+// never deleted.
+func DiffNetworkACLEntries(desired []v1alpha2.NetworkACLEntry, observed []ec2.NetworkAclEntry, egress bool) (create, replace []v1alpha2.NetworkACLEntry, remove []ec2.NetworkAclEntry) {
+	have := make(map[int64]ec2.NetworkAclEntry, len(observed))
+	for _, e := range observed {
+		if aws.BoolValue(e.Egress) == egress {
+			have[aws.Int64Value(e.RuleNumber)] = e
+		}
+	}
+
+	want := make(map[int64]bool, len(desired))
+	create, replace = make([]v1alpha2.NetworkACLEntry, 0), make([]v1alpha2.NetworkACLEntry, 0)
+	for _, e := range desired {
+		want[e.RuleNumber] = true
+		o, ok := have[e.RuleNumber]
+		switch {
+		case !ok:
+			create = append(create, e)
+		case !isNetworkACLEntryUpToDate(e, o):
+			replace = append(replace, e)
+		}
+	}
+
+	remove = make([]ec2.NetworkAclEntry, 0)
+	for _, e := range observed {
+		n := aws.Int64Value(e.RuleNumber)
+		if aws.BoolValue(e.Egress) != egress || n > NetworkACLMaxRuleNumber || want[n] {
+			continue
+		}
+		remove = append(remove, e)
+	}
+
+	return create, replace, remove
+}
+
+// DiffNetworkACLAssociations compares the desired subnet associations of a
+// network ACL to the observed ones. It returns the IDs of the subnets that
+// must be associated, and the observed associations that must be moved back
+// to the default network ACL of the VPC.
+func DiffNetworkACLAssociations(desired []v1alpha2.NetworkACLAssociation, observed []ec2.NetworkAclAssociation) (associate []string, disassociate []ec2.NetworkAclAssociation) {
+	have := make(map[string]bool, len(observed))
+	for _, a := range observed {
+		have[aws.StringValue(a.SubnetId)] = true
+	}
+
+	want := make(map[string]bool, len(desired))
+	associate = make([]string, 0)
+	for _, a := range desired {
+		want[a.SubnetID] = true
+		if !have[a.SubnetID] {
+			associate = append(associate, a.SubnetID)
+		}
+	}
+
+	disassociate = make([]ec2.NetworkAclAssociation, 0)
+	for _, a := range observed {
+		if !want[aws.StringValue(a.SubnetId)] {
+			disassociate = append(disassociate, a)
+		}
+	}
+
+	return associate, disassociate
+}
+
+// GenerateCreateNetworkACLEntryInput returns the input to create the supplied
+// entry in the supplied network ACL.
+func GenerateCreateNetworkACLEntryInput(aclID string, egress bool, e v1alpha2.NetworkACLEntry) *ec2.CreateNetworkAclEntryInput {
+	input := &ec2.CreateNetworkAclEntryInput{
+		NetworkAclId:  aws.String(aclID),
+		Egress:        aws.Bool(egress),
+		RuleNumber:    aws.Int64(e.RuleNumber),
+		Protocol:      aws.String(networkACLProtocol(e.Protocol)),
+		RuleAction:    ec2.RuleAction(e.RuleAction),
+		CidrBlock:     clients.String(e.CIDRBlock),
+		Ipv6CidrBlock: clients.String(e.IPv6CIDRBlock),
+	}
+	if e.PortRange != nil {
+		input.PortRange = &ec2.PortRange{
+			From: aws.Int64(e.PortRange.From),
+			To:   aws.Int64(e.PortRange.To),
+		}
+	}
+	if e.ICMPTypeCode != nil {
+		input.IcmpTypeCode = &ec2.IcmpTypeCode{
+			Type: aws.Int64(e.ICMPTypeCode.Type),
+			Code: aws.Int64(e.ICMPTypeCode.Code),
+		}
+	}
+	return input
+}
+
+// GenerateReplaceNetworkACLEntryInput returns the input to replace the entry
+// with the rule number of the supplied entry in the supplied network ACL.
+func GenerateReplaceNetworkACLEntryInput(aclID string, egress bool, e v1alpha2.NetworkACLEntry) *ec2.ReplaceNetworkAclEntryInput {
+	input := ec2.ReplaceNetworkAclEntryInput(*GenerateCreateNetworkACLEntryInput(aclID, egress, e))
+	return &input
+}
+
+// GenerateDeleteNetworkACLEntryInput returns the input to delete the supplied
+// observed entry from the supplied network ACL.
+func GenerateDeleteNetworkACLEntryInput(aclID string, e ec2.NetworkAclEntry) *ec2.DeleteNetworkAclEntryInput {
+	return &ec2.DeleteNetworkAclEntryInput{
+		NetworkAclId: aws.String(aclID),
+		Egress:       e.Egress,
+		RuleNumber:   e.RuleNumber,
+	}
+}
+
+// isNetworkACLEntryUpToDate compares a desired entry to an observed entry with
+// the same rule number. CIDR blocks are compared in their canonical form, and
+// port ranges and ICMP types are only compared for the protocols EC2 takes them
+// into account for.
+func isNetworkACLEntryUpToDate(e v1alpha2.NetworkACLEntry, o ec2.NetworkAclEntry) bool { // nolint:gocyclo
+	protocol := networkACLProtocol(e.Protocol)
+	if protocol != aws.StringValue(o.Protocol) ||
+		e.RuleAction != string(o.RuleAction) ||
+		CanonicalCIDR(e.CIDRBlock) != CanonicalCIDR(aws.StringValue(o.CidrBlock)) ||
+		CanonicalCIDR(e.IPv6CIDRBlock) != CanonicalCIDR(aws.StringValue(o.Ipv6CidrBlock)) {
+		return false
+	}
+
+	switch protocol {
+	case "6", "17":
+		return e.PortRange != nil && o.PortRange != nil &&
+			e.PortRange.From == aws.Int64Value(o.PortRange.From) &&
+			e.PortRange.To == aws.Int64Value(o.PortRange.To)
+	case "1", "58":
+		return e.ICMPTypeCode != nil && o.IcmpTypeCode != nil &&
+			e.ICMPTypeCode.Type == aws.Int64Value(o.IcmpTypeCode.Type) &&
+			e.ICMPTypeCode.Code == aws.Int64Value(o.IcmpTypeCode.Code)
+	}
+	return true
+}
+
+// networkACLProtocol converts well known protocol names to the numbers EC2
+// reports the protocols of network ACL entries by.
+func networkACLProtocol(p string) string {
+	switch strings.ToLower(p) {
+	case "tcp":
+		return "6"
+	case "udp":
+		return "17"
+	case "icmp":
+		return "1"
+	case "icmpv6":
+		return "58"
+	case "all":
+		return "-1"
+	}
+	return p
+}
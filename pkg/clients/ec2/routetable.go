@@ -4,6 +4,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	clients "github.com/crossplaneio/stack-aws/pkg/clients"
 )
 
 const (
@@ -13,6 +16,9 @@ const (
 	// RouteTableIDNotFound is the code that is returned by ec2 when the given SubnetID is invalid
 	RouteTableIDNotFound = "InvalidRouteTableID.NotFound"
 
+	// RouteTableIDPrefix is the prefix of the IDs EC2 assigns to route tables
+	RouteTableIDPrefix = "rtb-"
+
 	// RouteNotFound is the code that is returned when the given route is not found
 	RouteNotFound = "InvalidRoute.NotFound"
 
@@ -25,17 +31,110 @@ type RouteTableClient interface {
 	CreateRouteTableRequest(*ec2.CreateRouteTableInput) ec2.CreateRouteTableRequest
 	DeleteRouteTableRequest(*ec2.DeleteRouteTableInput) ec2.DeleteRouteTableRequest
 	DescribeRouteTablesRequest(*ec2.DescribeRouteTablesInput) ec2.DescribeRouteTablesRequest
+	DescribeRouteTransitGatewaysRequest(*ec2.DescribeRouteTablesInput) DescribeRouteTransitGatewaysRequest
 
-	CreateRouteRequest(*ec2.CreateRouteInput) ec2.CreateRouteRequest
+	CreateRouteRequest(*CreateRouteInput) ec2.CreateRouteRequest
+	ReplaceRouteRequest(*ReplaceRouteInput) ec2.ReplaceRouteRequest
 	DeleteRouteRequest(*ec2.DeleteRouteInput) ec2.DeleteRouteRequest
 
 	AssociateRouteTableRequest(*ec2.AssociateRouteTableInput) ec2.AssociateRouteTableRequest
+	ReplaceRouteTableAssociationRequest(*ec2.ReplaceRouteTableAssociationInput) ec2.ReplaceRouteTableAssociationRequest
 	DisassociateRouteTableRequest(*ec2.DisassociateRouteTableInput) ec2.DisassociateRouteTableRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// CreateRouteInput is the input of the CreateRoute operation. Unlike
+// ec2.CreateRouteInput it supports transit gateway and VPC endpoint targets.
+type CreateRouteInput struct {
+	_ struct{} `type:"structure"`
+
+	DestinationCIDRBlock        *string `locationName:"destinationCidrBlock" type:"string"`
+	DestinationIPv6CIDRBlock    *string `locationName:"destinationIpv6CidrBlock" type:"string"`
+	EgressOnlyInternetGatewayID *string `locationName:"egressOnlyInternetGatewayId" type:"string"`
+	GatewayID                   *string `locationName:"gatewayId" type:"string"`
+	NATGatewayID                *string `locationName:"natGatewayId" type:"string"`
+	NetworkInterfaceID          *string `locationName:"networkInterfaceId" type:"string"`
+	RouteTableID                *string `locationName:"routeTableId" type:"string" required:"true"`
+	TransitGatewayID            *string `locationName:"transitGatewayId" type:"string"`
+	VPCEndpointID               *string `locationName:"vpcEndpointId" type:"string"`
+	VPCPeeringConnectionID      *string `locationName:"vpcPeeringConnectionId" type:"string"`
+}
+
+// ReplaceRouteInput is the input of the ReplaceRoute operation. Unlike
+// ec2.ReplaceRouteInput it supports transit gateway and VPC endpoint targets.
+type ReplaceRouteInput CreateRouteInput
+
+// DescribeRouteTransitGatewaysOutput is the output of the DescribeRouteTables
+// operation, limited to the transit gateway targets of routes. Unlike
+// ec2.DescribeRouteTablesOutput it reports transit gateway targets.
+type DescribeRouteTransitGatewaysOutput struct {
+	_ struct{} `type:"structure"`
+
+	RouteTables []RouteTableTransitGateways `locationName:"routeTableSet" locationNameList:"item" type:"list"`
+}
+
+// RouteTableTransitGateways describes the transit gateway targets of the
+// routes of a route table.
+type RouteTableTransitGateways struct {
+	_ struct{} `type:"structure"`
+
+	RouteTableID *string                 `locationName:"routeTableId" type:"string"`
+	Routes       []RouteTransitGatewayID `locationName:"routeSet" locationNameList:"item" type:"list"`
+}
+
+// RouteTransitGatewayID describes the destination of a route and the transit
+// gateway it targets, if any.
+type RouteTransitGatewayID struct {
+	_ struct{} `type:"structure"`
+
+	DestinationCIDRBlock     *string `locationName:"destinationCidrBlock" type:"string"`
+	DestinationIPv6CIDRBlock *string `locationName:"destinationIpv6CidrBlock" type:"string"`
+	TransitGatewayID         *string `locationName:"transitGatewayId" type:"string"`
+}
+
+// DescribeRouteTransitGatewaysRequest is a DescribeRouteTables API request
+// whose output reports the transit gateway targets of routes.
+type DescribeRouteTransitGatewaysRequest struct {
+	*aws.Request
+	Input *ec2.DescribeRouteTablesInput
+}
+
+// Send marshals and sends the DescribeRouteTables API request.
+func (r DescribeRouteTransitGatewaysRequest) Send() (*DescribeRouteTransitGatewaysOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DescribeRouteTransitGatewaysOutput), nil
+}
+
+// routeTableClient is a RouteTableClient that issues route requests with
+// inputs the SDK does not model.
+type routeTableClient struct {
+	*ec2.EC2
+}
+
+// CreateRouteRequest returns a request to create a route.
+func (c *routeTableClient) CreateRouteRequest(input *CreateRouteInput) ec2.CreateRouteRequest {
+	op := &aws.Operation{Name: "CreateRoute", HTTPMethod: "POST", HTTPPath: "/"}
+	return ec2.CreateRouteRequest{Request: c.NewRequest(op, input, &ec2.CreateRouteOutput{})}
+}
+
+// ReplaceRouteRequest returns a request to replace the target of a route.
+func (c *routeTableClient) ReplaceRouteRequest(input *ReplaceRouteInput) ec2.ReplaceRouteRequest {
+	op := &aws.Operation{Name: "ReplaceRoute", HTTPMethod: "POST", HTTPPath: "/"}
+	return ec2.ReplaceRouteRequest{Request: c.NewRequest(op, input, &ec2.ReplaceRouteOutput{})}
+}
+
+// DescribeRouteTransitGatewaysRequest returns a request to describe the
+// transit gateway targets of the routes of route tables.
+func (c *routeTableClient) DescribeRouteTransitGatewaysRequest(input *ec2.DescribeRouteTablesInput) DescribeRouteTransitGatewaysRequest {
+	op := &aws.Operation{Name: "DescribeRouteTables", HTTPMethod: "POST", HTTPPath: "/"}
+	return DescribeRouteTransitGatewaysRequest{Request: c.NewRequest(op, input, &DescribeRouteTransitGatewaysOutput{}), Input: input}
 }
 
 // NewRouteTableClient returns a new client using AWS credentials as JSON encoded data.
 func NewRouteTableClient(cfg *aws.Config) (RouteTableClient, error) {
-	return ec2.New(*cfg), nil
+	return &routeTableClient{ec2.New(*cfg)}, nil
 }
 
 // IsRouteTableNotFoundErr returns true if the error is because the route table doesn't exist
@@ -67,3 +166,199 @@ func IsAssociationIDNotFoundErr(err error) bool {
 	}
 	return false
 }
+
+// IsRouteTableUpToDate returns true if the routes and subnet associations of
+// the supplied route table match the desired parameters. The transit gateway
+// targets of its routes are supplied separately, see TransitGatewayTargets.
+func IsRouteTableUpToDate(p v1alpha2.RouteTableParameters, rt ec2.RouteTable, transitGateways map[string]string) bool {
+	create, replace, remove := DiffRoutes(p.Routes, rt.Routes, transitGateways)
+	if len(create) != 0 || len(replace) != 0 || len(remove) != 0 {
+		return false
+	}
+
+	associate, disassociate := DiffAssociations(p.Associations, rt.Associations)
+	return len(associate) == 0 && len(disassociate) == 0
+}
+
+// DiffRoutes compares the desired routes of a route table to the observed ones,
+// by destination. It returns the routes that must be created, the routes whose
+// target must be replaced, and the observed routes that must be deleted. Only
+// routes created by a CreateRoute call are ever deleted; local and propagated
+// routes are left untouched. The transit gateway targets of the observed routes
+// are supplied by destination, since ec2.Route does not report them.
+func DiffRoutes(desired []v1alpha2.Route, observed []ec2.Route, transitGateways map[string]string) (create, replace []v1alpha2.Route, remove []ec2.Route) {
+	have := make(map[string]ec2.Route, len(observed))
+	for _, r := range observed {
+		have[observedRouteDestination(r)] = r
+	}
+
+	want := make(map[string]bool, len(desired))
+	create, replace = make([]v1alpha2.Route, 0), make([]v1alpha2.Route, 0)
+	for _, r := range desired {
+		d := routeDestination(r)
+		want[d] = true
+		o, ok := have[d]
+		switch {
+		case !ok:
+			create = append(create, r)
+		case o.Origin == ec2.RouteOriginCreateRoute && !isRouteTargetUpToDate(r, o, transitGateways[d]):
+			replace = append(replace, r)
+		}
+	}
+
+	remove = make([]ec2.Route, 0)
+	for _, r := range observed {
+		// Routes to prefix lists are managed by their gateway VPC endpoint.
+		if r.DestinationPrefixListId != nil {
+			continue
+		}
+		if r.Origin == ec2.RouteOriginCreateRoute && !want[observedRouteDestination(r)] {
+			remove = append(remove, r)
+		}
+	}
+
+	return create, replace, remove
+}
+
+// DiffAssociations compares the desired subnet associations of a route table
+// to the observed ones. It returns the associations that must be created, and
+// the observed associations that must be removed. The main association of a
+// route table is never removed.
+func DiffAssociations(desired []v1alpha2.Association, observed []ec2.RouteTableAssociation) (associate []v1alpha2.Association, disassociate []ec2.RouteTableAssociation) {
+	have := make(map[string]bool, len(observed))
+	for _, a := range observed {
+		have[aws.StringValue(a.SubnetId)] = true
+	}
+
+	want := make(map[string]bool, len(desired))
+	associate = make([]v1alpha2.Association, 0)
+	for _, a := range desired {
+		want[a.SubnetID] = true
+		if !have[a.SubnetID] {
+			associate = append(associate, a)
+		}
+	}
+
+	disassociate = make([]ec2.RouteTableAssociation, 0)
+	for _, a := range observed {
+		if aws.BoolValue(a.Main) || want[aws.StringValue(a.SubnetId)] {
+			continue
+		}
+		disassociate = append(disassociate, a)
+	}
+
+	return associate, disassociate
+}
+
+// GenerateCreateRouteInput returns the input to create the supplied route in
+// the supplied route table.
+func GenerateCreateRouteInput(tableID string, r v1alpha2.Route) *CreateRouteInput {
+	return &CreateRouteInput{
+		RouteTableID:                aws.String(tableID),
+		DestinationCIDRBlock:        clients.String(r.DestinationCIDRBlock),
+		DestinationIPv6CIDRBlock:    clients.String(r.DestinationIPv6CIDRBlock),
+		EgressOnlyInternetGatewayID: clients.String(r.EgressOnlyInternetGatewayID),
+		GatewayID:                   clients.String(r.GatewayID),
+		NATGatewayID:                clients.String(r.NATGatewayID),
+		NetworkInterfaceID:          clients.String(r.NetworkInterfaceID),
+		TransitGatewayID:            clients.String(r.TransitGatewayID),
+		VPCEndpointID:               clients.String(r.VPCEndpointID),
+		VPCPeeringConnectionID:      clients.String(r.VPCPeeringConnectionID),
+	}
+}
+
+// GenerateReplaceRouteInput returns the input to replace the target of the
+// supplied route in the supplied route table.
+func GenerateReplaceRouteInput(tableID string, r v1alpha2.Route) *ReplaceRouteInput {
+	return (*ReplaceRouteInput)(GenerateCreateRouteInput(tableID, r))
+}
+
+// GenerateDeleteRouteInput returns the input to delete the supplied observed
+// route from the supplied route table.
+func GenerateDeleteRouteInput(tableID string, r ec2.Route) *ec2.DeleteRouteInput {
+	return &ec2.DeleteRouteInput{
+		RouteTableId:             aws.String(tableID),
+		DestinationCidrBlock:     r.DestinationCidrBlock,
+		DestinationIpv6CidrBlock: r.DestinationIpv6CidrBlock,
+	}
+}
+
+// routeDestination returns the destination of the supplied desired route in
+// the canonical form EC2 reports it in.
+func routeDestination(r v1alpha2.Route) string {
+	if r.DestinationIPv6CIDRBlock != "" {
+		return "ipv6:" + CanonicalCIDR(r.DestinationIPv6CIDRBlock)
+	}
+	return CanonicalCIDR(r.DestinationCIDRBlock)
+}
+
+func observedRouteDestination(r ec2.Route) string {
+	if r.DestinationIpv6CidrBlock != nil {
+		return "ipv6:" + CanonicalCIDR(aws.StringValue(r.DestinationIpv6CidrBlock))
+	}
+	if r.DestinationPrefixListId != nil {
+		return "pl:" + aws.StringValue(r.DestinationPrefixListId)
+	}
+	return CanonicalCIDR(aws.StringValue(r.DestinationCidrBlock))
+}
+
+// isRouteTargetUpToDate compares the target of a desired route to that of an
+// observed route, whose transit gateway target is supplied separately. Target
+// IDs are unique across target types, so only the IDs are compared.
+func isRouteTargetUpToDate(r v1alpha2.Route, o ec2.Route, transitGatewayID string) bool {
+	observed := firstNonEmpty(
+		aws.StringValue(o.GatewayId),
+		aws.StringValue(o.EgressOnlyInternetGatewayId),
+		aws.StringValue(o.NatGatewayId),
+		aws.StringValue(o.NetworkInterfaceId),
+		transitGatewayID,
+		aws.StringValue(o.VpcPeeringConnectionId),
+	)
+
+	// EC2 reports Gateway Load Balancer endpoint targets as gateways.
+	return observed == firstNonEmpty(
+		r.GatewayID,
+		r.EgressOnlyInternetGatewayID,
+		r.NATGatewayID,
+		r.NetworkInterfaceID,
+		r.TransitGatewayID,
+		r.VPCEndpointID,
+		r.VPCPeeringConnectionID,
+	)
+}
+
+// HasTransitGatewayRoute returns true if any of the supplied routes targets a
+// transit gateway.
+func HasTransitGatewayRoute(routes []v1alpha2.Route) bool {
+	for _, r := range routes {
+		if r.TransitGatewayID != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// TransitGatewayTargets returns the transit gateway targets of the routes of
+// the supplied route tables by destination.
+func TransitGatewayTargets(o *DescribeRouteTransitGatewaysOutput) map[string]string {
+	targets := make(map[string]string)
+	for _, rt := range o.RouteTables {
+		for _, r := range rt.Routes {
+			if r.TransitGatewayID == nil {
+				continue
+			}
+			d := observedRouteDestination(ec2.Route{DestinationCidrBlock: r.DestinationCIDRBlock, DestinationIpv6CidrBlock: r.DestinationIPv6CIDRBlock})
+			targets[d] = aws.StringValue(r.TransitGatewayID)
+		}
+	}
+	return targets
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
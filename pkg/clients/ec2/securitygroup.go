@@ -1,14 +1,23 @@
 package ec2
 
 import (
+	"fmt"
+	"net"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
 )
 
 const (
 	// InvalidGroupNotFound is the code that is returned by ec2 when the given VPCID is not valid
 	InvalidGroupNotFound = "InvalidGroup.NotFound"
+
+	// SecurityGroupIDPrefix is the prefix of the IDs EC2 assigns to security groups
+	SecurityGroupIDPrefix = "sg-"
 )
 
 // SecurityGroupClient is the external client used for SecurityGroup Custom Resource
@@ -18,6 +27,9 @@ type SecurityGroupClient interface {
 	DescribeSecurityGroupsRequest(input *ec2.DescribeSecurityGroupsInput) ec2.DescribeSecurityGroupsRequest
 	AuthorizeSecurityGroupIngressRequest(input *ec2.AuthorizeSecurityGroupIngressInput) ec2.AuthorizeSecurityGroupIngressRequest
 	AuthorizeSecurityGroupEgressRequest(input *ec2.AuthorizeSecurityGroupEgressInput) ec2.AuthorizeSecurityGroupEgressRequest
+	RevokeSecurityGroupIngressRequest(input *ec2.RevokeSecurityGroupIngressInput) ec2.RevokeSecurityGroupIngressRequest
+	RevokeSecurityGroupEgressRequest(input *ec2.RevokeSecurityGroupEgressInput) ec2.RevokeSecurityGroupEgressRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
 }
 
 // NewSecurityGroupClient returns a new client using AWS credentials as JSON encoded data.
@@ -34,3 +46,180 @@ func IsSecurityGroupNotFoundErr(err error) bool {
 	}
 	return false
 }
+
+// DefaultEgressPermission is the egress rule EC2 creates for every new
+// security group. It allows all outbound IPv4 traffic.
+var DefaultEgressPermission = ec2.IpPermission{
+	IpProtocol: aws.String("-1"),
+	IpRanges:   []ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+}
+
+// IsSecurityGroupUpToDate returns true if the rules of the supplied security
+// group match the desired parameters. Egress rules are only considered when
+// they are managed, see DesiredEgressPermissions.
+func IsSecurityGroupUpToDate(p v1alpha2.SecurityGroupParameters, sg ec2.SecurityGroup, egressManaged bool) bool {
+	add, remove := DiffPermissions(v1alpha2.BuildEC2Permissions(p.IngressPermissions, aws.StringValue(sg.GroupId)), sg.IpPermissions)
+	if len(add) != 0 || len(remove) != 0 {
+		return false
+	}
+
+	egress, ok := DesiredEgressPermissions(p, aws.StringValue(sg.GroupId), egressManaged)
+	if !ok {
+		return true
+	}
+
+	add, remove = DiffPermissions(egress, sg.IpPermissionsEgress)
+	return len(add) == 0 && len(remove) == 0
+}
+
+// DesiredEgressPermissions returns the desired egress rules of a security
+// group, and whether they are managed at all. Egress rules are managed once
+// the parameters have specified at least one, so that the default egress rule
+// EC2 creates is left untouched otherwise. A security group whose egress rules
+// are managed but no longer specified is restored to the default egress rule.
+func DesiredEgressPermissions(p v1alpha2.SecurityGroupParameters, groupID string, egressManaged bool) ([]ec2.IpPermission, bool) {
+	switch {
+	case len(p.EgressPermissions) != 0:
+		return v1alpha2.BuildEC2Permissions(p.EgressPermissions, groupID), true
+	case egressManaged:
+		return []ec2.IpPermission{DefaultEgressPermission}, true
+	default:
+		return nil, false
+	}
+}
+
+// DiffPermissions compares the desired and observed permissions of a security
+// group rule set. It returns the permissions that must be authorized and the
+// observed permissions that must be revoked in order for the observed rules to
+// match the desired ones. Permissions are compared one source at a time, after
+// normalizing their protocol and port range. Descriptions are not compared.
+func DiffPermissions(desired, observed []ec2.IpPermission) (authorize, revoke []ec2.IpPermission) {
+	want := map[string]ec2.IpPermission{}
+	for _, p := range flattenPermissions(desired) {
+		want[permissionKey(p)] = p
+	}
+
+	have := map[string]ec2.IpPermission{}
+	for _, p := range flattenPermissions(observed) {
+		have[permissionKey(p)] = p
+	}
+
+	authorize = make([]ec2.IpPermission, 0)
+	for _, p := range flattenPermissions(desired) {
+		k := permissionKey(p)
+		if _, ok := have[k]; !ok {
+			authorize = append(authorize, p)
+			have[k] = p
+		}
+	}
+
+	revoke = make([]ec2.IpPermission, 0)
+	for _, p := range flattenPermissions(observed) {
+		k := permissionKey(p)
+		if _, ok := want[k]; !ok {
+			revoke = append(revoke, p)
+			want[k] = p
+		}
+	}
+
+	return authorize, revoke
+}
+
+// flattenPermissions splits the supplied permissions into permissions that
+// each have exactly one source, i.e. an IPv4 range, an IPv6 range, a prefix
+// list or a security group.
+func flattenPermissions(perms []ec2.IpPermission) []ec2.IpPermission {
+	flat := make([]ec2.IpPermission, 0)
+	for _, p := range perms {
+		base := ec2.IpPermission{FromPort: p.FromPort, ToPort: p.ToPort, IpProtocol: p.IpProtocol}
+		for _, r := range p.IpRanges {
+			f := base
+			f.IpRanges = []ec2.IpRange{r}
+			flat = append(flat, f)
+		}
+		for _, r := range p.Ipv6Ranges {
+			f := base
+			f.Ipv6Ranges = []ec2.Ipv6Range{r}
+			flat = append(flat, f)
+		}
+		for _, l := range p.PrefixListIds {
+			f := base
+			f.PrefixListIds = []ec2.PrefixListId{l}
+			flat = append(flat, f)
+		}
+		for _, g := range p.UserIdGroupPairs {
+			f := base
+			f.UserIdGroupPairs = []ec2.UserIdGroupPair{g}
+			flat = append(flat, f)
+		}
+	}
+	return flat
+}
+
+// permissionKey returns a key that uniquely identifies a flattened permission.
+func permissionKey(p ec2.IpPermission) string {
+	protocol := normalizeProtocol(aws.StringValue(p.IpProtocol))
+	from, to := int64(-1), int64(-1)
+	if protocolHasPorts(protocol) {
+		from, to = aws.Int64Value(p.FromPort), aws.Int64Value(p.ToPort)
+		if p.FromPort == nil {
+			from = -1
+		}
+		if p.ToPort == nil {
+			to = -1
+		}
+	}
+
+	source := ""
+	switch {
+	case len(p.IpRanges) > 0:
+		source = "ipv4:" + CanonicalCIDR(aws.StringValue(p.IpRanges[0].CidrIp))
+	case len(p.Ipv6Ranges) > 0:
+		source = "ipv6:" + CanonicalCIDR(aws.StringValue(p.Ipv6Ranges[0].CidrIpv6))
+	case len(p.PrefixListIds) > 0:
+		source = "pl:" + aws.StringValue(p.PrefixListIds[0].PrefixListId)
+	case len(p.UserIdGroupPairs) > 0:
+		source = "sg:" + aws.StringValue(p.UserIdGroupPairs[0].GroupId)
+	}
+
+	return fmt.Sprintf("%s/%d/%d/%s", protocol, from, to, source)
+}
+
+// CanonicalCIDR returns the canonical form of the supplied IPv4 or IPv6 CIDR
+// block, i.e. its network address in lower case and in its shortest form
+// followed by its prefix length, so that equivalent blocks can be compared.
+// Blocks that cannot be parsed are only converted to lower case.
+func CanonicalCIDR(cidr string) string {
+	_, n, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return strings.ToLower(cidr)
+	}
+	return n.String()
+}
+
+// normalizeProtocol converts well known protocol numbers to the names EC2
+// reports them by.
+func normalizeProtocol(p string) string {
+	p = strings.ToLower(p)
+	switch p {
+	case "6":
+		return "tcp"
+	case "17":
+		return "udp"
+	case "1":
+		return "icmp"
+	case "58":
+		return "icmpv6"
+	}
+	return p
+}
+
+// protocolHasPorts returns true if EC2 takes the port range of a rule with the
+// supplied normalized protocol into account.
+func protocolHasPorts(p string) bool {
+	switch p {
+	case "tcp", "udp", "icmp", "icmpv6":
+		return true
+	}
+	return false
+}
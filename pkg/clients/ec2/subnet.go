@@ -4,11 +4,17 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	clients "github.com/crossplaneio/stack-aws/pkg/clients"
 )
 
 const (
 	// SubnetIDNotFound is the code that is returned by ec2 when the given SubnetID is not valid
 	SubnetIDNotFound = "InvalidSubnetID.NotFound"
+
+	// SubnetIDPrefix is the prefix of the IDs EC2 assigns to subnets
+	SubnetIDPrefix = "subnet-"
 )
 
 // SubnetClient is the external client used for Subnet Custom Resource
@@ -16,6 +22,11 @@ type SubnetClient interface {
 	CreateSubnetRequest(input *ec2.CreateSubnetInput) ec2.CreateSubnetRequest
 	DescribeSubnetsRequest(input *ec2.DescribeSubnetsInput) ec2.DescribeSubnetsRequest
 	DeleteSubnetRequest(input *ec2.DeleteSubnetInput) ec2.DeleteSubnetRequest
+	ModifySubnetAttributeRequest(input *ec2.ModifySubnetAttributeInput) ec2.ModifySubnetAttributeRequest
+	AssociateSubnetCidrBlockRequest(input *ec2.AssociateSubnetCidrBlockInput) ec2.AssociateSubnetCidrBlockRequest
+	DisassociateSubnetCidrBlockRequest(input *ec2.DisassociateSubnetCidrBlockInput) ec2.DisassociateSubnetCidrBlockRequest
+	DescribeAvailabilityZonesRequest(input *ec2.DescribeAvailabilityZonesInput) ec2.DescribeAvailabilityZonesRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
 }
 
 // NewSubnetClient returns a new client using AWS credentials as JSON encoded data.
@@ -33,3 +44,66 @@ func IsSubnetNotFoundErr(err error) bool {
 
 	return false
 }
+
+// IsSubnetUpToDate returns true if the supplied Subnet matches the desired
+// parameters. Tags are not considered; see AreTagsUpToDate.
+func IsSubnetUpToDate(p v1alpha2.SubnetParameters, subnet ec2.Subnet) bool {
+	return len(GenerateModifySubnetAttributeInputs(p, subnet)) == 0 && !SubnetIPv6CIDRBlockNeedsUpdate(p, subnet)
+}
+
+// LateInitializeSubnet fills the parameters that were left unset with the
+// attributes and the IPv6 CIDR block of the observed Subnet, so that adopting
+// a Subnet doesn't change them.
+func LateInitializeSubnet(p *v1alpha2.SubnetParameters, subnet ec2.Subnet) {
+	p.MapPublicIPOnLaunch = clients.LateInitializeBoolPtr(p.MapPublicIPOnLaunch, subnet.MapPublicIpOnLaunch)
+	p.AssignIPv6AddressOnCreation = clients.LateInitializeBoolPtr(p.AssignIPv6AddressOnCreation, subnet.AssignIpv6AddressOnCreation)
+	if a, ok := SubnetIPv6CIDRBlockAssociation(subnet); ok {
+		p.IPv6CIDRBlock = clients.LateInitializeStringPtr(p.IPv6CIDRBlock, a.Ipv6CidrBlock)
+	}
+}
+
+// GenerateModifySubnetAttributeInputs returns the inputs that modify the
+// attributes of the supplied Subnet that don't match the desired parameters.
+// Attributes that are not set are left untouched. EC2 only modifies one
+// attribute per request.
+func GenerateModifySubnetAttributeInputs(p v1alpha2.SubnetParameters, subnet ec2.Subnet) []*ec2.ModifySubnetAttributeInput {
+	var inputs []*ec2.ModifySubnetAttributeInput
+	if p.MapPublicIPOnLaunch != nil && *p.MapPublicIPOnLaunch != aws.BoolValue(subnet.MapPublicIpOnLaunch) {
+		inputs = append(inputs, &ec2.ModifySubnetAttributeInput{
+			SubnetId:            subnet.SubnetId,
+			MapPublicIpOnLaunch: &ec2.AttributeBooleanValue{Value: p.MapPublicIPOnLaunch},
+		})
+	}
+	if p.AssignIPv6AddressOnCreation != nil && *p.AssignIPv6AddressOnCreation != aws.BoolValue(subnet.AssignIpv6AddressOnCreation) {
+		inputs = append(inputs, &ec2.ModifySubnetAttributeInput{
+			SubnetId:                    subnet.SubnetId,
+			AssignIpv6AddressOnCreation: &ec2.AttributeBooleanValue{Value: p.AssignIPv6AddressOnCreation},
+		})
+	}
+	return inputs
+}
+
+// SubnetIPv6CIDRBlockAssociation returns the active IPv6 CIDR block
+// association of the supplied Subnet, if any.
+func SubnetIPv6CIDRBlockAssociation(subnet ec2.Subnet) (ec2.SubnetIpv6CidrBlockAssociation, bool) {
+	for _, a := range subnet.Ipv6CidrBlockAssociationSet {
+		if a.Ipv6CidrBlockState == nil {
+			continue
+		}
+		if a.Ipv6CidrBlockState.State == ec2.SubnetCidrBlockStateCodeAssociated || a.Ipv6CidrBlockState.State == ec2.SubnetCidrBlockStateCodeAssociating {
+			return a, true
+		}
+	}
+	return ec2.SubnetIpv6CidrBlockAssociation{}, false
+}
+
+// SubnetIPv6CIDRBlockNeedsUpdate returns true if the IPv6 CIDR block that is
+// associated with the supplied Subnet is not the desired one. Blocks are
+// compared in their canonical form, since EC2 reports them shortened.
+func SubnetIPv6CIDRBlockNeedsUpdate(p v1alpha2.SubnetParameters, subnet ec2.Subnet) bool {
+	if p.IPv6CIDRBlock == nil {
+		return false
+	}
+	a, _ := SubnetIPv6CIDRBlockAssociation(subnet)
+	return CanonicalCIDR(*p.IPv6CIDRBlock) != CanonicalCIDR(aws.StringValue(a.Ipv6CidrBlock))
+}
@@ -0,0 +1,88 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+)
+
+// Keys of the tags that record which Kubernetes object owns an EC2 resource.
+const (
+	TagKeyUID       = "crossplane-uid"
+	TagKeyName      = "crossplane-name"
+	TagKeyNamespace = "crossplane-namespace"
+)
+
+// GenerateTags returns the tags that should be applied to the EC2 resource
+// represented by the supplied object: the supplied tags, followed by tags that
+// record the UID, name and namespace of the object. Supplied tags that use one
+// of the reserved ownership keys are ignored.
+func GenerateTags(o metav1.Object, tags []v1alpha2.Tag) []v1alpha2.Tag {
+	owner := []v1alpha2.Tag{
+		{Key: TagKeyUID, Value: string(o.GetUID())},
+		{Key: TagKeyName, Value: o.GetName()},
+		{Key: TagKeyNamespace, Value: o.GetNamespace()},
+	}
+
+	res := make([]v1alpha2.Tag, 0, len(tags)+len(owner))
+	for _, t := range tags {
+		if isOwnershipTagKey(t.Key) {
+			continue
+		}
+		res = append(res, t)
+	}
+	for _, t := range owner {
+		if t.Value == "" {
+			continue
+		}
+		res = append(res, t)
+	}
+	return res
+}
+
+// GenerateTagSpecifications returns tag specifications that apply the supplied
+// tags to a resource of the supplied type as it is created, or nil if there
+// are no tags to apply.
+func GenerateTagSpecifications(resourceType string, tags []v1alpha2.Tag) []ec2.TagSpecification {
+	if len(tags) == 0 {
+		return nil
+	}
+	return []ec2.TagSpecification{{ResourceType: ec2.ResourceType(resourceType), Tags: v1alpha2.BuildEC2Tags(tags)}}
+}
+
+// AreTagsUpToDate returns true if all tags that should be applied to the EC2
+// resource represented by the supplied object are observed.
+func AreTagsUpToDate(o metav1.Object, tags []v1alpha2.Tag, observed []ec2.Tag) bool {
+	return len(TagsToCreate(GenerateTags(o, tags), observed)) == 0
+}
+
+// OwnerTagFilters returns filters that match the EC2 resources that are tagged
+// with the UID of the supplied object, and any of the supplied filters.
+func OwnerTagFilters(o metav1.Object, filters ...ec2.Filter) []ec2.Filter {
+	owner := ec2.Filter{Name: aws.String("tag:" + TagKeyUID), Values: []string{string(o.GetUID())}}
+	return append([]ec2.Filter{owner}, filters...)
+}
+
+// TagsToCreate returns the desired tags that are missing from, or have a
+// different value than, the observed tags. Observed tags that are not desired
+// are ignored.
+func TagsToCreate(desired []v1alpha2.Tag, observed []ec2.Tag) []ec2.Tag {
+	current := make(map[string]string, len(observed))
+	for _, t := range observed {
+		current[aws.StringValue(t.Key)] = aws.StringValue(t.Value)
+	}
+
+	create := make([]v1alpha2.Tag, 0)
+	for _, t := range desired {
+		if v, ok := current[t.Key]; !ok || v != t.Value {
+			create = append(create, t)
+		}
+	}
+	return v1alpha2.BuildEC2Tags(create)
+}
+
+func isOwnershipTagKey(k string) bool {
+	return k == TagKeyUID || k == TagKeyName || k == TagKeyNamespace
+}
@@ -0,0 +1,264 @@
+package ec2
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+)
+
+const (
+	// TransitGatewayIDNotFound is the code that is returned by ec2 when the given TransitGatewayID is not valid
+	TransitGatewayIDNotFound = "InvalidTransitGatewayID.NotFound"
+
+	// TransitGatewayStatePending is the state of a transit gateway that is being created
+	TransitGatewayStatePending = "pending"
+	// TransitGatewayStateAvailable is the state of a transit gateway that is ready for use
+	TransitGatewayStateAvailable = "available"
+	// TransitGatewayStateModifying is the state of a transit gateway that is being modified
+	TransitGatewayStateModifying = "modifying"
+	// TransitGatewayStateDeleting is the state of a transit gateway that is being deleted
+	TransitGatewayStateDeleting = "deleting"
+	// TransitGatewayStateDeleted is the state of a transit gateway that has been deleted
+	TransitGatewayStateDeleted = "deleted"
+
+	// TransitGatewayResourceType is the type of transit gateways in tag
+	// specifications
+	TransitGatewayResourceType = "transit-gateway"
+
+	// transit gateway options are enabled and disabled with these values
+	optionEnable  = "enable"
+	optionDisable = "disable"
+)
+
+// The SDK version in use does not model the transit gateway API, so the
+// operations, their inputs and their outputs are declared here, following
+// the shapes of the EC2 API reference.
+
+// TransitGatewayRequestOptions describes the options of a new transit gateway.
+type TransitGatewayRequestOptions struct {
+	_ struct{} `type:"structure"`
+
+	AmazonSideASN                *int64  `locationName:"AmazonSideAsn" type:"long"`
+	AutoAcceptSharedAttachments  *string `type:"string"`
+	DefaultRouteTableAssociation *string `type:"string"`
+	DefaultRouteTablePropagation *string `type:"string"`
+	DNSSupport                   *string `locationName:"DnsSupport" type:"string"`
+	VPNECMPSupport               *string `locationName:"VpnEcmpSupport" type:"string"`
+}
+
+// TransitGatewayOptions describes the options of a transit gateway.
+type TransitGatewayOptions struct {
+	_ struct{} `type:"structure"`
+
+	AmazonSideASN                  *int64  `locationName:"amazonSideAsn" type:"long"`
+	AssociationDefaultRouteTableID *string `locationName:"associationDefaultRouteTableId" type:"string"`
+	AutoAcceptSharedAttachments    *string `locationName:"autoAcceptSharedAttachments" type:"string"`
+	DefaultRouteTableAssociation   *string `locationName:"defaultRouteTableAssociation" type:"string"`
+	DefaultRouteTablePropagation   *string `locationName:"defaultRouteTablePropagation" type:"string"`
+	DNSSupport                     *string `locationName:"dnsSupport" type:"string"`
+	PropagationDefaultRouteTableID *string `locationName:"propagationDefaultRouteTableId" type:"string"`
+	VPNECMPSupport                 *string `locationName:"vpnEcmpSupport" type:"string"`
+}
+
+// TransitGateway describes a transit gateway.
+type TransitGateway struct {
+	_ struct{} `type:"structure"`
+
+	CreationTime      *time.Time             `locationName:"creationTime" type:"timestamp" timestampFormat:"iso8601"`
+	Description       *string                `locationName:"description" type:"string"`
+	Options           *TransitGatewayOptions `locationName:"options" type:"structure"`
+	OwnerID           *string                `locationName:"ownerId" type:"string"`
+	State             *string                `locationName:"state" type:"string"`
+	Tags              []ec2.Tag              `locationName:"tagSet" locationNameList:"item" type:"list"`
+	TransitGatewayARN *string                `locationName:"transitGatewayArn" type:"string"`
+	TransitGatewayID  *string                `locationName:"transitGatewayId" type:"string"`
+}
+
+// CreateTransitGatewayInput is the input of the CreateTransitGateway operation.
+type CreateTransitGatewayInput struct {
+	_ struct{} `type:"structure"`
+
+	Description       *string                       `type:"string"`
+	Options           *TransitGatewayRequestOptions `type:"structure"`
+	TagSpecifications []ec2.TagSpecification        `locationName:"TagSpecification" locationNameList:"item" type:"list"`
+}
+
+// CreateTransitGatewayOutput is the output of the CreateTransitGateway operation.
+type CreateTransitGatewayOutput struct {
+	_ struct{} `type:"structure"`
+
+	TransitGateway *TransitGateway `locationName:"transitGateway" type:"structure"`
+}
+
+// CreateTransitGatewayRequest is a API request type for the CreateTransitGateway API operation.
+type CreateTransitGatewayRequest struct {
+	*aws.Request
+	Input *CreateTransitGatewayInput
+}
+
+// Send marshals and sends the CreateTransitGateway API request.
+func (r CreateTransitGatewayRequest) Send() (*CreateTransitGatewayOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*CreateTransitGatewayOutput), nil
+}
+
+// DescribeTransitGatewaysInput is the input of the DescribeTransitGateways operation.
+type DescribeTransitGatewaysInput struct {
+	_ struct{} `type:"structure"`
+
+	Filters           []ec2.Filter `locationName:"Filter" locationNameList:"Filter" type:"list"`
+	TransitGatewayIDs []string     `locationName:"TransitGatewayIds" locationNameList:"item" type:"list"`
+}
+
+// DescribeTransitGatewaysOutput is the output of the DescribeTransitGateways operation.
+type DescribeTransitGatewaysOutput struct {
+	_ struct{} `type:"structure"`
+
+	TransitGateways []TransitGateway `locationName:"transitGatewaySet" locationNameList:"item" type:"list"`
+}
+
+// DescribeTransitGatewaysRequest is a API request type for the DescribeTransitGateways API operation.
+type DescribeTransitGatewaysRequest struct {
+	*aws.Request
+	Input *DescribeTransitGatewaysInput
+}
+
+// Send marshals and sends the DescribeTransitGateways API request.
+func (r DescribeTransitGatewaysRequest) Send() (*DescribeTransitGatewaysOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DescribeTransitGatewaysOutput), nil
+}
+
+// DeleteTransitGatewayInput is the input of the DeleteTransitGateway operation.
+type DeleteTransitGatewayInput struct {
+	_ struct{} `type:"structure"`
+
+	TransitGatewayID *string `locationName:"TransitGatewayId" type:"string" required:"true"`
+}
+
+// DeleteTransitGatewayOutput is the output of the DeleteTransitGateway operation.
+type DeleteTransitGatewayOutput struct {
+	_ struct{} `type:"structure"`
+
+	TransitGateway *TransitGateway `locationName:"transitGateway" type:"structure"`
+}
+
+// DeleteTransitGatewayRequest is a API request type for the DeleteTransitGateway API operation.
+type DeleteTransitGatewayRequest struct {
+	*aws.Request
+	Input *DeleteTransitGatewayInput
+}
+
+// Send marshals and sends the DeleteTransitGateway API request.
+func (r DeleteTransitGatewayRequest) Send() (*DeleteTransitGatewayOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DeleteTransitGatewayOutput), nil
+}
+
+// TransitGatewayClient is the external client used for TransitGateway Custom Resource
+type TransitGatewayClient interface {
+	CreateTransitGatewayRequest(input *CreateTransitGatewayInput) CreateTransitGatewayRequest
+	DescribeTransitGatewaysRequest(input *DescribeTransitGatewaysInput) DescribeTransitGatewaysRequest
+	DeleteTransitGatewayRequest(input *DeleteTransitGatewayInput) DeleteTransitGatewayRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// transitGatewayClient issues the transit gateway requests the SDK does not
+// model.
+type transitGatewayClient struct {
+	*ec2.EC2
+}
+
+// NewTransitGatewayClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayClient(cfg *aws.Config) (TransitGatewayClient, error) {
+	return &transitGatewayClient{ec2.New(*cfg)}, nil
+}
+
+// CreateTransitGatewayRequest returns a request to create a transit gateway.
+func (c *transitGatewayClient) CreateTransitGatewayRequest(input *CreateTransitGatewayInput) CreateTransitGatewayRequest {
+	op := &aws.Operation{Name: "CreateTransitGateway", HTTPMethod: "POST", HTTPPath: "/"}
+	return CreateTransitGatewayRequest{Request: c.NewRequest(op, input, &CreateTransitGatewayOutput{}), Input: input}
+}
+
+// DescribeTransitGatewaysRequest returns a request to describe transit gateways.
+func (c *transitGatewayClient) DescribeTransitGatewaysRequest(input *DescribeTransitGatewaysInput) DescribeTransitGatewaysRequest {
+	op := &aws.Operation{Name: "DescribeTransitGateways", HTTPMethod: "POST", HTTPPath: "/"}
+	return DescribeTransitGatewaysRequest{Request: c.NewRequest(op, input, &DescribeTransitGatewaysOutput{}), Input: input}
+}
+
+// DeleteTransitGatewayRequest returns a request to delete a transit gateway.
+func (c *transitGatewayClient) DeleteTransitGatewayRequest(input *DeleteTransitGatewayInput) DeleteTransitGatewayRequest {
+	op := &aws.Operation{Name: "DeleteTransitGateway", HTTPMethod: "POST", HTTPPath: "/"}
+	return DeleteTransitGatewayRequest{Request: c.NewRequest(op, input, &DeleteTransitGatewayOutput{}), Input: input}
+}
+
+// IsTransitGatewayNotFoundErr returns true if the error is because the item doesn't exist
+func IsTransitGatewayNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateTransitGatewayInput returns the input to create a transit
+// gateway with the supplied parameters, tagged with the supplied tags.
+func GenerateCreateTransitGatewayInput(p v1alpha2.TransitGatewayParameters, tags []v1alpha2.Tag) *CreateTransitGatewayInput {
+	input := &CreateTransitGatewayInput{
+		TagSpecifications: GenerateTagSpecifications(TransitGatewayResourceType, tags),
+		Options: &TransitGatewayRequestOptions{
+			AmazonSideASN:                p.AmazonSideASN,
+			AutoAcceptSharedAttachments:  option(p.AutoAcceptSharedAttachments),
+			DefaultRouteTableAssociation: option(p.DefaultRouteTableAssociation),
+			DefaultRouteTablePropagation: option(p.DefaultRouteTablePropagation),
+			DNSSupport:                   option(p.DNSSupport),
+			VPNECMPSupport:               option(p.VPNECMPSupport),
+		},
+	}
+	if p.Description != "" {
+		input.Description = aws.String(p.Description)
+	}
+	return input
+}
+
+// GenerateTransitGatewayObservation returns the observed state of the
+// supplied transit gateway.
+func GenerateTransitGatewayObservation(tg TransitGateway) v1alpha2.TransitGatewayExternalStatus {
+	o := v1alpha2.TransitGatewayExternalStatus{
+		TransitGatewayID:    aws.StringValue(tg.TransitGatewayID),
+		TransitGatewayARN:   aws.StringValue(tg.TransitGatewayARN),
+		TransitGatewayState: aws.StringValue(tg.State),
+		OwnerID:             aws.StringValue(tg.OwnerID),
+		Tags:                v1alpha2.BuildFromEC2Tags(tg.Tags),
+	}
+	if tg.Options != nil {
+		o.AssociationDefaultRouteTableID = aws.StringValue(tg.Options.AssociationDefaultRouteTableID)
+		o.PropagationDefaultRouteTableID = aws.StringValue(tg.Options.PropagationDefaultRouteTableID)
+	}
+	return o
+}
+
+// option converts an optional boolean into the enable or disable value
+// expected by the transit gateway API.
+func option(b *bool) *string {
+	switch {
+	case b == nil:
+		return nil
+	case *b:
+		return aws.String(optionEnable)
+	default:
+		return aws.String(optionDisable)
+	}
+}
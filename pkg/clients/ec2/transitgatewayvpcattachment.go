@@ -0,0 +1,320 @@
+package ec2
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+)
+
+const (
+	// TransitGatewayAttachmentIDNotFound is the code that is returned by ec2 when the given TransitGatewayAttachmentID is not valid
+	TransitGatewayAttachmentIDNotFound = "InvalidTransitGatewayAttachmentID.NotFound"
+
+	// TransitGatewayAttachmentResourceType is the type of transit gateway
+	// attachments in tag specifications
+	TransitGatewayAttachmentResourceType = "transit-gateway-attachment"
+
+	// TransitGatewayAttachmentStateInitiating is the state of an attachment whose creation has been requested
+	TransitGatewayAttachmentStateInitiating = "initiating"
+	// TransitGatewayAttachmentStatePendingAcceptance is the state of an attachment that waits for the owner of the transit gateway to accept it
+	TransitGatewayAttachmentStatePendingAcceptance = "pendingAcceptance"
+	// TransitGatewayAttachmentStateRollingBack is the state of an attachment whose creation is being rolled back
+	TransitGatewayAttachmentStateRollingBack = "rollingBack"
+	// TransitGatewayAttachmentStatePending is the state of an attachment that is being created
+	TransitGatewayAttachmentStatePending = "pending"
+	// TransitGatewayAttachmentStateAvailable is the state of an attachment that is ready for use
+	TransitGatewayAttachmentStateAvailable = "available"
+	// TransitGatewayAttachmentStateModifying is the state of an attachment that is being modified
+	TransitGatewayAttachmentStateModifying = "modifying"
+	// TransitGatewayAttachmentStateDeleting is the state of an attachment that is being deleted
+	TransitGatewayAttachmentStateDeleting = "deleting"
+	// TransitGatewayAttachmentStateDeleted is the state of an attachment that has been deleted
+	TransitGatewayAttachmentStateDeleted = "deleted"
+	// TransitGatewayAttachmentStateFailed is the state of an attachment that could not be created
+	TransitGatewayAttachmentStateFailed = "failed"
+	// TransitGatewayAttachmentStateFailing is the state of an attachment that is failing to be created
+	TransitGatewayAttachmentStateFailing = "failing"
+	// TransitGatewayAttachmentStateRejected is the state of an attachment that has been rejected by the owner of the transit gateway
+	TransitGatewayAttachmentStateRejected = "rejected"
+	// TransitGatewayAttachmentStateRejecting is the state of an attachment that is being rejected
+	TransitGatewayAttachmentStateRejecting = "rejecting"
+)
+
+// TransitGatewayVPCAttachmentOptions describes the options of a transit
+// gateway VPC attachment.
+type TransitGatewayVPCAttachmentOptions struct {
+	_ struct{} `type:"structure"`
+
+	DNSSupport  *string `locationName:"dnsSupport" type:"string"`
+	IPv6Support *string `locationName:"ipv6Support" type:"string"`
+}
+
+// TransitGatewayVPCAttachmentRequestOptions describes the options of a new or
+// modified transit gateway VPC attachment.
+type TransitGatewayVPCAttachmentRequestOptions struct {
+	_ struct{} `type:"structure"`
+
+	DNSSupport  *string `locationName:"DnsSupport" type:"string"`
+	IPv6Support *string `locationName:"Ipv6Support" type:"string"`
+}
+
+// TransitGatewayVPCAttachment describes a VPC attachment of a transit gateway.
+type TransitGatewayVPCAttachment struct {
+	_ struct{} `type:"structure"`
+
+	CreationTime               *time.Time                          `locationName:"creationTime" type:"timestamp" timestampFormat:"iso8601"`
+	Options                    *TransitGatewayVPCAttachmentOptions `locationName:"options" type:"structure"`
+	State                      *string                             `locationName:"state" type:"string"`
+	SubnetIDs                  []string                            `locationName:"subnetIds" locationNameList:"item" type:"list"`
+	Tags                       []ec2.Tag                           `locationName:"tagSet" locationNameList:"item" type:"list"`
+	TransitGatewayAttachmentID *string                             `locationName:"transitGatewayAttachmentId" type:"string"`
+	TransitGatewayID           *string                             `locationName:"transitGatewayId" type:"string"`
+	VPCID                      *string                             `locationName:"vpcId" type:"string"`
+	VPCOwnerID                 *string                             `locationName:"vpcOwnerId" type:"string"`
+}
+
+// CreateTransitGatewayVPCAttachmentInput is the input of the
+// CreateTransitGatewayVpcAttachment operation.
+type CreateTransitGatewayVPCAttachmentInput struct {
+	_ struct{} `type:"structure"`
+
+	Options           *TransitGatewayVPCAttachmentRequestOptions `type:"structure"`
+	SubnetIDs         []string                                   `locationName:"SubnetIds" locationNameList:"item" type:"list" required:"true"`
+	TagSpecifications []ec2.TagSpecification                     `locationName:"TagSpecifications" locationNameList:"item" type:"list"`
+	TransitGatewayID  *string                                    `locationName:"TransitGatewayId" type:"string" required:"true"`
+	VPCID             *string                                    `locationName:"VpcId" type:"string" required:"true"`
+}
+
+// CreateTransitGatewayVPCAttachmentOutput is the output of the
+// CreateTransitGatewayVpcAttachment operation.
+type CreateTransitGatewayVPCAttachmentOutput struct {
+	_ struct{} `type:"structure"`
+
+	TransitGatewayVPCAttachment *TransitGatewayVPCAttachment `locationName:"transitGatewayVpcAttachment" type:"structure"`
+}
+
+// CreateTransitGatewayVPCAttachmentRequest is a API request type for the CreateTransitGatewayVpcAttachment API operation.
+type CreateTransitGatewayVPCAttachmentRequest struct {
+	*aws.Request
+	Input *CreateTransitGatewayVPCAttachmentInput
+}
+
+// Send marshals and sends the CreateTransitGatewayVpcAttachment API request.
+func (r CreateTransitGatewayVPCAttachmentRequest) Send() (*CreateTransitGatewayVPCAttachmentOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*CreateTransitGatewayVPCAttachmentOutput), nil
+}
+
+// DescribeTransitGatewayVPCAttachmentsInput is the input of the
+// DescribeTransitGatewayVpcAttachments operation.
+type DescribeTransitGatewayVPCAttachmentsInput struct {
+	_ struct{} `type:"structure"`
+
+	Filters                     []ec2.Filter `locationName:"Filter" locationNameList:"Filter" type:"list"`
+	TransitGatewayAttachmentIDs []string     `locationName:"TransitGatewayAttachmentIds" locationNameList:"item" type:"list"`
+}
+
+// DescribeTransitGatewayVPCAttachmentsOutput is the output of the
+// DescribeTransitGatewayVpcAttachments operation.
+type DescribeTransitGatewayVPCAttachmentsOutput struct {
+	_ struct{} `type:"structure"`
+
+	TransitGatewayVPCAttachments []TransitGatewayVPCAttachment `locationName:"transitGatewayVpcAttachments" locationNameList:"item" type:"list"`
+}
+
+// DescribeTransitGatewayVPCAttachmentsRequest is a API request type for the DescribeTransitGatewayVpcAttachments API operation.
+type DescribeTransitGatewayVPCAttachmentsRequest struct {
+	*aws.Request
+	Input *DescribeTransitGatewayVPCAttachmentsInput
+}
+
+// Send marshals and sends the DescribeTransitGatewayVpcAttachments API request.
+func (r DescribeTransitGatewayVPCAttachmentsRequest) Send() (*DescribeTransitGatewayVPCAttachmentsOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DescribeTransitGatewayVPCAttachmentsOutput), nil
+}
+
+// ModifyTransitGatewayVPCAttachmentInput is the input of the
+// ModifyTransitGatewayVpcAttachment operation.
+type ModifyTransitGatewayVPCAttachmentInput struct {
+	_ struct{} `type:"structure"`
+
+	AddSubnetIDs               []string                                   `locationName:"AddSubnetIds" locationNameList:"item" type:"list"`
+	Options                    *TransitGatewayVPCAttachmentRequestOptions `type:"structure"`
+	RemoveSubnetIDs            []string                                   `locationName:"RemoveSubnetIds" locationNameList:"item" type:"list"`
+	TransitGatewayAttachmentID *string                                    `locationName:"TransitGatewayAttachmentId" type:"string" required:"true"`
+}
+
+// ModifyTransitGatewayVPCAttachmentOutput is the output of the
+// ModifyTransitGatewayVpcAttachment operation.
+type ModifyTransitGatewayVPCAttachmentOutput struct {
+	_ struct{} `type:"structure"`
+
+	TransitGatewayVPCAttachment *TransitGatewayVPCAttachment `locationName:"transitGatewayVpcAttachment" type:"structure"`
+}
+
+// ModifyTransitGatewayVPCAttachmentRequest is a API request type for the ModifyTransitGatewayVpcAttachment API operation.
+type ModifyTransitGatewayVPCAttachmentRequest struct {
+	*aws.Request
+	Input *ModifyTransitGatewayVPCAttachmentInput
+}
+
+// Send marshals and sends the ModifyTransitGatewayVpcAttachment API request.
+func (r ModifyTransitGatewayVPCAttachmentRequest) Send() (*ModifyTransitGatewayVPCAttachmentOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*ModifyTransitGatewayVPCAttachmentOutput), nil
+}
+
+// DeleteTransitGatewayVPCAttachmentInput is the input of the
+// DeleteTransitGatewayVpcAttachment operation.
+type DeleteTransitGatewayVPCAttachmentInput struct {
+	_ struct{} `type:"structure"`
+
+	TransitGatewayAttachmentID *string `locationName:"TransitGatewayAttachmentId" type:"string" required:"true"`
+}
+
+// DeleteTransitGatewayVPCAttachmentOutput is the output of the
+// DeleteTransitGatewayVpcAttachment operation.
+type DeleteTransitGatewayVPCAttachmentOutput struct {
+	_ struct{} `type:"structure"`
+
+	TransitGatewayVPCAttachment *TransitGatewayVPCAttachment `locationName:"transitGatewayVpcAttachment" type:"structure"`
+}
+
+// DeleteTransitGatewayVPCAttachmentRequest is a API request type for the DeleteTransitGatewayVpcAttachment API operation.
+type DeleteTransitGatewayVPCAttachmentRequest struct {
+	*aws.Request
+	Input *DeleteTransitGatewayVPCAttachmentInput
+}
+
+// Send marshals and sends the DeleteTransitGatewayVpcAttachment API request.
+func (r DeleteTransitGatewayVPCAttachmentRequest) Send() (*DeleteTransitGatewayVPCAttachmentOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DeleteTransitGatewayVPCAttachmentOutput), nil
+}
+
+// TransitGatewayVPCAttachmentClient is the external client used for TransitGatewayVPCAttachment Custom Resource
+type TransitGatewayVPCAttachmentClient interface {
+	CreateTransitGatewayVPCAttachmentRequest(input *CreateTransitGatewayVPCAttachmentInput) CreateTransitGatewayVPCAttachmentRequest
+	DescribeTransitGatewayVPCAttachmentsRequest(input *DescribeTransitGatewayVPCAttachmentsInput) DescribeTransitGatewayVPCAttachmentsRequest
+	ModifyTransitGatewayVPCAttachmentRequest(input *ModifyTransitGatewayVPCAttachmentInput) ModifyTransitGatewayVPCAttachmentRequest
+	DeleteTransitGatewayVPCAttachmentRequest(input *DeleteTransitGatewayVPCAttachmentInput) DeleteTransitGatewayVPCAttachmentRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewTransitGatewayVPCAttachmentClient returns a new client using AWS credentials as JSON encoded data.
+func NewTransitGatewayVPCAttachmentClient(cfg *aws.Config) (TransitGatewayVPCAttachmentClient, error) {
+	return &transitGatewayClient{ec2.New(*cfg)}, nil
+}
+
+// CreateTransitGatewayVPCAttachmentRequest returns a request to attach a VPC to a transit gateway.
+func (c *transitGatewayClient) CreateTransitGatewayVPCAttachmentRequest(input *CreateTransitGatewayVPCAttachmentInput) CreateTransitGatewayVPCAttachmentRequest {
+	op := &aws.Operation{Name: "CreateTransitGatewayVpcAttachment", HTTPMethod: "POST", HTTPPath: "/"}
+	return CreateTransitGatewayVPCAttachmentRequest{Request: c.NewRequest(op, input, &CreateTransitGatewayVPCAttachmentOutput{}), Input: input}
+}
+
+// DescribeTransitGatewayVPCAttachmentsRequest returns a request to describe VPC attachments.
+func (c *transitGatewayClient) DescribeTransitGatewayVPCAttachmentsRequest(input *DescribeTransitGatewayVPCAttachmentsInput) DescribeTransitGatewayVPCAttachmentsRequest {
+	op := &aws.Operation{Name: "DescribeTransitGatewayVpcAttachments", HTTPMethod: "POST", HTTPPath: "/"}
+	return DescribeTransitGatewayVPCAttachmentsRequest{Request: c.NewRequest(op, input, &DescribeTransitGatewayVPCAttachmentsOutput{}), Input: input}
+}
+
+// ModifyTransitGatewayVPCAttachmentRequest returns a request to modify a VPC attachment.
+func (c *transitGatewayClient) ModifyTransitGatewayVPCAttachmentRequest(input *ModifyTransitGatewayVPCAttachmentInput) ModifyTransitGatewayVPCAttachmentRequest {
+	op := &aws.Operation{Name: "ModifyTransitGatewayVpcAttachment", HTTPMethod: "POST", HTTPPath: "/"}
+	return ModifyTransitGatewayVPCAttachmentRequest{Request: c.NewRequest(op, input, &ModifyTransitGatewayVPCAttachmentOutput{}), Input: input}
+}
+
+// DeleteTransitGatewayVPCAttachmentRequest returns a request to delete a VPC attachment.
+func (c *transitGatewayClient) DeleteTransitGatewayVPCAttachmentRequest(input *DeleteTransitGatewayVPCAttachmentInput) DeleteTransitGatewayVPCAttachmentRequest {
+	op := &aws.Operation{Name: "DeleteTransitGatewayVpcAttachment", HTTPMethod: "POST", HTTPPath: "/"}
+	return DeleteTransitGatewayVPCAttachmentRequest{Request: c.NewRequest(op, input, &DeleteTransitGatewayVPCAttachmentOutput{}), Input: input}
+}
+
+// IsTransitGatewayAttachmentNotFoundErr returns true if the error is because the item doesn't exist
+func IsTransitGatewayAttachmentNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == TransitGatewayAttachmentIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateTransitGatewayVPCAttachmentInput returns the input to attach
+// a VPC to a transit gateway off of the given parameters, with an attachment
+// tagged with the given tags.
+func GenerateCreateTransitGatewayVPCAttachmentInput(p v1alpha2.TransitGatewayVPCAttachmentParameters, tags []v1alpha2.Tag) *CreateTransitGatewayVPCAttachmentInput {
+	return &CreateTransitGatewayVPCAttachmentInput{
+		TransitGatewayID:  aws.String(p.TransitGatewayID),
+		VPCID:             aws.String(p.VPCID),
+		SubnetIDs:         p.SubnetIDs,
+		TagSpecifications: GenerateTagSpecifications(TransitGatewayAttachmentResourceType, tags),
+		Options: &TransitGatewayVPCAttachmentRequestOptions{
+			DNSSupport:  option(p.DNSSupport),
+			IPv6Support: option(p.IPv6Support),
+		},
+	}
+}
+
+// IsTransitGatewayVPCAttachmentUpToDate returns true if the subnets and
+// options of the observed attachment match the given parameters.
+func IsTransitGatewayVPCAttachmentUpToDate(p v1alpha2.TransitGatewayVPCAttachmentParameters, a TransitGatewayVPCAttachment) bool {
+	return GenerateModifyTransitGatewayVPCAttachmentInput("", p, a) == nil
+}
+
+// GenerateModifyTransitGatewayVPCAttachmentInput returns the input to bring
+// the observed attachment in line with the given parameters, or nil if it is
+// up to date.
+func GenerateModifyTransitGatewayVPCAttachmentInput(id string, p v1alpha2.TransitGatewayVPCAttachmentParameters, a TransitGatewayVPCAttachment) *ModifyTransitGatewayVPCAttachmentInput {
+	in := &ModifyTransitGatewayVPCAttachmentInput{TransitGatewayAttachmentID: aws.String(id)}
+	in.AddSubnetIDs, in.RemoveSubnetIDs = diffStrings(p.SubnetIDs, a.SubnetIDs)
+	changed := len(in.AddSubnetIDs)+len(in.RemoveSubnetIDs) > 0
+
+	observed := a.Options
+	if observed == nil {
+		observed = &TransitGatewayVPCAttachmentOptions{}
+	}
+	opts := &TransitGatewayVPCAttachmentRequestOptions{}
+	if o := option(p.DNSSupport); o != nil && *o != aws.StringValue(observed.DNSSupport) {
+		opts.DNSSupport = o
+		changed = true
+	}
+	if o := option(p.IPv6Support); o != nil && *o != aws.StringValue(observed.IPv6Support) {
+		opts.IPv6Support = o
+		changed = true
+	}
+	if opts.DNSSupport != nil || opts.IPv6Support != nil {
+		in.Options = opts
+	}
+
+	if !changed {
+		return nil
+	}
+	return in
+}
+
+// GenerateTransitGatewayVPCAttachmentObservation returns the observed state
+// of the supplied attachment.
+func GenerateTransitGatewayVPCAttachmentObservation(a TransitGatewayVPCAttachment) v1alpha2.TransitGatewayVPCAttachmentExternalStatus {
+	return v1alpha2.TransitGatewayVPCAttachmentExternalStatus{
+		TransitGatewayAttachmentID:    aws.StringValue(a.TransitGatewayAttachmentID),
+		TransitGatewayAttachmentState: aws.StringValue(a.State),
+		VPCOwnerID:                    aws.StringValue(a.VPCOwnerID),
+		SubnetIDs:                     a.SubnetIDs,
+		Tags:                          v1alpha2.BuildFromEC2Tags(a.Tags),
+	}
+}
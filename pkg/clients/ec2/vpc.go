@@ -4,11 +4,17 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	clients "github.com/crossplaneio/stack-aws/pkg/clients"
 )
 
 const (
 	// VPCIDNotFound is the code that is returned by ec2 when the given VPCID is not valid
 	VPCIDNotFound = "InvalidVpcID.NotFound"
+
+	// VPCIDPrefix is the prefix of the IDs EC2 assigns to VPCs
+	VPCIDPrefix = "vpc-"
 )
 
 // VPCClient is the external client used for VPC Custom Resource
@@ -17,6 +23,18 @@ type VPCClient interface {
 	DeleteVpcRequest(*ec2.DeleteVpcInput) ec2.DeleteVpcRequest
 	DescribeVpcsRequest(*ec2.DescribeVpcsInput) ec2.DescribeVpcsRequest
 	ModifyVpcAttributeRequest(*ec2.ModifyVpcAttributeInput) ec2.ModifyVpcAttributeRequest
+	DescribeVpcAttributeRequest(*ec2.DescribeVpcAttributeInput) ec2.DescribeVpcAttributeRequest
+	AssociateVpcCidrBlockRequest(*ec2.AssociateVpcCidrBlockInput) ec2.AssociateVpcCidrBlockRequest
+	DisassociateVpcCidrBlockRequest(*ec2.DisassociateVpcCidrBlockInput) ec2.DisassociateVpcCidrBlockRequest
+	ModifyVpcTenancyRequest(*ec2.ModifyVpcTenancyInput) ec2.ModifyVpcTenancyRequest
+	CreateTagsRequest(*ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// VPCAttributes are the attributes of a VPC that are not returned by
+// DescribeVpcs, and must be described individually.
+type VPCAttributes struct {
+	EnableDNSSupport   bool
+	EnableDNSHostNames bool
 }
 
 // NewVPCClient returns a new client using AWS credentials as JSON encoded data.
@@ -34,3 +52,124 @@ func IsVPCNotFoundErr(err error) bool {
 
 	return false
 }
+
+// IsVPCUpToDate returns true if the supplied VPC and its attributes match the
+// desired parameters. Tags are not considered; see AreTagsUpToDate.
+func IsVPCUpToDate(p v1alpha2.VPCParameters, vpc ec2.Vpc, attrs VPCAttributes) bool {
+	if DNSSupportNeedsUpdate(p, attrs) || DNSHostNamesNeedsUpdate(p, attrs) {
+		return false
+	}
+
+	if len(CIDRBlocksToAssociate(p, vpc)) != 0 || len(CIDRBlockAssociationsToRemove(p, vpc)) != 0 {
+		return false
+	}
+
+	return !IPv6CIDRBlockNeedsUpdate(p, vpc) && !VPCTenancyNeedsUpdate(p, vpc)
+}
+
+// LateInitializeVPC fills the parameters that were left unset with the
+// settings and attributes of the observed VPC, so that adopting a VPC doesn't
+// change them.
+func LateInitializeVPC(p *v1alpha2.VPCParameters, vpc ec2.Vpc, attrs VPCAttributes) {
+	p.EnableDNSSupport = clients.LateInitializeBoolPtr(p.EnableDNSSupport, aws.Bool(attrs.EnableDNSSupport))
+	p.EnableDNSHostNames = clients.LateInitializeBoolPtr(p.EnableDNSHostNames, aws.Bool(attrs.EnableDNSHostNames))
+
+	_, ipv6 := IPv6CIDRBlockAssociation(vpc)
+	p.AmazonProvidedIPv6CIDRBlock = clients.LateInitializeBoolPtr(p.AmazonProvidedIPv6CIDRBlock, aws.Bool(ipv6))
+
+	if p.InstanceTenancy == "" {
+		p.InstanceTenancy = string(vpc.InstanceTenancy)
+	}
+
+	if p.AdditionalCIDRBlocks == nil {
+		for _, a := range vpc.CidrBlockAssociationSet {
+			c := aws.StringValue(a.CidrBlock)
+			if isCIDRBlockAssociationActive(a.CidrBlockState) && c != aws.StringValue(vpc.CidrBlock) {
+				p.AdditionalCIDRBlocks = append(p.AdditionalCIDRBlocks, c)
+			}
+		}
+	}
+}
+
+// DNSSupportNeedsUpdate returns true if DNS support of the supplied VPC
+// attributes differs from the one of the supplied parameters.
+func DNSSupportNeedsUpdate(p v1alpha2.VPCParameters, attrs VPCAttributes) bool {
+	return p.EnableDNSSupport != nil && *p.EnableDNSSupport != attrs.EnableDNSSupport
+}
+
+// DNSHostNamesNeedsUpdate returns true if DNS hostnames of the supplied VPC
+// attributes differ from the ones of the supplied parameters.
+func DNSHostNamesNeedsUpdate(p v1alpha2.VPCParameters, attrs VPCAttributes) bool {
+	return p.EnableDNSHostNames != nil && *p.EnableDNSHostNames != attrs.EnableDNSHostNames
+}
+
+// CIDRBlocksToAssociate returns the additional IPv4 CIDR blocks of the
+// supplied parameters that are not yet associated with the supplied VPC.
+func CIDRBlocksToAssociate(p v1alpha2.VPCParameters, vpc ec2.Vpc) []string {
+	associated := map[string]bool{}
+	for _, a := range vpc.CidrBlockAssociationSet {
+		if isCIDRBlockAssociationActive(a.CidrBlockState) {
+			associated[aws.StringValue(a.CidrBlock)] = true
+		}
+	}
+
+	add := make([]string, 0)
+	for _, c := range p.AdditionalCIDRBlocks {
+		if !associated[c] {
+			add = append(add, c)
+		}
+	}
+	return add
+}
+
+// CIDRBlockAssociationsToRemove returns the IDs of the secondary IPv4 CIDR
+// block associations of the supplied VPC that are not desired by the supplied
+// parameters. The primary CIDR block is never removed.
+func CIDRBlockAssociationsToRemove(p v1alpha2.VPCParameters, vpc ec2.Vpc) []string {
+	desired := map[string]bool{aws.StringValue(vpc.CidrBlock): true}
+	for _, c := range p.AdditionalCIDRBlocks {
+		desired[c] = true
+	}
+
+	remove := make([]string, 0)
+	for _, a := range vpc.CidrBlockAssociationSet {
+		if !isCIDRBlockAssociationActive(a.CidrBlockState) || desired[aws.StringValue(a.CidrBlock)] {
+			continue
+		}
+		remove = append(remove, aws.StringValue(a.AssociationId))
+	}
+	return remove
+}
+
+// IPv6CIDRBlockAssociation returns the ID of the active IPv6 CIDR block
+// association of the supplied VPC, if any.
+func IPv6CIDRBlockAssociation(vpc ec2.Vpc) (string, bool) {
+	for _, a := range vpc.Ipv6CidrBlockAssociationSet {
+		if isCIDRBlockAssociationActive(a.Ipv6CidrBlockState) {
+			return aws.StringValue(a.AssociationId), true
+		}
+	}
+	return "", false
+}
+
+// IPv6CIDRBlockNeedsUpdate returns true if an Amazon-provided IPv6 CIDR block
+// must be associated with or disassociated from the supplied VPC. An unset
+// AmazonProvidedIPv6CIDRBlock never needs an update.
+func IPv6CIDRBlockNeedsUpdate(p v1alpha2.VPCParameters, vpc ec2.Vpc) bool {
+	_, associated := IPv6CIDRBlockAssociation(vpc)
+	return p.AmazonProvidedIPv6CIDRBlock != nil && *p.AmazonProvidedIPv6CIDRBlock != associated
+}
+
+// VPCTenancyNeedsUpdate returns true if the instance tenancy of the supplied
+// VPC must be changed. EC2 only supports changing from dedicated to default
+// tenancy, so this is the only change that is considered.
+func VPCTenancyNeedsUpdate(p v1alpha2.VPCParameters, vpc ec2.Vpc) bool {
+	return p.InstanceTenancy == string(ec2.VpcTenancyDefault) && vpc.InstanceTenancy == ec2.TenancyDedicated
+}
+
+func isCIDRBlockAssociationActive(s *ec2.VpcCidrBlockState) bool {
+	if s == nil {
+		return false
+	}
+	return s.State == ec2.VpcCidrBlockStateCodeAssociated || s.State == ec2.VpcCidrBlockStateCodeAssociating
+}
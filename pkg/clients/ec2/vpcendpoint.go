@@ -0,0 +1,146 @@
+package ec2
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	clients "github.com/crossplaneio/stack-aws/pkg/clients"
+)
+
+const (
+	// VPCEndpointIDNotFound is the code that is returned by ec2 when the given VPCEndpointID is not valid
+	VPCEndpointIDNotFound = "InvalidVpcEndpointId.NotFound"
+)
+
+// VPCEndpointClient is the external client used for VPCEndpoint Custom Resource
+type VPCEndpointClient interface {
+	CreateVpcEndpointRequest(input *ec2.CreateVpcEndpointInput) ec2.CreateVpcEndpointRequest
+	DescribeVpcEndpointsRequest(input *ec2.DescribeVpcEndpointsInput) ec2.DescribeVpcEndpointsRequest
+	ModifyVpcEndpointRequest(input *ec2.ModifyVpcEndpointInput) ec2.ModifyVpcEndpointRequest
+	DeleteVpcEndpointsRequest(input *ec2.DeleteVpcEndpointsInput) ec2.DeleteVpcEndpointsRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+	DescribeTagsRequest(input *ec2.DescribeTagsInput) ec2.DescribeTagsRequest
+}
+
+// NewVPCEndpointClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCEndpointClient(cfg *aws.Config) (VPCEndpointClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsVPCEndpointNotFoundErr returns true if the error is because the item doesn't exist
+func IsVPCEndpointNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCEndpointIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GenerateCreateVPCEndpointInput returns the input to create a VPC endpoint
+// off of the given parameters, using the given client token to make the
+// request idempotent.
+func GenerateCreateVPCEndpointInput(p v1alpha2.VPCEndpointParameters, clientToken *string) *ec2.CreateVpcEndpointInput {
+	return &ec2.CreateVpcEndpointInput{
+		ClientToken:       clientToken,
+		VpcId:             aws.String(p.VPCID),
+		ServiceName:       aws.String(p.ServiceName),
+		VpcEndpointType:   ec2.VpcEndpointType(p.VPCEndpointType),
+		PolicyDocument:    clients.String(p.PolicyDocument),
+		PrivateDnsEnabled: p.PrivateDNSEnabled,
+		RouteTableIds:     p.RouteTableIDs,
+		SubnetIds:         p.SubnetIDs,
+		SecurityGroupIds:  p.SecurityGroupIDs,
+	}
+}
+
+// IsVPCEndpointUpToDate returns true if the route tables, subnets, security
+// groups, policy and private DNS setting of the observed endpoint match the
+// given parameters.
+func IsVPCEndpointUpToDate(p v1alpha2.VPCEndpointParameters, e ec2.VpcEndpoint) bool {
+	return GenerateModifyVPCEndpointInput("", p, e) == nil
+}
+
+// GenerateModifyVPCEndpointInput returns the input to bring the observed
+// endpoint in line with the given parameters, or nil if it is up to date.
+func GenerateModifyVPCEndpointInput(id string, p v1alpha2.VPCEndpointParameters, e ec2.VpcEndpoint) *ec2.ModifyVpcEndpointInput {
+	observedGroups := make([]string, len(e.Groups))
+	for i, g := range e.Groups {
+		observedGroups[i] = aws.StringValue(g.GroupId)
+	}
+
+	in := &ec2.ModifyVpcEndpointInput{VpcEndpointId: aws.String(id)}
+	in.AddRouteTableIds, in.RemoveRouteTableIds = diffStrings(p.RouteTableIDs, e.RouteTableIds)
+	in.AddSubnetIds, in.RemoveSubnetIds = diffStrings(p.SubnetIDs, e.SubnetIds)
+	in.AddSecurityGroupIds, in.RemoveSecurityGroupIds = diffStrings(p.SecurityGroupIDs, observedGroups)
+
+	changed := len(in.AddRouteTableIds)+len(in.RemoveRouteTableIds)+
+		len(in.AddSubnetIds)+len(in.RemoveSubnetIds)+
+		len(in.AddSecurityGroupIds)+len(in.RemoveSecurityGroupIds) > 0
+
+	if !isPolicyUpToDate(p.PolicyDocument, aws.StringValue(e.PolicyDocument)) {
+		in.PolicyDocument = aws.String(p.PolicyDocument)
+		changed = true
+	}
+
+	if p.PrivateDNSEnabled != nil && aws.BoolValue(p.PrivateDNSEnabled) != aws.BoolValue(e.PrivateDnsEnabled) {
+		in.PrivateDnsEnabled = p.PrivateDNSEnabled
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	return in
+}
+
+// isPolicyUpToDate returns true if the observed policy document is
+// semantically equal to the desired one. An empty desired policy leaves the
+// policy chosen by AWS in place.
+func isPolicyUpToDate(desired, observed string) bool {
+	if desired == "" {
+		return true
+	}
+
+	var d, o interface{}
+	if err := json.Unmarshal([]byte(desired), &d); err != nil {
+		return desired == observed
+	}
+	if err := json.Unmarshal([]byte(observed), &o); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(d, o)
+}
+
+// diffStrings returns the elements of desired that are missing from observed,
+// and the elements of observed that are not desired.
+func diffStrings(desired, observed []string) (add, remove []string) {
+	d := make(map[string]bool, len(desired))
+	for _, s := range desired {
+		d[s] = true
+	}
+	o := make(map[string]bool, len(observed))
+	for _, s := range observed {
+		o[s] = true
+	}
+
+	for _, s := range desired {
+		if !o[s] {
+			add = append(add, s)
+		}
+	}
+	for _, s := range observed {
+		if !d[s] {
+			remove = append(remove, s)
+		}
+	}
+
+	return add, remove
+}
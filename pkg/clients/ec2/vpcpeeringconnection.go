@@ -0,0 +1,37 @@
+package ec2
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+)
+
+const (
+	// VPCPeeringConnectionIDNotFound is the code that is returned by ec2 when the given VPCPeeringConnectionID is not valid
+	VPCPeeringConnectionIDNotFound = "InvalidVpcPeeringConnectionID.NotFound"
+)
+
+// VPCPeeringConnectionClient is the external client used for VPCPeeringConnection Custom Resource
+type VPCPeeringConnectionClient interface {
+	CreateVpcPeeringConnectionRequest(input *ec2.CreateVpcPeeringConnectionInput) ec2.CreateVpcPeeringConnectionRequest
+	DescribeVpcPeeringConnectionsRequest(input *ec2.DescribeVpcPeeringConnectionsInput) ec2.DescribeVpcPeeringConnectionsRequest
+	AcceptVpcPeeringConnectionRequest(input *ec2.AcceptVpcPeeringConnectionInput) ec2.AcceptVpcPeeringConnectionRequest
+	DeleteVpcPeeringConnectionRequest(input *ec2.DeleteVpcPeeringConnectionInput) ec2.DeleteVpcPeeringConnectionRequest
+	CreateTagsRequest(input *ec2.CreateTagsInput) ec2.CreateTagsRequest
+}
+
+// NewVPCPeeringConnectionClient returns a new client using AWS credentials as JSON encoded data.
+func NewVPCPeeringConnectionClient(cfg *aws.Config) (VPCPeeringConnectionClient, error) {
+	return ec2.New(*cfg), nil
+}
+
+// IsVPCPeeringConnectionNotFoundErr returns true if the error is because the item doesn't exist
+func IsVPCPeeringConnectionNotFoundErr(err error) bool {
+	if awsErr, ok := err.(awserr.Error); ok {
+		if awsErr.Code() == VPCPeeringConnectionIDNotFound {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,407 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+
+	awscomputev1alpha2 "github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
+)
+
+// The SDK version in use models DescribeCluster, but neither the logging nor
+// the endpoint access configuration of a cluster, nor the operations that
+// update a cluster. The operations, their inputs and their outputs are
+// declared here, following the shapes of the EKS API reference.
+
+// Cluster update statuses.
+const (
+	UpdateStatusInProgress = "InProgress"
+	UpdateStatusFailed     = "Failed"
+	UpdateStatusCancelled  = "Cancelled"
+	UpdateStatusSuccessful = "Successful"
+)
+
+// logTypes are all EKS control plane log types, in the order they are sent
+// to EKS.
+var logTypes = []awscomputev1alpha2.LogType{
+	awscomputev1alpha2.LogTypeAPI,
+	awscomputev1alpha2.LogTypeAudit,
+	awscomputev1alpha2.LogTypeAuthenticator,
+	awscomputev1alpha2.LogTypeControllerManager,
+	awscomputev1alpha2.LogTypeScheduler,
+}
+
+// VpcConfigRequest describes the endpoint access configuration of a cluster.
+type VpcConfigRequest struct {
+	_ struct{} `type:"structure"`
+
+	EndpointPrivateAccess *bool    `locationName:"endpointPrivateAccess" type:"boolean"`
+	EndpointPublicAccess  *bool    `locationName:"endpointPublicAccess" type:"boolean"`
+	PublicAccessCIDRs     []string `locationName:"publicAccessCidrs" type:"list"`
+}
+
+// VpcConfigResponse describes the VPC configuration of a cluster.
+type VpcConfigResponse struct {
+	_ struct{} `type:"structure"`
+
+	EndpointPrivateAccess *bool    `locationName:"endpointPrivateAccess" type:"boolean"`
+	EndpointPublicAccess  *bool    `locationName:"endpointPublicAccess" type:"boolean"`
+	PublicAccessCIDRs     []string `locationName:"publicAccessCidrs" type:"list"`
+	SecurityGroupIDs      []string `locationName:"securityGroupIds" type:"list"`
+	SubnetIDs             []string `locationName:"subnetIds" type:"list"`
+	VpcID                 *string  `locationName:"vpcId" type:"string"`
+}
+
+// LogSetup enables or disables a set of control plane log types.
+type LogSetup struct {
+	_ struct{} `type:"structure"`
+
+	Enabled *bool    `locationName:"enabled" type:"boolean"`
+	Types   []string `locationName:"types" type:"list"`
+}
+
+// Logging describes the control plane logging configuration of a cluster.
+type Logging struct {
+	_ struct{} `type:"structure"`
+
+	ClusterLogging []LogSetup `locationName:"clusterLogging" type:"list"`
+}
+
+// ClusterDescription describes a cluster, including its logging and endpoint
+// access configuration.
+type ClusterDescription struct {
+	_ struct{} `type:"structure"`
+
+	ARN                  *string            `locationName:"arn" type:"string"`
+	CertificateAuthority *eks.Certificate   `locationName:"certificateAuthority" type:"structure"`
+	Endpoint             *string            `locationName:"endpoint" type:"string"`
+	Logging              *Logging           `locationName:"logging" type:"structure"`
+	Name                 *string            `locationName:"name" type:"string"`
+	PlatformVersion      *string            `locationName:"platformVersion" type:"string"`
+	ResourcesVpcConfig   *VpcConfigResponse `locationName:"resourcesVpcConfig" type:"structure"`
+	RoleARN              *string            `locationName:"roleArn" type:"string"`
+	Status               *string            `locationName:"status" type:"string"`
+	Version              *string            `locationName:"version" type:"string"`
+}
+
+// DescribeClusterInput is the input of the DescribeCluster operation.
+type DescribeClusterInput struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `location:"uri" locationName:"name" type:"string" required:"true"`
+}
+
+// DescribeClusterOutput is the output of the DescribeCluster operation.
+type DescribeClusterOutput struct {
+	_ struct{} `type:"structure"`
+
+	Cluster *ClusterDescription `locationName:"cluster" type:"structure"`
+}
+
+// DescribeClusterRequest is a API request type for the DescribeCluster API operation.
+type DescribeClusterRequest struct {
+	*aws.Request
+	Input *DescribeClusterInput
+}
+
+// Send marshals and sends the DescribeCluster API request.
+func (r DescribeClusterRequest) Send() (*DescribeClusterOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DescribeClusterOutput), nil
+}
+
+// UpdateClusterVersionInput is the input of the UpdateClusterVersion
+// operation.
+type UpdateClusterVersionInput struct {
+	_ struct{} `type:"structure"`
+
+	Name    *string `location:"uri" locationName:"name" type:"string" required:"true"`
+	Version *string `locationName:"version" type:"string" required:"true"`
+}
+
+// UpdateClusterVersionOutput is the output of the UpdateClusterVersion
+// operation.
+type UpdateClusterVersionOutput struct {
+	_ struct{} `type:"structure"`
+
+	Update *Update `locationName:"update" type:"structure"`
+}
+
+// UpdateClusterVersionRequest is a API request type for the UpdateClusterVersion API operation.
+type UpdateClusterVersionRequest struct {
+	*aws.Request
+	Input *UpdateClusterVersionInput
+}
+
+// Send marshals and sends the UpdateClusterVersion API request.
+func (r UpdateClusterVersionRequest) Send() (*UpdateClusterVersionOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*UpdateClusterVersionOutput), nil
+}
+
+// UpdateClusterConfigInput is the input of the UpdateClusterConfig operation.
+type UpdateClusterConfigInput struct {
+	_ struct{} `type:"structure"`
+
+	Logging            *Logging          `locationName:"logging" type:"structure"`
+	Name               *string           `location:"uri" locationName:"name" type:"string" required:"true"`
+	ResourcesVpcConfig *VpcConfigRequest `locationName:"resourcesVpcConfig" type:"structure"`
+}
+
+// UpdateClusterConfigOutput is the output of the UpdateClusterConfig
+// operation.
+type UpdateClusterConfigOutput struct {
+	_ struct{} `type:"structure"`
+
+	Update *Update `locationName:"update" type:"structure"`
+}
+
+// UpdateClusterConfigRequest is a API request type for the UpdateClusterConfig API operation.
+type UpdateClusterConfigRequest struct {
+	*aws.Request
+	Input *UpdateClusterConfigInput
+}
+
+// Send marshals and sends the UpdateClusterConfig API request.
+func (r UpdateClusterConfigRequest) Send() (*UpdateClusterConfigOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*UpdateClusterConfigOutput), nil
+}
+
+// DescribeUpdateInput is the input of the DescribeUpdate operation.
+type DescribeUpdateInput struct {
+	_ struct{} `type:"structure"`
+
+	Name     *string `location:"uri" locationName:"name" type:"string" required:"true"`
+	UpdateID *string `location:"uri" locationName:"updateId" type:"string" required:"true"`
+}
+
+// DescribeUpdateOutput is the output of the DescribeUpdate operation.
+type DescribeUpdateOutput struct {
+	_ struct{} `type:"structure"`
+
+	Update *Update `locationName:"update" type:"structure"`
+}
+
+// DescribeUpdateRequest is a API request type for the DescribeUpdate API operation.
+type DescribeUpdateRequest struct {
+	*aws.Request
+	Input *DescribeUpdateInput
+}
+
+// Send marshals and sends the DescribeUpdate API request.
+func (r DescribeUpdateRequest) Send() (*DescribeUpdateOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DescribeUpdateOutput), nil
+}
+
+// ClusterConfigClient is the interface for describing clusters and updating
+// their version and configuration.
+type ClusterConfigClient interface {
+	DescribeClusterRequest(input *DescribeClusterInput) DescribeClusterRequest
+	UpdateClusterVersionRequest(input *UpdateClusterVersionInput) UpdateClusterVersionRequest
+	UpdateClusterConfigRequest(input *UpdateClusterConfigInput) UpdateClusterConfigRequest
+	DescribeUpdateRequest(input *DescribeUpdateInput) DescribeUpdateRequest
+}
+
+// clusterConfigClient issues the cluster requests the SDK does not model.
+type clusterConfigClient struct {
+	*eks.EKS
+}
+
+// DescribeClusterRequest returns a request to describe a cluster.
+func (c *clusterConfigClient) DescribeClusterRequest(input *DescribeClusterInput) DescribeClusterRequest {
+	op := &aws.Operation{Name: "DescribeCluster", HTTPMethod: "GET", HTTPPath: "/clusters/{name}"}
+	return DescribeClusterRequest{Request: c.NewRequest(op, input, &DescribeClusterOutput{}), Input: input}
+}
+
+// UpdateClusterVersionRequest returns a request to update the Kubernetes
+// version of a cluster.
+func (c *clusterConfigClient) UpdateClusterVersionRequest(input *UpdateClusterVersionInput) UpdateClusterVersionRequest {
+	op := &aws.Operation{Name: "UpdateClusterVersion", HTTPMethod: "POST", HTTPPath: "/clusters/{name}/updates"}
+	return UpdateClusterVersionRequest{Request: c.NewRequest(op, input, &UpdateClusterVersionOutput{}), Input: input}
+}
+
+// UpdateClusterConfigRequest returns a request to update the logging or the
+// endpoint access configuration of a cluster.
+func (c *clusterConfigClient) UpdateClusterConfigRequest(input *UpdateClusterConfigInput) UpdateClusterConfigRequest {
+	op := &aws.Operation{Name: "UpdateClusterConfig", HTTPMethod: "POST", HTTPPath: "/clusters/{name}/update-config"}
+	return UpdateClusterConfigRequest{Request: c.NewRequest(op, input, &UpdateClusterConfigOutput{}), Input: input}
+}
+
+// DescribeUpdateRequest returns a request to describe an update of a cluster.
+func (c *clusterConfigClient) DescribeUpdateRequest(input *DescribeUpdateInput) DescribeUpdateRequest {
+	op := &aws.Operation{Name: "DescribeUpdate", HTTPMethod: "GET", HTTPPath: "/clusters/{name}/updates/{updateId}"}
+	return DescribeUpdateRequest{Request: c.NewRequest(op, input, &DescribeUpdateOutput{}), Input: input}
+}
+
+// NewClusterFromDescription returns crossplane representation of an AWS EKS
+// cluster from its description.
+func NewClusterFromDescription(c *ClusterDescription) *Cluster {
+	cluster := &Cluster{
+		Name:     aws.StringValue(c.Name),
+		Version:  aws.StringValue(c.Version),
+		ARN:      aws.StringValue(c.ARN),
+		Status:   aws.StringValue(c.Status),
+		Endpoint: aws.StringValue(c.Endpoint),
+	}
+
+	if c.CertificateAuthority != nil {
+		cluster.CA = aws.StringValue(c.CertificateAuthority.Data)
+	}
+
+	if c.ResourcesVpcConfig != nil {
+		cluster.EndpointPublicAccess = aws.BoolValue(c.ResourcesVpcConfig.EndpointPublicAccess)
+		cluster.EndpointPrivateAccess = aws.BoolValue(c.ResourcesVpcConfig.EndpointPrivateAccess)
+		cluster.PublicAccessCIDRs = c.ResourcesVpcConfig.PublicAccessCIDRs
+	}
+
+	if c.Logging != nil {
+		for _, setup := range c.Logging.ClusterLogging {
+			if aws.BoolValue(setup.Enabled) {
+				cluster.LogTypes = append(cluster.LogTypes, setup.Types...)
+			}
+		}
+	}
+
+	return cluster
+}
+
+// NewClusterUpdate returns crossplane representation of an update of an AWS
+// EKS cluster.
+func NewClusterUpdate(u *Update) *ClusterUpdate {
+	update := &ClusterUpdate{
+		ID:     aws.StringValue(u.ID),
+		Status: aws.StringValue(u.Status),
+		Type:   aws.StringValue(u.Type),
+	}
+	for _, e := range u.Errors {
+		update.Errors = append(update.Errors, fmt.Sprintf("%s: %s", aws.StringValue(e.ErrorCode), aws.StringValue(e.ErrorMessage)))
+	}
+	return update
+}
+
+// IsClusterVersionUpToDate returns true if the cluster runs the Kubernetes
+// version of the spec, or if the spec does not pin a version.
+func IsClusterVersionUpToDate(spec awscomputev1alpha2.EKSClusterSpec, c *Cluster) bool {
+	return spec.ClusterVersion == "" || spec.ClusterVersion == c.Version
+}
+
+// IsClusterConfigUpToDate returns true if the logging and the endpoint access
+// configuration of the cluster match the spec.
+func IsClusterConfigUpToDate(spec awscomputev1alpha2.EKSClusterSpec, c *Cluster) bool {
+	return isLoggingUpToDate(spec, c) && isEndpointAccessUpToDate(spec, c)
+}
+
+// GenerateUpdateClusterConfigInput returns the input to bring the
+// configuration of the supplied cluster up to date with the spec. EKS updates
+// either the logging or the endpoint access configuration of a cluster at a
+// time, so the logging configuration is brought up to date first.
+func GenerateUpdateClusterConfigInput(name string, spec awscomputev1alpha2.EKSClusterSpec, c *Cluster) *UpdateClusterConfigInput {
+	input := &UpdateClusterConfigInput{Name: aws.String(name)}
+
+	if !isLoggingUpToDate(spec, c) {
+		input.Logging = generateLogging(spec.EnabledLogTypes)
+		return input
+	}
+
+	input.ResourcesVpcConfig = &VpcConfigRequest{
+		EndpointPublicAccess:  spec.EndpointPublicAccess,
+		EndpointPrivateAccess: spec.EndpointPrivateAccess,
+		PublicAccessCIDRs:     spec.PublicAccessCIDRs,
+	}
+	return input
+}
+
+// isLoggingUpToDate returns true if exactly the log types of the spec are
+// enabled for the cluster.
+func isLoggingUpToDate(spec awscomputev1alpha2.EKSClusterSpec, c *Cluster) bool {
+	enabled := make([]string, len(spec.EnabledLogTypes))
+	for i, t := range spec.EnabledLogTypes {
+		enabled[i] = string(t)
+	}
+	return isSameSet(enabled, c.LogTypes)
+}
+
+// isEndpointAccessUpToDate returns true if the endpoint access configuration
+// of the cluster matches the fields that are set in the spec.
+func isEndpointAccessUpToDate(spec awscomputev1alpha2.EKSClusterSpec, c *Cluster) bool {
+	if spec.EndpointPublicAccess != nil && *spec.EndpointPublicAccess != c.EndpointPublicAccess {
+		return false
+	}
+	if spec.EndpointPrivateAccess != nil && *spec.EndpointPrivateAccess != c.EndpointPrivateAccess {
+		return false
+	}
+	return len(spec.PublicAccessCIDRs) == 0 || isSameSet(spec.PublicAccessCIDRs, c.PublicAccessCIDRs)
+}
+
+// generateLogging returns a logging configuration that enables the supplied
+// log types and disables all others.
+func generateLogging(enabledTypes []awscomputev1alpha2.LogType) *Logging {
+	isEnabled := make(map[awscomputev1alpha2.LogType]bool, len(enabledTypes))
+	for _, t := range enabledTypes {
+		isEnabled[t] = true
+	}
+
+	var enabled, disabled []string
+	for _, t := range logTypes {
+		if isEnabled[t] {
+			enabled = append(enabled, string(t))
+			continue
+		}
+		disabled = append(disabled, string(t))
+	}
+
+	logging := &Logging{}
+	if len(enabled) > 0 {
+		logging.ClusterLogging = append(logging.ClusterLogging, LogSetup{Enabled: aws.Bool(true), Types: enabled})
+	}
+	if len(disabled) > 0 {
+		logging.ClusterLogging = append(logging.ClusterLogging, LogSetup{Enabled: aws.Bool(false), Types: disabled})
+	}
+	return logging
+}
+
+// isSameSet returns true if both slices contain the same strings, regardless
+// of their order.
+func isSameSet(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]string{}, a...)
+	sb := append([]string{}, b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,282 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
+)
+
+func Test_NewClusterFromDescription(t *testing.T) {
+	d := &ClusterDescription{
+		ARN:                  aws.String("arn"),
+		CertificateAuthority: &eks.Certificate{Data: aws.String("ca")},
+		Endpoint:             aws.String("https://endpoint"),
+		Logging: &Logging{ClusterLogging: []LogSetup{
+			{Enabled: aws.Bool(true), Types: []string{"api", "audit"}},
+			{Enabled: aws.Bool(false), Types: []string{"scheduler"}},
+		}},
+		Name: aws.String("cluster"),
+		ResourcesVpcConfig: &VpcConfigResponse{
+			EndpointPublicAccess: aws.Bool(true),
+			PublicAccessCIDRs:    []string{"0.0.0.0/0"},
+		},
+		Status:  aws.String("ACTIVE"),
+		Version: aws.String("1.14"),
+	}
+	want := &Cluster{
+		Name:                 "cluster",
+		Version:              "1.14",
+		ARN:                  "arn",
+		Status:               "ACTIVE",
+		Endpoint:             "https://endpoint",
+		CA:                   "ca",
+		LogTypes:             []string{"api", "audit"},
+		EndpointPublicAccess: true,
+		PublicAccessCIDRs:    []string{"0.0.0.0/0"},
+	}
+
+	got := NewClusterFromDescription(d)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func Test_IsClusterConfigUpToDate(t *testing.T) {
+	c := &Cluster{
+		LogTypes:             []string{"audit", "api"},
+		EndpointPublicAccess: true,
+		PublicAccessCIDRs:    []string{"10.0.0.0/8", "192.168.0.0/16"},
+	}
+
+	testCases := []struct {
+		name string
+		spec v1alpha2.EKSClusterSpec
+		want bool
+	}{
+		{
+			"an empty spec does not match enabled logging",
+			v1alpha2.EKSClusterSpec{},
+			false,
+		},
+		{
+			"log types and CIDRs match in any order",
+			v1alpha2.EKSClusterSpec{EKSClusterParameters: v1alpha2.EKSClusterParameters{
+				EnabledLogTypes:      []v1alpha2.LogType{v1alpha2.LogTypeAPI, v1alpha2.LogTypeAudit},
+				EndpointPublicAccess: aws.Bool(true),
+				PublicAccessCIDRs:    []string{"192.168.0.0/16", "10.0.0.0/8"},
+			}},
+			true,
+		},
+		{
+			"an additional log type is outdated",
+			v1alpha2.EKSClusterSpec{EKSClusterParameters: v1alpha2.EKSClusterParameters{
+				EnabledLogTypes: []v1alpha2.LogType{v1alpha2.LogTypeAPI, v1alpha2.LogTypeAudit, v1alpha2.LogTypeScheduler},
+			}},
+			false,
+		},
+		{
+			"a different endpoint access is outdated",
+			v1alpha2.EKSClusterSpec{EKSClusterParameters: v1alpha2.EKSClusterParameters{
+				EnabledLogTypes:       []v1alpha2.LogType{v1alpha2.LogTypeAPI, v1alpha2.LogTypeAudit},
+				EndpointPrivateAccess: aws.Bool(true),
+			}},
+			false,
+		},
+		{
+			"different CIDRs are outdated",
+			v1alpha2.EKSClusterSpec{EKSClusterParameters: v1alpha2.EKSClusterParameters{
+				EnabledLogTypes:   []v1alpha2.LogType{v1alpha2.LogTypeAPI, v1alpha2.LogTypeAudit},
+				PublicAccessCIDRs: []string{"10.0.0.0/8"},
+			}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsClusterConfigUpToDate(tc.spec, c); got != tc.want {
+				t.Errorf("IsClusterConfigUpToDate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func Test_GenerateUpdateClusterConfigInput(t *testing.T) {
+	c := &Cluster{LogTypes: []string{"api"}, EndpointPublicAccess: true}
+
+	testCases := []struct {
+		name string
+		spec v1alpha2.EKSClusterSpec
+		want *UpdateClusterConfigInput
+	}{
+		{
+			"outdated logging enables the listed log types and disables the others",
+			v1alpha2.EKSClusterSpec{EKSClusterParameters: v1alpha2.EKSClusterParameters{
+				EnabledLogTypes:       []v1alpha2.LogType{v1alpha2.LogTypeAudit, v1alpha2.LogTypeAPI},
+				EndpointPrivateAccess: aws.Bool(true),
+			}},
+			&UpdateClusterConfigInput{
+				Name: aws.String("cluster"),
+				Logging: &Logging{ClusterLogging: []LogSetup{
+					{Enabled: aws.Bool(true), Types: []string{"api", "audit"}},
+					{Enabled: aws.Bool(false), Types: []string{"authenticator", "controllerManager", "scheduler"}},
+				}},
+			},
+		},
+		{
+			"outdated endpoint access is updated once logging is up to date",
+			v1alpha2.EKSClusterSpec{EKSClusterParameters: v1alpha2.EKSClusterParameters{
+				EnabledLogTypes:       []v1alpha2.LogType{v1alpha2.LogTypeAPI},
+				EndpointPrivateAccess: aws.Bool(true),
+			}},
+			&UpdateClusterConfigInput{
+				Name:               aws.String("cluster"),
+				ResourcesVpcConfig: &VpcConfigRequest{EndpointPrivateAccess: aws.Bool(true)},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := GenerateUpdateClusterConfigInput("cluster", tc.spec, c)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(UpdateClusterConfigInput{}, Logging{}, LogSetup{}, VpcConfigRequest{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_ClusterConfigRequests(t *testing.T) {
+	testCases := []struct {
+		name    string
+		body    string
+		send    func(c ClusterConfigClient) (interface{}, error)
+		wantReq testRequest
+		want    interface{}
+	}{
+		{
+			"DescribeCluster",
+			`{"cluster":{"name":"cluster","arn":"arn:aws:eks:us-east-1:123456789012:cluster/cluster","status":"ACTIVE","version":"1.14",` +
+				`"certificateAuthority":{"data":"Y2E="},` +
+				`"logging":{"clusterLogging":[{"enabled":true,"types":["api"]},{"enabled":false,"types":["audit"]}]},` +
+				`"resourcesVpcConfig":{"endpointPrivateAccess":true,"endpointPublicAccess":false,"publicAccessCidrs":["10.0.0.0/8"],"subnetIds":["subnet-1"],"vpcId":"vpc-1"}}}`,
+			func(c ClusterConfigClient) (interface{}, error) {
+				return c.DescribeClusterRequest(&DescribeClusterInput{Name: aws.String("cluster")}).Send()
+			},
+			testRequest{Method: "GET", Path: "/clusters/cluster"},
+			&DescribeClusterOutput{Cluster: &ClusterDescription{
+				ARN:                  aws.String("arn:aws:eks:us-east-1:123456789012:cluster/cluster"),
+				CertificateAuthority: &eks.Certificate{Data: aws.String("Y2E=")},
+				Logging: &Logging{ClusterLogging: []LogSetup{
+					{Enabled: aws.Bool(true), Types: []string{"api"}},
+					{Enabled: aws.Bool(false), Types: []string{"audit"}},
+				}},
+				Name: aws.String("cluster"),
+				ResourcesVpcConfig: &VpcConfigResponse{
+					EndpointPrivateAccess: aws.Bool(true),
+					EndpointPublicAccess:  aws.Bool(false),
+					PublicAccessCIDRs:     []string{"10.0.0.0/8"},
+					SubnetIDs:             []string{"subnet-1"},
+					VpcID:                 aws.String("vpc-1"),
+				},
+				Status:  aws.String("ACTIVE"),
+				Version: aws.String("1.14"),
+			}},
+		},
+		{
+			"UpdateClusterVersion",
+			`{"update":{"id":"update-1","status":"InProgress","type":"VersionUpdate"}}`,
+			func(c ClusterConfigClient) (interface{}, error) {
+				return c.UpdateClusterVersionRequest(&UpdateClusterVersionInput{Name: aws.String("cluster"), Version: aws.String("1.15")}).Send()
+			},
+			testRequest{
+				Method: "POST",
+				Path:   "/clusters/cluster/updates",
+				Body:   map[string]interface{}{"version": "1.15"},
+			},
+			&UpdateClusterVersionOutput{Update: &Update{ID: aws.String("update-1"), Status: aws.String(UpdateStatusInProgress), Type: aws.String("VersionUpdate")}},
+		},
+		{
+			"UpdateClusterConfig",
+			`{"update":{"id":"update-1","status":"InProgress","type":"LoggingUpdate"}}`,
+			func(c ClusterConfigClient) (interface{}, error) {
+				return c.UpdateClusterConfigRequest(&UpdateClusterConfigInput{
+					Name: aws.String("cluster"),
+					Logging: &Logging{ClusterLogging: []LogSetup{
+						{Enabled: aws.Bool(true), Types: []string{"api", "audit"}},
+					}},
+					ResourcesVpcConfig: &VpcConfigRequest{EndpointPrivateAccess: aws.Bool(true), PublicAccessCIDRs: []string{"10.0.0.0/8"}},
+				}).Send()
+			},
+			testRequest{
+				Method: "POST",
+				Path:   "/clusters/cluster/update-config",
+				Body: map[string]interface{}{
+					"logging": map[string]interface{}{
+						"clusterLogging": []interface{}{map[string]interface{}{"enabled": true, "types": []interface{}{"api", "audit"}}},
+					},
+					"resourcesVpcConfig": map[string]interface{}{"endpointPrivateAccess": true, "publicAccessCidrs": []interface{}{"10.0.0.0/8"}},
+				},
+			},
+			&UpdateClusterConfigOutput{Update: &Update{ID: aws.String("update-1"), Status: aws.String(UpdateStatusInProgress), Type: aws.String("LoggingUpdate")}},
+		},
+		{
+			"DescribeUpdate",
+			`{"update":{"id":"update-1","status":"Failed","type":"VersionUpdate","errors":[{"errorCode":"InsufficientFreeAddresses","errorMessage":"no addresses","resourceIds":["subnet-1"]}]}}`,
+			func(c ClusterConfigClient) (interface{}, error) {
+				return c.DescribeUpdateRequest(&DescribeUpdateInput{Name: aws.String("cluster"), UpdateID: aws.String("update-1")}).Send()
+			},
+			testRequest{Method: "GET", Path: "/clusters/cluster/updates/update-1"},
+			&DescribeUpdateOutput{Update: &Update{
+				Errors: []ErrorDetail{{ErrorCode: aws.String("InsufficientFreeAddresses"), ErrorMessage: aws.String("no addresses"), ResourceIDs: []string{"subnet-1"}}},
+				ID:     aws.String("update-1"),
+				Status: aws.String(UpdateStatusFailed),
+				Type:   aws.String("VersionUpdate"),
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var req testRequest
+			cfg, stop := testEKSServer(t, tc.body, &req)
+			defer stop()
+
+			c := &clusterConfigClient{eks.New(*cfg)}
+			got, err := tc.send(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.wantReq, req); diff != "" {
+				t.Errorf("request: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(
+				DescribeClusterOutput{}, UpdateClusterVersionOutput{}, UpdateClusterConfigOutput{}, DescribeUpdateOutput{},
+				ClusterDescription{}, eks.Certificate{}, Logging{}, LogSetup{}, VpcConfigResponse{}, Update{}, ErrorDetail{},
+			)); diff != "" {
+				t.Errorf("output: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
@@ -32,6 +32,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/ghodss/yaml"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 
 	awscomputev1alpha2 "github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
 	cfc "github.com/crossplaneio/stack-aws/pkg/clients/cloudformation"
@@ -41,6 +43,14 @@ const (
 	clusterIDHeader                = "x-k8s-aws-id"
 	v1Prefix                       = "k8s-aws-v1."
 	cloudFormationNodeInstanceRole = "NodeInstanceRole"
+
+	// EKS accepts a token for 15 minutes after it was signed. Like
+	// aws-iam-authenticator we consider it expired a minute earlier to allow
+	// for clock skew.
+	tokenValidity = 14 * time.Minute
+
+	authenticatorAPIVersion = "client.authentication.k8s.io/v1alpha1"
+	authenticatorCommand    = "aws-iam-authenticator"
 )
 
 // Cluster crossplane representation of the AWS EKS Cluster
@@ -51,6 +61,11 @@ type Cluster struct {
 	Status   string
 	Endpoint string
 	CA       string
+
+	LogTypes              []string
+	EndpointPublicAccess  bool
+	EndpointPrivateAccess bool
+	PublicAccessCIDRs     []string
 }
 
 // NewCluster returns crossplane representation AWS EKS cluster
@@ -88,15 +103,33 @@ func NewClusterWorkers(workerStackID string, workerStatus cloudformation.StackSt
 	}
 }
 
+// Token crossplane representation of an authentication token for an AWS EKS
+// cluster
+type Token struct {
+	Token      string
+	Expiration time.Time
+}
+
+// ClusterUpdate crossplane representation of an update of an AWS EKS cluster
+type ClusterUpdate struct {
+	ID     string
+	Status string
+	Type   string
+	Errors []string
+}
+
 // Client interface to perform cluster operations
 type Client interface {
 	Create(string, awscomputev1alpha2.EKSClusterSpec) (*Cluster, error)
 	Get(string) (*Cluster, error)
 	Delete(string) error
+	UpdateVersion(name string, version string) (string, error)
+	UpdateConfig(name string, spec awscomputev1alpha2.EKSClusterSpec, cluster *Cluster) (string, error)
+	GetUpdate(name string, updateID string) (*ClusterUpdate, error)
 	CreateWorkerNodes(name string, version string, spec awscomputev1alpha2.EKSClusterSpec) (*ClusterWorkers, error)
 	GetWorkerNodes(stackID string) (*ClusterWorkers, error)
 	DeleteWorkerNodes(stackID string) error
-	ConnectionToken(string) (string, error)
+	ConnectionToken(string) (*Token, error)
 }
 
 // AMIClient the interface for getting AMI images information
@@ -109,12 +142,13 @@ type eksClient struct {
 	amiClient      AMIClient
 	sts            *sts.STS
 	cloudformation cfc.Client
+	clusters       ClusterConfigClient
 }
 
 // NewClient return new instance of the crossplane client for a specific AWS configuration
 func NewClient(config *aws.Config) Client {
 	return &eksClient{eks.New(*config),
-		ec2.New(*config), sts.New(*config), cfc.NewClient(config)}
+		ec2.New(*config), sts.New(*config), cfc.NewClient(config), &clusterConfigClient{eks.New(*config)}}
 }
 
 // Create new EKS cluster
@@ -183,13 +217,48 @@ func (e *eksClient) CreateWorkerNodes(name string, clusterVersion string, spec a
 
 // Get an existing EKS cluster
 func (e *eksClient) Get(name string) (*Cluster, error) {
-	input := &eks.DescribeClusterInput{Name: aws.String(name)}
-	output, err := e.eks.DescribeClusterRequest(input).Send()
+	input := &DescribeClusterInput{Name: aws.String(name)}
+	output, err := e.clusters.DescribeClusterRequest(input).Send()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClusterFromDescription(output.Cluster), err
+}
+
+// UpdateVersion starts an update of the Kubernetes version of an EKS cluster
+// and returns the ID of the update
+func (e *eksClient) UpdateVersion(name string, version string) (string, error) {
+	input := &UpdateClusterVersionInput{Name: aws.String(name), Version: aws.String(version)}
+	output, err := e.clusters.UpdateClusterVersionRequest(input).Send()
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.Update.ID), nil
+}
+
+// UpdateConfig starts an update that brings the configuration of an EKS
+// cluster up to date with the spec and returns the ID of the update
+func (e *eksClient) UpdateConfig(name string, spec awscomputev1alpha2.EKSClusterSpec, cluster *Cluster) (string, error) {
+	input := GenerateUpdateClusterConfigInput(name, spec, cluster)
+	output, err := e.clusters.UpdateClusterConfigRequest(input).Send()
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(output.Update.ID), nil
+}
+
+// GetUpdate of an EKS cluster
+func (e *eksClient) GetUpdate(name string, updateID string) (*ClusterUpdate, error) {
+	input := &DescribeUpdateInput{Name: aws.String(name), UpdateID: aws.String(updateID)}
+	output, err := e.clusters.DescribeUpdateRequest(input).Send()
 	if err != nil {
 		return nil, err
 	}
 
-	return NewCluster(output.Cluster), err
+	return NewClusterUpdate(output.Update), nil
 }
 
 // GetWorkerNodes information about existing cloud formation stack
@@ -225,17 +294,55 @@ func (e *eksClient) DeleteWorkerNodes(stackID string) error {
 }
 
 // ConnectionToken to a cluster
-func (e *eksClient) ConnectionToken(name string) (string, error) {
+func (e *eksClient) ConnectionToken(name string) (*Token, error) {
 	request := e.sts.GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
 	request.HTTPRequest.Header.Add(clusterIDHeader, name)
 
 	// sign the request
+	signedAt := time.Now()
 	presignedURLString, err := request.Presign(60 * time.Second)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	return v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURLString)), nil
+	return &Token{
+		Token:      v1Prefix + base64.RawURLEncoding.EncodeToString([]byte(presignedURLString)),
+		Expiration: signedAt.Add(tokenValidity),
+	}, nil
+}
+
+// GenerateExecKubeconfig returns a kubeconfig for a cluster that obtains
+// tokens by executing aws-iam-authenticator, so that they never go stale.
+func GenerateExecKubeconfig(name string, endpoint string, caData []byte) ([]byte, error) {
+	return yaml.Marshal(clientcmdv1.Config{
+		APIVersion: "v1",
+		Kind:       "Config",
+		Clusters: []clientcmdv1.NamedCluster{{
+			Name: name,
+			Cluster: clientcmdv1.Cluster{
+				Server:                   endpoint,
+				CertificateAuthorityData: caData,
+			},
+		}},
+		AuthInfos: []clientcmdv1.NamedAuthInfo{{
+			Name: name,
+			AuthInfo: clientcmdv1.AuthInfo{
+				Exec: &clientcmdv1.ExecConfig{
+					APIVersion: authenticatorAPIVersion,
+					Command:    authenticatorCommand,
+					Args:       []string{"token", "-i", name},
+				},
+			},
+		}},
+		Contexts: []clientcmdv1.NamedContext{{
+			Name: name,
+			Context: clientcmdv1.Context{
+				Cluster:  name,
+				AuthInfo: name,
+			},
+		}},
+		CurrentContext: name,
+	})
 }
 
 // getAMIImage checks to see if the requested image ID is compatible with the
@@ -321,8 +428,11 @@ func getImageWithID(imgName string, images []*ec2.Image) (*ec2.Image, error) {
 	return nil, errors.New("The specified AMI image name is either invalid or is not available for this cluster version and region")
 }
 
-// IsErrorAlreadyExists helper function
-func IsErrorAlreadyExists(err error) bool {
+// IsErrorResourceInUse returns true if the supplied error indicates that a
+// resource is in use, e.g. because a cluster with the same name already exists
+// or because EKS is still creating or deleting another Fargate profile of the
+// same cluster.
+func IsErrorResourceInUse(err error) bool {
 	return strings.Contains(err.Error(), eks.ErrCodeResourceInUseException)
 }
 
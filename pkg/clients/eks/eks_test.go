@@ -17,13 +17,19 @@ limitations under the License.
 package eks
 
 import (
+	"encoding/json"
+	"io/ioutil"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/defaults"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/ghodss/yaml"
 	"github.com/onsi/gomega"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 )
 
 // MockAMIClient mocks AMI client which is used to get information about AMI images
@@ -190,3 +196,54 @@ func Test_GetAMIImage_InvalidVersion_ReturnsError(t *testing.T) {
 	g.Expect(res).Should(gomega.BeNil())
 	g.Expect(err).ShouldNot(gomega.BeNil())
 }
+
+func Test_GenerateExecKubeconfig(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	res, err := GenerateExecKubeconfig("cluster", "https://endpoint", []byte("ca"))
+	g.Expect(err).Should(gomega.BeNil())
+
+	kubeconfig := clientcmdv1.Config{}
+	g.Expect(yaml.Unmarshal(res, &kubeconfig)).Should(gomega.Succeed())
+	g.Expect(kubeconfig.CurrentContext).Should(gomega.Equal("cluster"))
+	g.Expect(kubeconfig.Clusters).Should(gomega.ConsistOf(clientcmdv1.NamedCluster{
+		Name:    "cluster",
+		Cluster: clientcmdv1.Cluster{Server: "https://endpoint", CertificateAuthorityData: []byte("ca")},
+	}))
+	g.Expect(kubeconfig.AuthInfos).Should(gomega.HaveLen(1))
+	g.Expect(kubeconfig.AuthInfos[0].AuthInfo.Exec).Should(gomega.Equal(&clientcmdv1.ExecConfig{
+		APIVersion: authenticatorAPIVersion,
+		Command:    authenticatorCommand,
+		Args:       []string{"token", "-i", "cluster"},
+	}))
+}
+
+// A testRequest is a request received by a test EKS server.
+type testRequest struct {
+	Method string
+	Path   string
+	Body   map[string]interface{}
+}
+
+// testEKSServer returns an AWS config whose EKS requests are served by a
+// server that records the method, path and JSON body of the request and
+// responds with the supplied JSON body.
+func testEKSServer(t *testing.T, body string, req *testRequest) (*aws.Config, func()) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*req = testRequest{Method: r.Method, Path: r.URL.Path}
+		b, _ := ioutil.ReadAll(r.Body)
+		if len(b) > 0 {
+			if err := json.Unmarshal(b, &req.Body); err != nil {
+				t.Fatal(err)
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+
+	cfg := defaults.Config()
+	cfg.Region = "us-east-1"
+	cfg.Credentials = aws.NewStaticCredentialsProvider("AKID", "SECRET", "")
+	cfg.EndpointResolver = aws.ResolveWithEndpointURL(srv.URL)
+	return &cfg, srv.Close
+}
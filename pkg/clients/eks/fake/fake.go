@@ -26,7 +26,10 @@ type MockEKSClient struct {
 	MockCreate            func(string, v1alpha2.EKSClusterSpec) (*eks.Cluster, error)
 	MockGet               func(string) (*eks.Cluster, error)
 	MockDelete            func(name string) error
-	MockConnectionToken   func(string) (string, error)
+	MockUpdateVersion     func(string, string) (string, error)
+	MockUpdateConfig      func(string, v1alpha2.EKSClusterSpec, *eks.Cluster) (string, error)
+	MockGetUpdate         func(string, string) (*eks.ClusterUpdate, error)
+	MockConnectionToken   func(string) (*eks.Token, error)
 	MockCreateWorkerNodes func(string, string, v1alpha2.EKSClusterSpec) (*eks.ClusterWorkers, error)
 	MockGetWorkerNodes    func(string) (*eks.ClusterWorkers, error)
 	MockDeleteWorkerNodes func(string) error
@@ -47,8 +50,23 @@ func (m *MockEKSClient) Delete(name string) error {
 	return m.MockDelete(name)
 }
 
+// UpdateVersion mock
+func (m *MockEKSClient) UpdateVersion(name string, version string) (string, error) {
+	return m.MockUpdateVersion(name, version)
+}
+
+// UpdateConfig mock
+func (m *MockEKSClient) UpdateConfig(name string, spec v1alpha2.EKSClusterSpec, cluster *eks.Cluster) (string, error) {
+	return m.MockUpdateConfig(name, spec, cluster)
+}
+
+// GetUpdate mock
+func (m *MockEKSClient) GetUpdate(name string, updateID string) (*eks.ClusterUpdate, error) {
+	return m.MockGetUpdate(name, updateID)
+}
+
 // ConnectionToken mock
-func (m *MockEKSClient) ConnectionToken(name string) (string, error) {
+func (m *MockEKSClient) ConnectionToken(name string) (*eks.Token, error) {
 	return m.MockConnectionToken(name)
 }
 
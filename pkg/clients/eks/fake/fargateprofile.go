@@ -0,0 +1,52 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	clientset "github.com/crossplaneio/stack-aws/pkg/clients/eks"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.FargateProfileClient = (*MockFargateProfileClient)(nil)
+
+// MockFargateProfileClient is a type that implements all the methods for FargateProfileClient interface
+type MockFargateProfileClient struct {
+	MockDescribeClusterRequest        func(*clientset.DescribeClusterInput) clientset.DescribeClusterRequest
+	MockCreateFargateProfileRequest   func(*clientset.CreateFargateProfileInput) clientset.CreateFargateProfileRequest
+	MockDescribeFargateProfileRequest func(*clientset.DescribeFargateProfileInput) clientset.DescribeFargateProfileRequest
+	MockDeleteFargateProfileRequest   func(*clientset.DeleteFargateProfileInput) clientset.DeleteFargateProfileRequest
+}
+
+// DescribeClusterRequest mocks DescribeClusterRequest method
+func (m *MockFargateProfileClient) DescribeClusterRequest(input *clientset.DescribeClusterInput) clientset.DescribeClusterRequest {
+	return m.MockDescribeClusterRequest(input)
+}
+
+// CreateFargateProfileRequest mocks CreateFargateProfileRequest method
+func (m *MockFargateProfileClient) CreateFargateProfileRequest(input *clientset.CreateFargateProfileInput) clientset.CreateFargateProfileRequest {
+	return m.MockCreateFargateProfileRequest(input)
+}
+
+// DescribeFargateProfileRequest mocks DescribeFargateProfileRequest method
+func (m *MockFargateProfileClient) DescribeFargateProfileRequest(input *clientset.DescribeFargateProfileInput) clientset.DescribeFargateProfileRequest {
+	return m.MockDescribeFargateProfileRequest(input)
+}
+
+// DeleteFargateProfileRequest mocks DeleteFargateProfileRequest method
+func (m *MockFargateProfileClient) DeleteFargateProfileRequest(input *clientset.DeleteFargateProfileInput) clientset.DeleteFargateProfileRequest {
+	return m.MockDeleteFargateProfileRequest(input)
+}
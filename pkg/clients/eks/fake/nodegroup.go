@@ -0,0 +1,58 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	clientset "github.com/crossplaneio/stack-aws/pkg/clients/eks"
+)
+
+// this ensures that the mock implements the client interface
+var _ clientset.NodeGroupClient = (*MockNodeGroupClient)(nil)
+
+// MockNodeGroupClient is a type that implements all the methods for NodeGroupClient interface
+type MockNodeGroupClient struct {
+	MockCreateNodegroupRequest        func(*clientset.CreateNodegroupInput) clientset.CreateNodegroupRequest
+	MockDescribeNodegroupRequest      func(*clientset.DescribeNodegroupInput) clientset.DescribeNodegroupRequest
+	MockUpdateNodegroupConfigRequest  func(*clientset.UpdateNodegroupConfigInput) clientset.UpdateNodegroupConfigRequest
+	MockUpdateNodegroupVersionRequest func(*clientset.UpdateNodegroupVersionInput) clientset.UpdateNodegroupVersionRequest
+	MockDeleteNodegroupRequest        func(*clientset.DeleteNodegroupInput) clientset.DeleteNodegroupRequest
+}
+
+// CreateNodegroupRequest mocks CreateNodegroupRequest method
+func (m *MockNodeGroupClient) CreateNodegroupRequest(input *clientset.CreateNodegroupInput) clientset.CreateNodegroupRequest {
+	return m.MockCreateNodegroupRequest(input)
+}
+
+// DescribeNodegroupRequest mocks DescribeNodegroupRequest method
+func (m *MockNodeGroupClient) DescribeNodegroupRequest(input *clientset.DescribeNodegroupInput) clientset.DescribeNodegroupRequest {
+	return m.MockDescribeNodegroupRequest(input)
+}
+
+// UpdateNodegroupConfigRequest mocks UpdateNodegroupConfigRequest method
+func (m *MockNodeGroupClient) UpdateNodegroupConfigRequest(input *clientset.UpdateNodegroupConfigInput) clientset.UpdateNodegroupConfigRequest {
+	return m.MockUpdateNodegroupConfigRequest(input)
+}
+
+// UpdateNodegroupVersionRequest mocks UpdateNodegroupVersionRequest method
+func (m *MockNodeGroupClient) UpdateNodegroupVersionRequest(input *clientset.UpdateNodegroupVersionInput) clientset.UpdateNodegroupVersionRequest {
+	return m.MockUpdateNodegroupVersionRequest(input)
+}
+
+// DeleteNodegroupRequest mocks DeleteNodegroupRequest method
+func (m *MockNodeGroupClient) DeleteNodegroupRequest(input *clientset.DeleteNodegroupInput) clientset.DeleteNodegroupRequest {
+	return m.MockDeleteNodegroupRequest(input)
+}
@@ -0,0 +1,219 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+
+	"github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
+)
+
+// The SDK version in use does not model the Fargate profile API, so the
+// operations, their inputs and their outputs are declared here, following
+// the shapes of the EKS API reference.
+
+// FargateProfileSelector describes the pods that are scheduled on Fargate.
+type FargateProfileSelector struct {
+	_ struct{} `type:"structure"`
+
+	Labels    map[string]string `locationName:"labels" type:"map"`
+	Namespace *string           `locationName:"namespace" type:"string"`
+}
+
+// FargateProfile describes a Fargate profile.
+type FargateProfile struct {
+	_ struct{} `type:"structure"`
+
+	ClusterName         *string                  `locationName:"clusterName" type:"string"`
+	CreatedAt           *time.Time               `locationName:"createdAt" type:"timestamp" timestampFormat:"unix"`
+	FargateProfileARN   *string                  `locationName:"fargateProfileArn" type:"string"`
+	FargateProfileName  *string                  `locationName:"fargateProfileName" type:"string"`
+	PodExecutionRoleARN *string                  `locationName:"podExecutionRoleArn" type:"string"`
+	Selectors           []FargateProfileSelector `locationName:"selectors" type:"list"`
+	Status              *string                  `locationName:"status" type:"string"`
+	Subnets             []string                 `locationName:"subnets" type:"list"`
+}
+
+// CreateFargateProfileInput is the input of the CreateFargateProfile
+// operation.
+type CreateFargateProfileInput struct {
+	_ struct{} `type:"structure"`
+
+	ClientRequestToken  *string                  `locationName:"clientRequestToken" type:"string" idempotencyToken:"true"`
+	ClusterName         *string                  `location:"uri" locationName:"name" type:"string" required:"true"`
+	FargateProfileName  *string                  `locationName:"fargateProfileName" type:"string" required:"true"`
+	PodExecutionRoleARN *string                  `locationName:"podExecutionRoleArn" type:"string" required:"true"`
+	Selectors           []FargateProfileSelector `locationName:"selectors" type:"list"`
+	Subnets             []string                 `locationName:"subnets" type:"list"`
+}
+
+// CreateFargateProfileOutput is the output of the CreateFargateProfile
+// operation.
+type CreateFargateProfileOutput struct {
+	_ struct{} `type:"structure"`
+
+	FargateProfile *FargateProfile `locationName:"fargateProfile" type:"structure"`
+}
+
+// CreateFargateProfileRequest is a API request type for the CreateFargateProfile API operation.
+type CreateFargateProfileRequest struct {
+	*aws.Request
+	Input *CreateFargateProfileInput
+}
+
+// Send marshals and sends the CreateFargateProfile API request.
+func (r CreateFargateProfileRequest) Send() (*CreateFargateProfileOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*CreateFargateProfileOutput), nil
+}
+
+// DescribeFargateProfileInput is the input of the DescribeFargateProfile
+// operation.
+type DescribeFargateProfileInput struct {
+	_ struct{} `type:"structure"`
+
+	ClusterName        *string `location:"uri" locationName:"name" type:"string" required:"true"`
+	FargateProfileName *string `location:"uri" locationName:"fargateProfileName" type:"string" required:"true"`
+}
+
+// DescribeFargateProfileOutput is the output of the DescribeFargateProfile
+// operation.
+type DescribeFargateProfileOutput struct {
+	_ struct{} `type:"structure"`
+
+	FargateProfile *FargateProfile `locationName:"fargateProfile" type:"structure"`
+}
+
+// DescribeFargateProfileRequest is a API request type for the DescribeFargateProfile API operation.
+type DescribeFargateProfileRequest struct {
+	*aws.Request
+	Input *DescribeFargateProfileInput
+}
+
+// Send marshals and sends the DescribeFargateProfile API request.
+func (r DescribeFargateProfileRequest) Send() (*DescribeFargateProfileOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DescribeFargateProfileOutput), nil
+}
+
+// DeleteFargateProfileInput is the input of the DeleteFargateProfile
+// operation.
+type DeleteFargateProfileInput struct {
+	_ struct{} `type:"structure"`
+
+	ClusterName        *string `location:"uri" locationName:"name" type:"string" required:"true"`
+	FargateProfileName *string `location:"uri" locationName:"fargateProfileName" type:"string" required:"true"`
+}
+
+// DeleteFargateProfileOutput is the output of the DeleteFargateProfile
+// operation.
+type DeleteFargateProfileOutput struct {
+	_ struct{} `type:"structure"`
+
+	FargateProfile *FargateProfile `locationName:"fargateProfile" type:"structure"`
+}
+
+// DeleteFargateProfileRequest is a API request type for the DeleteFargateProfile API operation.
+type DeleteFargateProfileRequest struct {
+	*aws.Request
+	Input *DeleteFargateProfileInput
+}
+
+// Send marshals and sends the DeleteFargateProfile API request.
+func (r DeleteFargateProfileRequest) Send() (*DeleteFargateProfileOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DeleteFargateProfileOutput), nil
+}
+
+// FargateProfileClient is the external client used for EKSFargateProfile
+// Custom Resource. It describes clusters too, because a Fargate profile can
+// only be created in an active cluster.
+type FargateProfileClient interface {
+	DescribeClusterRequest(input *DescribeClusterInput) DescribeClusterRequest
+	CreateFargateProfileRequest(input *CreateFargateProfileInput) CreateFargateProfileRequest
+	DescribeFargateProfileRequest(input *DescribeFargateProfileInput) DescribeFargateProfileRequest
+	DeleteFargateProfileRequest(input *DeleteFargateProfileInput) DeleteFargateProfileRequest
+}
+
+// fargateProfileClient issues the Fargate profile requests the SDK does not
+// model.
+type fargateProfileClient struct {
+	*clusterConfigClient
+}
+
+// NewFargateProfileClient returns a new client using AWS credentials as JSON encoded data.
+func NewFargateProfileClient(cfg *aws.Config) (FargateProfileClient, error) {
+	return &fargateProfileClient{&clusterConfigClient{eks.New(*cfg)}}, nil
+}
+
+// CreateFargateProfileRequest returns a request to create a Fargate profile.
+func (c *fargateProfileClient) CreateFargateProfileRequest(input *CreateFargateProfileInput) CreateFargateProfileRequest {
+	op := &aws.Operation{Name: "CreateFargateProfile", HTTPMethod: "POST", HTTPPath: "/clusters/{name}/fargate-profiles"}
+	return CreateFargateProfileRequest{Request: c.NewRequest(op, input, &CreateFargateProfileOutput{}), Input: input}
+}
+
+// DescribeFargateProfileRequest returns a request to describe a Fargate
+// profile.
+func (c *fargateProfileClient) DescribeFargateProfileRequest(input *DescribeFargateProfileInput) DescribeFargateProfileRequest {
+	op := &aws.Operation{Name: "DescribeFargateProfile", HTTPMethod: "GET", HTTPPath: "/clusters/{name}/fargate-profiles/{fargateProfileName}"}
+	return DescribeFargateProfileRequest{Request: c.NewRequest(op, input, &DescribeFargateProfileOutput{}), Input: input}
+}
+
+// DeleteFargateProfileRequest returns a request to delete a Fargate profile.
+func (c *fargateProfileClient) DeleteFargateProfileRequest(input *DeleteFargateProfileInput) DeleteFargateProfileRequest {
+	op := &aws.Operation{Name: "DeleteFargateProfile", HTTPMethod: "DELETE", HTTPPath: "/clusters/{name}/fargate-profiles/{fargateProfileName}"}
+	return DeleteFargateProfileRequest{Request: c.NewRequest(op, input, &DeleteFargateProfileOutput{}), Input: input}
+}
+
+// GenerateCreateFargateProfileInput returns the input to create a Fargate
+// profile with the supplied name and parameters. The supplied client request
+// token makes retries of the request idempotent.
+func GenerateCreateFargateProfileInput(name string, p v1alpha2.EKSFargateProfileParameters, clientToken *string) *CreateFargateProfileInput {
+	input := &CreateFargateProfileInput{
+		ClientRequestToken:  clientToken,
+		ClusterName:         aws.String(p.ClusterName),
+		FargateProfileName:  aws.String(name),
+		PodExecutionRoleARN: aws.String(p.PodExecutionRoleARN),
+		Subnets:             p.SubnetIDs,
+	}
+	for _, s := range p.Selectors {
+		input.Selectors = append(input.Selectors, FargateProfileSelector{
+			Labels:    s.Labels,
+			Namespace: aws.String(s.Namespace),
+		})
+	}
+	return input
+}
+
+// GenerateFargateProfileObservation returns the observed state of the
+// supplied Fargate profile.
+func GenerateFargateProfileObservation(fp FargateProfile) v1alpha2.EKSFargateProfileExternalStatus {
+	return v1alpha2.EKSFargateProfileExternalStatus{
+		FargateProfileName: aws.StringValue(fp.FargateProfileName),
+		FargateProfileARN:  aws.StringValue(fp.FargateProfileARN),
+		Status:             aws.StringValue(fp.Status),
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
+)
+
+func Test_GenerateCreateFargateProfileInput(t *testing.T) {
+	p := v1alpha2.EKSFargateProfileParameters{
+		ClusterName:         "cluster",
+		PodExecutionRoleARN: "arn:aws:iam::123456789012:role/pods",
+		SubnetIDs:           []string{"subnet-1", "subnet-2"},
+		Selectors: []v1alpha2.FargateProfileSelector{
+			{Namespace: "default"},
+			{Namespace: "batch", Labels: map[string]string{"fargate": "true"}},
+		},
+	}
+	want := &CreateFargateProfileInput{
+		ClientRequestToken:  aws.String("uid"),
+		ClusterName:         aws.String("cluster"),
+		FargateProfileName:  aws.String("profile"),
+		PodExecutionRoleARN: aws.String("arn:aws:iam::123456789012:role/pods"),
+		Selectors: []FargateProfileSelector{
+			{Namespace: aws.String("default")},
+			{Namespace: aws.String("batch"), Labels: map[string]string{"fargate": "true"}},
+		},
+		Subnets: []string{"subnet-1", "subnet-2"},
+	}
+
+	got := GenerateCreateFargateProfileInput("profile", p, aws.String("uid"))
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(CreateFargateProfileInput{}, FargateProfileSelector{})); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func Test_GenerateFargateProfileObservation(t *testing.T) {
+	fp := FargateProfile{
+		ClusterName:        aws.String("cluster"),
+		FargateProfileARN:  aws.String("arn"),
+		FargateProfileName: aws.String("profile"),
+		Status:             aws.String(v1alpha2.FargateProfileStatusActive),
+	}
+	want := v1alpha2.EKSFargateProfileExternalStatus{
+		FargateProfileName: "profile",
+		FargateProfileARN:  "arn",
+		Status:             v1alpha2.FargateProfileStatusActive,
+	}
+
+	got := GenerateFargateProfileObservation(fp)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func Test_FargateProfileRequests(t *testing.T) {
+	testCases := []struct {
+		name    string
+		body    string
+		send    func(c FargateProfileClient) (interface{}, error)
+		wantReq testRequest
+		want    interface{}
+	}{
+		{
+			"CreateFargateProfile",
+			`{"fargateProfile":{"fargateProfileName":"profile","status":"CREATING","selectors":[{"namespace":"batch","labels":{"fargate":"true"}}]}}`,
+			func(c FargateProfileClient) (interface{}, error) {
+				return c.CreateFargateProfileRequest(GenerateCreateFargateProfileInput("profile", v1alpha2.EKSFargateProfileParameters{
+					ClusterName:         "cluster",
+					PodExecutionRoleARN: "arn:aws:iam::123456789012:role/pods",
+					SubnetIDs:           []string{"subnet-1"},
+					Selectors:           []v1alpha2.FargateProfileSelector{{Namespace: "batch", Labels: map[string]string{"fargate": "true"}}},
+				}, aws.String("uid"))).Send()
+			},
+			testRequest{
+				Method: "POST",
+				Path:   "/clusters/cluster/fargate-profiles",
+				Body: map[string]interface{}{
+					"clientRequestToken":  "uid",
+					"fargateProfileName":  "profile",
+					"podExecutionRoleArn": "arn:aws:iam::123456789012:role/pods",
+					"selectors":           []interface{}{map[string]interface{}{"namespace": "batch", "labels": map[string]interface{}{"fargate": "true"}}},
+					"subnets":             []interface{}{"subnet-1"},
+				},
+			},
+			&CreateFargateProfileOutput{FargateProfile: &FargateProfile{
+				FargateProfileName: aws.String("profile"),
+				Selectors:          []FargateProfileSelector{{Namespace: aws.String("batch"), Labels: map[string]string{"fargate": "true"}}},
+				Status:             aws.String(v1alpha2.FargateProfileStatusCreating),
+			}},
+		},
+		{
+			"DescribeFargateProfile",
+			`{"fargateProfile":{"clusterName":"cluster","fargateProfileArn":"arn:aws:eks:us-east-1:123456789012:fargateprofile/cluster/profile/1","fargateProfileName":"profile","podExecutionRoleArn":"arn:aws:iam::123456789012:role/pods","status":"ACTIVE","subnets":["subnet-1"]}}`,
+			func(c FargateProfileClient) (interface{}, error) {
+				return c.DescribeFargateProfileRequest(&DescribeFargateProfileInput{
+					ClusterName:        aws.String("cluster"),
+					FargateProfileName: aws.String("profile"),
+				}).Send()
+			},
+			testRequest{Method: "GET", Path: "/clusters/cluster/fargate-profiles/profile"},
+			&DescribeFargateProfileOutput{FargateProfile: &FargateProfile{
+				ClusterName:         aws.String("cluster"),
+				FargateProfileARN:   aws.String("arn:aws:eks:us-east-1:123456789012:fargateprofile/cluster/profile/1"),
+				FargateProfileName:  aws.String("profile"),
+				PodExecutionRoleARN: aws.String("arn:aws:iam::123456789012:role/pods"),
+				Status:              aws.String(v1alpha2.FargateProfileStatusActive),
+				Subnets:             []string{"subnet-1"},
+			}},
+		},
+		{
+			"DeleteFargateProfile",
+			`{"fargateProfile":{"fargateProfileName":"profile","status":"DELETING"}}`,
+			func(c FargateProfileClient) (interface{}, error) {
+				return c.DeleteFargateProfileRequest(&DeleteFargateProfileInput{
+					ClusterName:        aws.String("cluster"),
+					FargateProfileName: aws.String("profile"),
+				}).Send()
+			},
+			testRequest{Method: "DELETE", Path: "/clusters/cluster/fargate-profiles/profile"},
+			&DeleteFargateProfileOutput{FargateProfile: &FargateProfile{
+				FargateProfileName: aws.String("profile"),
+				Status:             aws.String(v1alpha2.FargateProfileStatusDeleting),
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var req testRequest
+			cfg, stop := testEKSServer(t, tc.body, &req)
+			defer stop()
+
+			c, _ := NewFargateProfileClient(cfg)
+			got, err := tc.send(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.wantReq, req); diff != "" {
+				t.Errorf("request: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(
+				CreateFargateProfileOutput{}, DescribeFargateProfileOutput{}, DeleteFargateProfileOutput{},
+				FargateProfile{}, FargateProfileSelector{},
+			)); diff != "" {
+				t.Errorf("output: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
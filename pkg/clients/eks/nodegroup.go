@@ -0,0 +1,544 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+
+	"github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
+	awsclients "github.com/crossplaneio/stack-aws/pkg/clients"
+)
+
+// The SDK version in use does not model the managed node group API, so the
+// operations, their inputs and their outputs are declared here, following
+// the shapes of the EKS API reference.
+
+// NodegroupScalingConfig describes the size of the Auto Scaling group of a
+// node group.
+type NodegroupScalingConfig struct {
+	_ struct{} `type:"structure"`
+
+	DesiredSize *int64 `locationName:"desiredSize" type:"integer"`
+	MaxSize     *int64 `locationName:"maxSize" type:"integer"`
+	MinSize     *int64 `locationName:"minSize" type:"integer"`
+}
+
+// Taint describes a Kubernetes taint that is applied to the nodes of a node
+// group.
+type Taint struct {
+	_ struct{} `type:"structure"`
+
+	Effect *string `locationName:"effect" type:"string"`
+	Key    *string `locationName:"key" type:"string"`
+	Value  *string `locationName:"value" type:"string"`
+}
+
+// AutoScalingGroup describes an Auto Scaling group of a node group.
+type AutoScalingGroup struct {
+	_ struct{} `type:"structure"`
+
+	Name *string `locationName:"name" type:"string"`
+}
+
+// NodegroupResources describes the resources of a node group.
+type NodegroupResources struct {
+	_ struct{} `type:"structure"`
+
+	AutoScalingGroups         []AutoScalingGroup `locationName:"autoScalingGroups" type:"list"`
+	RemoteAccessSecurityGroup *string            `locationName:"remoteAccessSecurityGroup" type:"string"`
+}
+
+// Issue describes a health issue of a node group.
+type Issue struct {
+	_ struct{} `type:"structure"`
+
+	Code        *string  `locationName:"code" type:"string"`
+	Message     *string  `locationName:"message" type:"string"`
+	ResourceIDs []string `locationName:"resourceIds" type:"list"`
+}
+
+// NodegroupHealth describes the health of a node group.
+type NodegroupHealth struct {
+	_ struct{} `type:"structure"`
+
+	Issues []Issue `locationName:"issues" type:"list"`
+}
+
+// Nodegroup describes a managed node group.
+type Nodegroup struct {
+	_ struct{} `type:"structure"`
+
+	AMIType        *string                 `locationName:"amiType" type:"string"`
+	ClusterName    *string                 `locationName:"clusterName" type:"string"`
+	CreatedAt      *time.Time              `locationName:"createdAt" type:"timestamp" timestampFormat:"unix"`
+	DiskSize       *int64                  `locationName:"diskSize" type:"integer"`
+	Health         *NodegroupHealth        `locationName:"health" type:"structure"`
+	InstanceTypes  []string                `locationName:"instanceTypes" type:"list"`
+	Labels         map[string]string       `locationName:"labels" type:"map"`
+	ModifiedAt     *time.Time              `locationName:"modifiedAt" type:"timestamp" timestampFormat:"unix"`
+	NodegroupARN   *string                 `locationName:"nodegroupArn" type:"string"`
+	NodegroupName  *string                 `locationName:"nodegroupName" type:"string"`
+	NodeRole       *string                 `locationName:"nodeRole" type:"string"`
+	ReleaseVersion *string                 `locationName:"releaseVersion" type:"string"`
+	Resources      *NodegroupResources     `locationName:"resources" type:"structure"`
+	ScalingConfig  *NodegroupScalingConfig `locationName:"scalingConfig" type:"structure"`
+	Status         *string                 `locationName:"status" type:"string"`
+	Subnets        []string                `locationName:"subnets" type:"list"`
+	Taints         []Taint                 `locationName:"taints" type:"list"`
+	Version        *string                 `locationName:"version" type:"string"`
+}
+
+// ErrorDetail describes an error that caused an update to fail.
+type ErrorDetail struct {
+	_ struct{} `type:"structure"`
+
+	ErrorCode    *string  `locationName:"errorCode" type:"string"`
+	ErrorMessage *string  `locationName:"errorMessage" type:"string"`
+	ResourceIDs  []string `locationName:"resourceIds" type:"list"`
+}
+
+// Update describes an update of a cluster or a node group.
+type Update struct {
+	_ struct{} `type:"structure"`
+
+	Errors []ErrorDetail `locationName:"errors" type:"list"`
+	ID     *string       `locationName:"id" type:"string"`
+	Status *string       `locationName:"status" type:"string"`
+	Type   *string       `locationName:"type" type:"string"`
+}
+
+// CreateNodegroupInput is the input of the CreateNodegroup operation.
+type CreateNodegroupInput struct {
+	_ struct{} `type:"structure"`
+
+	AMIType            *string                 `locationName:"amiType" type:"string"`
+	ClientRequestToken *string                 `locationName:"clientRequestToken" type:"string" idempotencyToken:"true"`
+	ClusterName        *string                 `location:"uri" locationName:"name" type:"string" required:"true"`
+	DiskSize           *int64                  `locationName:"diskSize" type:"integer"`
+	InstanceTypes      []string                `locationName:"instanceTypes" type:"list"`
+	Labels             map[string]string       `locationName:"labels" type:"map"`
+	NodegroupName      *string                 `locationName:"nodegroupName" type:"string" required:"true"`
+	NodeRole           *string                 `locationName:"nodeRole" type:"string" required:"true"`
+	ReleaseVersion     *string                 `locationName:"releaseVersion" type:"string"`
+	ScalingConfig      *NodegroupScalingConfig `locationName:"scalingConfig" type:"structure"`
+	Subnets            []string                `locationName:"subnets" type:"list" required:"true"`
+	Taints             []Taint                 `locationName:"taints" type:"list"`
+	Version            *string                 `locationName:"version" type:"string"`
+}
+
+// CreateNodegroupOutput is the output of the CreateNodegroup operation.
+type CreateNodegroupOutput struct {
+	_ struct{} `type:"structure"`
+
+	Nodegroup *Nodegroup `locationName:"nodegroup" type:"structure"`
+}
+
+// CreateNodegroupRequest is a API request type for the CreateNodegroup API operation.
+type CreateNodegroupRequest struct {
+	*aws.Request
+	Input *CreateNodegroupInput
+}
+
+// Send marshals and sends the CreateNodegroup API request.
+func (r CreateNodegroupRequest) Send() (*CreateNodegroupOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*CreateNodegroupOutput), nil
+}
+
+// DescribeNodegroupInput is the input of the DescribeNodegroup operation.
+type DescribeNodegroupInput struct {
+	_ struct{} `type:"structure"`
+
+	ClusterName   *string `location:"uri" locationName:"name" type:"string" required:"true"`
+	NodegroupName *string `location:"uri" locationName:"nodegroupName" type:"string" required:"true"`
+}
+
+// DescribeNodegroupOutput is the output of the DescribeNodegroup operation.
+type DescribeNodegroupOutput struct {
+	_ struct{} `type:"structure"`
+
+	Nodegroup *Nodegroup `locationName:"nodegroup" type:"structure"`
+}
+
+// DescribeNodegroupRequest is a API request type for the DescribeNodegroup API operation.
+type DescribeNodegroupRequest struct {
+	*aws.Request
+	Input *DescribeNodegroupInput
+}
+
+// Send marshals and sends the DescribeNodegroup API request.
+func (r DescribeNodegroupRequest) Send() (*DescribeNodegroupOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DescribeNodegroupOutput), nil
+}
+
+// UpdateLabelsPayload describes the labels to add to or remove from the
+// nodes of a node group.
+type UpdateLabelsPayload struct {
+	_ struct{} `type:"structure"`
+
+	AddOrUpdateLabels map[string]string `locationName:"addOrUpdateLabels" type:"map"`
+	RemoveLabels      []string          `locationName:"removeLabels" type:"list"`
+}
+
+// UpdateTaintsPayload describes the taints to add to or remove from the nodes
+// of a node group.
+type UpdateTaintsPayload struct {
+	_ struct{} `type:"structure"`
+
+	AddOrUpdateTaints []Taint `locationName:"addOrUpdateTaints" type:"list"`
+	RemoveTaints      []Taint `locationName:"removeTaints" type:"list"`
+}
+
+// UpdateNodegroupConfigInput is the input of the UpdateNodegroupConfig
+// operation.
+type UpdateNodegroupConfigInput struct {
+	_ struct{} `type:"structure"`
+
+	ClusterName   *string                 `location:"uri" locationName:"name" type:"string" required:"true"`
+	Labels        *UpdateLabelsPayload    `locationName:"labels" type:"structure"`
+	NodegroupName *string                 `location:"uri" locationName:"nodegroupName" type:"string" required:"true"`
+	ScalingConfig *NodegroupScalingConfig `locationName:"scalingConfig" type:"structure"`
+	Taints        *UpdateTaintsPayload    `locationName:"taints" type:"structure"`
+}
+
+// UpdateNodegroupConfigOutput is the output of the UpdateNodegroupConfig
+// operation.
+type UpdateNodegroupConfigOutput struct {
+	_ struct{} `type:"structure"`
+
+	Update *Update `locationName:"update" type:"structure"`
+}
+
+// UpdateNodegroupConfigRequest is a API request type for the UpdateNodegroupConfig API operation.
+type UpdateNodegroupConfigRequest struct {
+	*aws.Request
+	Input *UpdateNodegroupConfigInput
+}
+
+// Send marshals and sends the UpdateNodegroupConfig API request.
+func (r UpdateNodegroupConfigRequest) Send() (*UpdateNodegroupConfigOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*UpdateNodegroupConfigOutput), nil
+}
+
+// UpdateNodegroupVersionInput is the input of the UpdateNodegroupVersion
+// operation.
+type UpdateNodegroupVersionInput struct {
+	_ struct{} `type:"structure"`
+
+	ClusterName    *string `location:"uri" locationName:"name" type:"string" required:"true"`
+	NodegroupName  *string `location:"uri" locationName:"nodegroupName" type:"string" required:"true"`
+	ReleaseVersion *string `locationName:"releaseVersion" type:"string"`
+	Version        *string `locationName:"version" type:"string"`
+}
+
+// UpdateNodegroupVersionOutput is the output of the UpdateNodegroupVersion
+// operation.
+type UpdateNodegroupVersionOutput struct {
+	_ struct{} `type:"structure"`
+
+	Update *Update `locationName:"update" type:"structure"`
+}
+
+// UpdateNodegroupVersionRequest is a API request type for the UpdateNodegroupVersion API operation.
+type UpdateNodegroupVersionRequest struct {
+	*aws.Request
+	Input *UpdateNodegroupVersionInput
+}
+
+// Send marshals and sends the UpdateNodegroupVersion API request.
+func (r UpdateNodegroupVersionRequest) Send() (*UpdateNodegroupVersionOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*UpdateNodegroupVersionOutput), nil
+}
+
+// DeleteNodegroupInput is the input of the DeleteNodegroup operation.
+type DeleteNodegroupInput struct {
+	_ struct{} `type:"structure"`
+
+	ClusterName   *string `location:"uri" locationName:"name" type:"string" required:"true"`
+	NodegroupName *string `location:"uri" locationName:"nodegroupName" type:"string" required:"true"`
+}
+
+// DeleteNodegroupOutput is the output of the DeleteNodegroup operation.
+type DeleteNodegroupOutput struct {
+	_ struct{} `type:"structure"`
+
+	Nodegroup *Nodegroup `locationName:"nodegroup" type:"structure"`
+}
+
+// DeleteNodegroupRequest is a API request type for the DeleteNodegroup API operation.
+type DeleteNodegroupRequest struct {
+	*aws.Request
+	Input *DeleteNodegroupInput
+}
+
+// Send marshals and sends the DeleteNodegroup API request.
+func (r DeleteNodegroupRequest) Send() (*DeleteNodegroupOutput, error) {
+	if err := r.Request.Send(); err != nil {
+		return nil, err
+	}
+	return r.Request.Data.(*DeleteNodegroupOutput), nil
+}
+
+// NodeGroupClient is the external client used for NodeGroup Custom Resource
+type NodeGroupClient interface {
+	CreateNodegroupRequest(input *CreateNodegroupInput) CreateNodegroupRequest
+	DescribeNodegroupRequest(input *DescribeNodegroupInput) DescribeNodegroupRequest
+	UpdateNodegroupConfigRequest(input *UpdateNodegroupConfigInput) UpdateNodegroupConfigRequest
+	UpdateNodegroupVersionRequest(input *UpdateNodegroupVersionInput) UpdateNodegroupVersionRequest
+	DeleteNodegroupRequest(input *DeleteNodegroupInput) DeleteNodegroupRequest
+}
+
+// nodeGroupClient issues the managed node group requests the SDK does not
+// model.
+type nodeGroupClient struct {
+	*eks.EKS
+}
+
+// NewNodeGroupClient returns a new client using AWS credentials as JSON encoded data.
+func NewNodeGroupClient(cfg *aws.Config) (NodeGroupClient, error) {
+	return &nodeGroupClient{eks.New(*cfg)}, nil
+}
+
+// CreateNodegroupRequest returns a request to create a managed node group.
+func (c *nodeGroupClient) CreateNodegroupRequest(input *CreateNodegroupInput) CreateNodegroupRequest {
+	op := &aws.Operation{Name: "CreateNodegroup", HTTPMethod: "POST", HTTPPath: "/clusters/{name}/node-groups"}
+	return CreateNodegroupRequest{Request: c.NewRequest(op, input, &CreateNodegroupOutput{}), Input: input}
+}
+
+// DescribeNodegroupRequest returns a request to describe a managed node group.
+func (c *nodeGroupClient) DescribeNodegroupRequest(input *DescribeNodegroupInput) DescribeNodegroupRequest {
+	op := &aws.Operation{Name: "DescribeNodegroup", HTTPMethod: "GET", HTTPPath: "/clusters/{name}/node-groups/{nodegroupName}"}
+	return DescribeNodegroupRequest{Request: c.NewRequest(op, input, &DescribeNodegroupOutput{}), Input: input}
+}
+
+// UpdateNodegroupConfigRequest returns a request to update the scaling
+// configuration, labels and taints of a managed node group.
+func (c *nodeGroupClient) UpdateNodegroupConfigRequest(input *UpdateNodegroupConfigInput) UpdateNodegroupConfigRequest {
+	op := &aws.Operation{Name: "UpdateNodegroupConfig", HTTPMethod: "POST", HTTPPath: "/clusters/{name}/node-groups/{nodegroupName}/update-config"}
+	return UpdateNodegroupConfigRequest{Request: c.NewRequest(op, input, &UpdateNodegroupConfigOutput{}), Input: input}
+}
+
+// UpdateNodegroupVersionRequest returns a request to update the Kubernetes
+// version or AMI release version of a managed node group.
+func (c *nodeGroupClient) UpdateNodegroupVersionRequest(input *UpdateNodegroupVersionInput) UpdateNodegroupVersionRequest {
+	op := &aws.Operation{Name: "UpdateNodegroupVersion", HTTPMethod: "POST", HTTPPath: "/clusters/{name}/node-groups/{nodegroupName}/update-version"}
+	return UpdateNodegroupVersionRequest{Request: c.NewRequest(op, input, &UpdateNodegroupVersionOutput{}), Input: input}
+}
+
+// DeleteNodegroupRequest returns a request to delete a managed node group.
+func (c *nodeGroupClient) DeleteNodegroupRequest(input *DeleteNodegroupInput) DeleteNodegroupRequest {
+	op := &aws.Operation{Name: "DeleteNodegroup", HTTPMethod: "DELETE", HTTPPath: "/clusters/{name}/node-groups/{nodegroupName}"}
+	return DeleteNodegroupRequest{Request: c.NewRequest(op, input, &DeleteNodegroupOutput{}), Input: input}
+}
+
+// GenerateCreateNodegroupInput returns the input to create a managed node
+// group with the supplied name and parameters. The supplied client request
+// token makes retries of the request idempotent.
+func GenerateCreateNodegroupInput(name string, p v1alpha2.NodeGroupParameters, clientToken *string) *CreateNodegroupInput {
+	input := &CreateNodegroupInput{
+		AMIType:            awsclients.String(p.AMIType),
+		ClientRequestToken: clientToken,
+		ClusterName:        aws.String(p.ClusterName),
+		DiskSize:           awsclients.Int64Address(p.DiskSize),
+		InstanceTypes:      p.InstanceTypes,
+		Labels:             p.Labels,
+		NodegroupName:      aws.String(name),
+		NodeRole:           aws.String(p.NodeRoleARN),
+		ReleaseVersion:     awsclients.String(p.ReleaseVersion),
+		Subnets:            p.SubnetIDs,
+		Taints:             generateTaints(p.Taints),
+		Version:            awsclients.String(p.Version),
+	}
+	if p.ScalingConfig != nil {
+		input.ScalingConfig = &NodegroupScalingConfig{
+			DesiredSize: awsclients.Int64Address(p.ScalingConfig.DesiredSize),
+			MaxSize:     awsclients.Int64Address(p.ScalingConfig.MaxSize),
+			MinSize:     awsclients.Int64Address(p.ScalingConfig.MinSize),
+		}
+	}
+	return input
+}
+
+// GenerateNodeGroupObservation returns the observed state of the supplied
+// managed node group.
+func GenerateNodeGroupObservation(ng Nodegroup) v1alpha2.NodeGroupExternalStatus {
+	o := v1alpha2.NodeGroupExternalStatus{
+		NodeGroupName:  aws.StringValue(ng.NodegroupName),
+		NodeGroupARN:   aws.StringValue(ng.NodegroupARN),
+		Status:         aws.StringValue(ng.Status),
+		Version:        aws.StringValue(ng.Version),
+		ReleaseVersion: aws.StringValue(ng.ReleaseVersion),
+	}
+	if ng.Resources != nil {
+		for _, asg := range ng.Resources.AutoScalingGroups {
+			o.AutoScalingGroupNames = append(o.AutoScalingGroupNames, aws.StringValue(asg.Name))
+		}
+	}
+	if ng.Health != nil {
+		for _, i := range ng.Health.Issues {
+			o.HealthIssues = append(o.HealthIssues, aws.StringValue(i.Code)+": "+aws.StringValue(i.Message))
+		}
+	}
+	return o
+}
+
+// IsNodeGroupUpToDate returns true if the supplied managed node group matches
+// the supplied parameters.
+func IsNodeGroupUpToDate(p v1alpha2.NodeGroupParameters, ng Nodegroup) bool {
+	return IsNodeGroupConfigUpToDate(p, ng) && IsNodeGroupVersionUpToDate(p, ng)
+}
+
+// IsNodeGroupConfigUpToDate returns true if the scaling configuration, labels
+// and taints of the supplied managed node group match the supplied parameters.
+func IsNodeGroupConfigUpToDate(p v1alpha2.NodeGroupParameters, ng Nodegroup) bool {
+	u := GenerateUpdateNodegroupConfigInput(p, ng)
+	return u.ScalingConfig == nil && u.Labels == nil && u.Taints == nil
+}
+
+// IsNodeGroupVersionUpToDate returns true if the Kubernetes version and AMI
+// release version of the supplied managed node group match the supplied
+// parameters. Versions that aren't specified are considered up to date.
+func IsNodeGroupVersionUpToDate(p v1alpha2.NodeGroupParameters, ng Nodegroup) bool {
+	if p.Version != "" && p.Version != aws.StringValue(ng.Version) {
+		return false
+	}
+	return p.ReleaseVersion == "" || p.ReleaseVersion == aws.StringValue(ng.ReleaseVersion)
+}
+
+// GenerateUpdateNodegroupConfigInput returns the input to bring the scaling
+// configuration, labels and taints of the supplied managed node group in line
+// with the supplied parameters. Only the parts that need to be changed are
+// set; the cluster and node group names are left to the caller.
+func GenerateUpdateNodegroupConfigInput(p v1alpha2.NodeGroupParameters, ng Nodegroup) *UpdateNodegroupConfigInput {
+	input := &UpdateNodegroupConfigInput{}
+
+	if p.ScalingConfig != nil && !isScalingConfigUpToDate(*p.ScalingConfig, ng.ScalingConfig) {
+		input.ScalingConfig = &NodegroupScalingConfig{
+			DesiredSize: awsclients.Int64Address(p.ScalingConfig.DesiredSize),
+			MaxSize:     awsclients.Int64Address(p.ScalingConfig.MaxSize),
+			MinSize:     awsclients.Int64Address(p.ScalingConfig.MinSize),
+		}
+	}
+
+	input.Labels = generateUpdateLabelsPayload(p.Labels, ng.Labels)
+	input.Taints = generateUpdateTaintsPayload(p.Taints, ng.Taints)
+
+	return input
+}
+
+// isScalingConfigUpToDate returns true if the sizes that are set in the
+// supplied scaling configuration match the observed ones.
+func isScalingConfigUpToDate(sc v1alpha2.NodeGroupScalingConfig, o *NodegroupScalingConfig) bool {
+	if o == nil {
+		o = &NodegroupScalingConfig{}
+	}
+	for _, s := range []struct {
+		desired  *int
+		observed *int64
+	}{
+		{sc.DesiredSize, o.DesiredSize},
+		{sc.MaxSize, o.MaxSize},
+		{sc.MinSize, o.MinSize},
+	} {
+		if s.desired != nil && int64(*s.desired) != aws.Int64Value(s.observed) {
+			return false
+		}
+	}
+	return true
+}
+
+// generateUpdateLabelsPayload returns the changes that turn the observed
+// labels into the desired ones, or nil if there are none.
+func generateUpdateLabelsPayload(desired, observed map[string]string) *UpdateLabelsPayload {
+	labels := &UpdateLabelsPayload{}
+	for k, v := range desired {
+		if ov, ok := observed[k]; !ok || ov != v {
+			if labels.AddOrUpdateLabels == nil {
+				labels.AddOrUpdateLabels = map[string]string{}
+			}
+			labels.AddOrUpdateLabels[k] = v
+		}
+	}
+	for k := range observed {
+		if _, ok := desired[k]; !ok {
+			labels.RemoveLabels = append(labels.RemoveLabels, k)
+		}
+	}
+	if labels.AddOrUpdateLabels == nil && labels.RemoveLabels == nil {
+		return nil
+	}
+	sort.Strings(labels.RemoveLabels)
+	return labels
+}
+
+// generateUpdateTaintsPayload returns the changes that turn the observed
+// taints into the desired ones, or nil if there are none.
+func generateUpdateTaintsPayload(desired []v1alpha2.NodeGroupTaint, observed []Taint) *UpdateTaintsPayload {
+	// a node can only have one taint with a given key and effect, so that's
+	// what identifies a taint.
+	o := map[v1alpha2.NodeGroupTaint]bool{}
+	for _, t := range observed {
+		o[v1alpha2.NodeGroupTaint{Key: aws.StringValue(t.Key), Value: aws.StringValue(t.Value), Effect: aws.StringValue(t.Effect)}] = true
+	}
+	d := map[[2]string]bool{}
+	taints := &UpdateTaintsPayload{}
+	for _, t := range desired {
+		d[[2]string{t.Key, t.Effect}] = true
+		if !o[t] {
+			taints.AddOrUpdateTaints = append(taints.AddOrUpdateTaints, generateTaints([]v1alpha2.NodeGroupTaint{t})...)
+		}
+	}
+	for _, t := range observed {
+		if !d[[2]string{aws.StringValue(t.Key), aws.StringValue(t.Effect)}] {
+			taints.RemoveTaints = append(taints.RemoveTaints, t)
+		}
+	}
+	if taints.AddOrUpdateTaints == nil && taints.RemoveTaints == nil {
+		return nil
+	}
+	return taints
+}
+
+func generateTaints(in []v1alpha2.NodeGroupTaint) []Taint {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]Taint, len(in))
+	for i, t := range in {
+		out[i] = Taint{
+			Effect: aws.String(t.Effect),
+			Key:    aws.String(t.Key),
+			Value:  awsclients.String(t.Value),
+		}
+	}
+	return out
+}
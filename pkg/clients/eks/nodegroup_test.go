@@ -0,0 +1,325 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
+)
+
+func intAddress(i int) *int { return &i }
+
+func Test_GenerateCreateNodegroupInput(t *testing.T) {
+	p := v1alpha2.NodeGroupParameters{
+		ClusterName:   "cluster",
+		NodeRoleARN:   "arn:aws:iam::123456789012:role/node",
+		SubnetIDs:     []string{"subnet-1", "subnet-2"},
+		ScalingConfig: &v1alpha2.NodeGroupScalingConfig{MaxSize: intAddress(3)},
+		InstanceTypes: []string{"m5.large"},
+		DiskSize:      intAddress(50),
+		Labels:        map[string]string{"pool": "general"},
+		Taints:        []v1alpha2.NodeGroupTaint{{Key: "dedicated", Effect: "NO_SCHEDULE"}},
+	}
+	want := &CreateNodegroupInput{
+		ClientRequestToken: aws.String("uid"),
+		ClusterName:        aws.String("cluster"),
+		DiskSize:           aws.Int64(50),
+		InstanceTypes:      []string{"m5.large"},
+		Labels:             map[string]string{"pool": "general"},
+		NodegroupName:      aws.String("workers"),
+		NodeRole:           aws.String("arn:aws:iam::123456789012:role/node"),
+		ScalingConfig:      &NodegroupScalingConfig{MaxSize: aws.Int64(3)},
+		Subnets:            []string{"subnet-1", "subnet-2"},
+		Taints:             []Taint{{Key: aws.String("dedicated"), Effect: aws.String("NO_SCHEDULE")}},
+	}
+
+	got := GenerateCreateNodegroupInput("workers", p, aws.String("uid"))
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreUnexported(CreateNodegroupInput{}, NodegroupScalingConfig{}, Taint{})); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func Test_GenerateUpdateNodegroupConfigInput(t *testing.T) {
+	ng := Nodegroup{
+		ScalingConfig: &NodegroupScalingConfig{DesiredSize: aws.Int64(2), MaxSize: aws.Int64(3), MinSize: aws.Int64(1)},
+		Labels:        map[string]string{"pool": "general", "team": "a"},
+		Taints: []Taint{
+			{Key: aws.String("dedicated"), Value: aws.String("a"), Effect: aws.String("NO_SCHEDULE")},
+			{Key: aws.String("spot"), Effect: aws.String("PREFER_NO_SCHEDULE")},
+		},
+	}
+	params := v1alpha2.NodeGroupParameters{
+		ScalingConfig: &v1alpha2.NodeGroupScalingConfig{DesiredSize: intAddress(2)},
+		Labels:        map[string]string{"pool": "general", "team": "a"},
+		Taints: []v1alpha2.NodeGroupTaint{
+			{Key: "dedicated", Value: "a", Effect: "NO_SCHEDULE"},
+			{Key: "spot", Effect: "PREFER_NO_SCHEDULE"},
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		params func(p v1alpha2.NodeGroupParameters) v1alpha2.NodeGroupParameters
+		want   *UpdateNodegroupConfigInput
+	}{
+		{
+			"matching parameters need no changes",
+			func(p v1alpha2.NodeGroupParameters) v1alpha2.NodeGroupParameters { return p },
+			&UpdateNodegroupConfigInput{},
+		},
+		{
+			"a different size updates the scaling config",
+			func(p v1alpha2.NodeGroupParameters) v1alpha2.NodeGroupParameters {
+				p.ScalingConfig = &v1alpha2.NodeGroupScalingConfig{DesiredSize: intAddress(3)}
+				return p
+			},
+			&UpdateNodegroupConfigInput{ScalingConfig: &NodegroupScalingConfig{DesiredSize: aws.Int64(3)}},
+		},
+		{
+			"an unspecified scaling config is left alone",
+			func(p v1alpha2.NodeGroupParameters) v1alpha2.NodeGroupParameters { p.ScalingConfig = nil; return p },
+			&UpdateNodegroupConfigInput{},
+		},
+		{
+			"changed labels are updated and undesired ones removed",
+			func(p v1alpha2.NodeGroupParameters) v1alpha2.NodeGroupParameters {
+				p.Labels = map[string]string{"pool": "batch"}
+				return p
+			},
+			&UpdateNodegroupConfigInput{Labels: &UpdateLabelsPayload{
+				AddOrUpdateLabels: map[string]string{"pool": "batch"},
+				RemoveLabels:      []string{"team"},
+			}},
+		},
+		{
+			"changed taints are updated and undesired ones removed",
+			func(p v1alpha2.NodeGroupParameters) v1alpha2.NodeGroupParameters {
+				p.Taints = []v1alpha2.NodeGroupTaint{{Key: "dedicated", Value: "b", Effect: "NO_SCHEDULE"}}
+				return p
+			},
+			&UpdateNodegroupConfigInput{Taints: &UpdateTaintsPayload{
+				AddOrUpdateTaints: []Taint{{Key: aws.String("dedicated"), Value: aws.String("b"), Effect: aws.String("NO_SCHEDULE")}},
+				RemoveTaints:      []Taint{{Key: aws.String("spot"), Effect: aws.String("PREFER_NO_SCHEDULE")}},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := GenerateUpdateNodegroupConfigInput(tc.params(*params.DeepCopy()), ng)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(UpdateNodegroupConfigInput{}, NodegroupScalingConfig{}, UpdateLabelsPayload{}, UpdateTaintsPayload{}, Taint{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_IsNodeGroupVersionUpToDate(t *testing.T) {
+	ng := Nodegroup{Version: aws.String("1.14"), ReleaseVersion: aws.String("1.14.7-20190927")}
+
+	testCases := []struct {
+		name   string
+		params v1alpha2.NodeGroupParameters
+		want   bool
+	}{
+		{"unspecified versions are up to date", v1alpha2.NodeGroupParameters{}, true},
+		{"matching versions are up to date", v1alpha2.NodeGroupParameters{Version: "1.14", ReleaseVersion: "1.14.7-20190927"}, true},
+		{"a different version is not up to date", v1alpha2.NodeGroupParameters{Version: "1.15"}, false},
+		{"a different release version is not up to date", v1alpha2.NodeGroupParameters{ReleaseVersion: "1.14.8-20191213"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := IsNodeGroupVersionUpToDate(tc.params, ng)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_GenerateNodeGroupObservation(t *testing.T) {
+	ng := Nodegroup{
+		NodegroupName: aws.String("workers"),
+		NodegroupARN:  aws.String("arn:aws:eks:us-west-2:123456789012:nodegroup/cluster/workers/1"),
+		Status:        aws.String(v1alpha2.NodeGroupStatusDegraded),
+		Version:       aws.String("1.14"),
+		Resources:     &NodegroupResources{AutoScalingGroups: []AutoScalingGroup{{Name: aws.String("eks-1")}}},
+		Health:        &NodegroupHealth{Issues: []Issue{{Code: aws.String("AsgInstanceLaunchFailures"), Message: aws.String("no capacity")}}},
+	}
+	want := v1alpha2.NodeGroupExternalStatus{
+		NodeGroupName:         "workers",
+		NodeGroupARN:          "arn:aws:eks:us-west-2:123456789012:nodegroup/cluster/workers/1",
+		Status:                v1alpha2.NodeGroupStatusDegraded,
+		Version:               "1.14",
+		AutoScalingGroupNames: []string{"eks-1"},
+		HealthIssues:          []string{"AsgInstanceLaunchFailures: no capacity"},
+	}
+
+	got := GenerateNodeGroupObservation(ng)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func Test_NodeGroupRequests(t *testing.T) {
+	testCases := []struct {
+		name    string
+		body    string
+		send    func(c NodeGroupClient) (interface{}, error)
+		wantReq testRequest
+		want    interface{}
+	}{
+		{
+			"CreateNodegroup",
+			`{"nodegroup":{"nodegroupName":"workers","status":"CREATING","scalingConfig":{"maxSize":3}}}`,
+			func(c NodeGroupClient) (interface{}, error) {
+				return c.CreateNodegroupRequest(GenerateCreateNodegroupInput("workers", v1alpha2.NodeGroupParameters{
+					ClusterName:   "cluster",
+					NodeRoleARN:   "arn:aws:iam::123456789012:role/node",
+					SubnetIDs:     []string{"subnet-1"},
+					ScalingConfig: &v1alpha2.NodeGroupScalingConfig{MaxSize: intAddress(3)},
+					DiskSize:      intAddress(50),
+					Labels:        map[string]string{"pool": "general"},
+					Taints:        []v1alpha2.NodeGroupTaint{{Key: "dedicated", Effect: "NO_SCHEDULE"}},
+				}, aws.String("uid"))).Send()
+			},
+			testRequest{
+				Method: "POST",
+				Path:   "/clusters/cluster/node-groups",
+				Body: map[string]interface{}{
+					"clientRequestToken": "uid",
+					"diskSize":           float64(50),
+					"labels":             map[string]interface{}{"pool": "general"},
+					"nodegroupName":      "workers",
+					"nodeRole":           "arn:aws:iam::123456789012:role/node",
+					"scalingConfig":      map[string]interface{}{"maxSize": float64(3)},
+					"subnets":            []interface{}{"subnet-1"},
+					"taints":             []interface{}{map[string]interface{}{"key": "dedicated", "effect": "NO_SCHEDULE"}},
+				},
+			},
+			&CreateNodegroupOutput{Nodegroup: &Nodegroup{
+				NodegroupName: aws.String("workers"),
+				ScalingConfig: &NodegroupScalingConfig{MaxSize: aws.Int64(3)},
+				Status:        aws.String(v1alpha2.NodeGroupStatusCreating),
+			}},
+		},
+		{
+			"DescribeNodegroup",
+			`{"nodegroup":{"nodegroupName":"workers","status":"ACTIVE","resources":{"autoScalingGroups":[{"name":"eks-1"}]},"health":{"issues":[{"code":"AsgInstanceLaunchFailures","message":"no capacity","resourceIds":["eks-1"]}]}}}`,
+			func(c NodeGroupClient) (interface{}, error) {
+				return c.DescribeNodegroupRequest(&DescribeNodegroupInput{
+					ClusterName:   aws.String("cluster"),
+					NodegroupName: aws.String("workers"),
+				}).Send()
+			},
+			testRequest{Method: "GET", Path: "/clusters/cluster/node-groups/workers"},
+			&DescribeNodegroupOutput{Nodegroup: &Nodegroup{
+				Health:        &NodegroupHealth{Issues: []Issue{{Code: aws.String("AsgInstanceLaunchFailures"), Message: aws.String("no capacity"), ResourceIDs: []string{"eks-1"}}}},
+				NodegroupName: aws.String("workers"),
+				Resources:     &NodegroupResources{AutoScalingGroups: []AutoScalingGroup{{Name: aws.String("eks-1")}}},
+				Status:        aws.String(v1alpha2.NodeGroupStatusActive),
+			}},
+		},
+		{
+			"UpdateNodegroupConfig",
+			`{"update":{"id":"update-1","status":"InProgress","type":"ConfigUpdate"}}`,
+			func(c NodeGroupClient) (interface{}, error) {
+				return c.UpdateNodegroupConfigRequest(&UpdateNodegroupConfigInput{
+					ClusterName:   aws.String("cluster"),
+					NodegroupName: aws.String("workers"),
+					Labels:        &UpdateLabelsPayload{AddOrUpdateLabels: map[string]string{"pool": "general"}, RemoveLabels: []string{"old"}},
+					ScalingConfig: &NodegroupScalingConfig{DesiredSize: aws.Int64(2)},
+					Taints:        &UpdateTaintsPayload{RemoveTaints: []Taint{{Key: aws.String("dedicated"), Effect: aws.String("NO_SCHEDULE")}}},
+				}).Send()
+			},
+			testRequest{
+				Method: "POST",
+				Path:   "/clusters/cluster/node-groups/workers/update-config",
+				Body: map[string]interface{}{
+					"labels": map[string]interface{}{
+						"addOrUpdateLabels": map[string]interface{}{"pool": "general"},
+						"removeLabels":      []interface{}{"old"},
+					},
+					"scalingConfig": map[string]interface{}{"desiredSize": float64(2)},
+					"taints": map[string]interface{}{
+						"removeTaints": []interface{}{map[string]interface{}{"key": "dedicated", "effect": "NO_SCHEDULE"}},
+					},
+				},
+			},
+			&UpdateNodegroupConfigOutput{Update: &Update{ID: aws.String("update-1"), Status: aws.String("InProgress"), Type: aws.String("ConfigUpdate")}},
+		},
+		{
+			"UpdateNodegroupVersion",
+			`{"update":{"id":"update-1","status":"InProgress","type":"VersionUpdate"}}`,
+			func(c NodeGroupClient) (interface{}, error) {
+				return c.UpdateNodegroupVersionRequest(&UpdateNodegroupVersionInput{
+					ClusterName:    aws.String("cluster"),
+					NodegroupName:  aws.String("workers"),
+					ReleaseVersion: aws.String("1.15.10-20200228"),
+					Version:        aws.String("1.15"),
+				}).Send()
+			},
+			testRequest{
+				Method: "POST",
+				Path:   "/clusters/cluster/node-groups/workers/update-version",
+				Body:   map[string]interface{}{"releaseVersion": "1.15.10-20200228", "version": "1.15"},
+			},
+			&UpdateNodegroupVersionOutput{Update: &Update{ID: aws.String("update-1"), Status: aws.String("InProgress"), Type: aws.String("VersionUpdate")}},
+		},
+		{
+			"DeleteNodegroup",
+			`{"nodegroup":{"nodegroupName":"workers","status":"DELETING"}}`,
+			func(c NodeGroupClient) (interface{}, error) {
+				return c.DeleteNodegroupRequest(&DeleteNodegroupInput{
+					ClusterName:   aws.String("cluster"),
+					NodegroupName: aws.String("workers"),
+				}).Send()
+			},
+			testRequest{Method: "DELETE", Path: "/clusters/cluster/node-groups/workers"},
+			&DeleteNodegroupOutput{Nodegroup: &Nodegroup{NodegroupName: aws.String("workers"), Status: aws.String(v1alpha2.NodeGroupStatusDeleting)}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var req testRequest
+			cfg, stop := testEKSServer(t, tc.body, &req)
+			defer stop()
+
+			c, _ := NewNodeGroupClient(cfg)
+			got, err := tc.send(c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.wantReq, req); diff != "" {
+				t.Errorf("request: -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(
+				CreateNodegroupOutput{}, DescribeNodegroupOutput{}, UpdateNodegroupConfigOutput{}, UpdateNodegroupVersionOutput{}, DeleteNodegroupOutput{},
+				Nodegroup{}, NodegroupScalingConfig{}, NodegroupResources{}, AutoScalingGroup{}, NodegroupHealth{}, Issue{}, Update{},
+			)); diff != "" {
+				t.Errorf("output: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
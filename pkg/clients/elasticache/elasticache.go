@@ -26,7 +26,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache/elasticacheiface"
-	"github.com/pkg/errors"
 
 	"github.com/crossplaneio/stack-aws/apis/cache/v1beta1"
 	clients "github.com/crossplaneio/stack-aws/pkg/clients"
@@ -36,13 +35,9 @@ import (
 // compatible with the upstream AWS redis client.
 type Client elasticacheiface.ElastiCacheAPI
 
-// NewClient returns a new ElastiCache client. Credentials must be passed as
-// JSON encoded data.
-func NewClient(credentials []byte, region string) (Client, error) {
-	cfg, err := clients.LoadConfig(credentials, clients.DefaultSection, region)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot create new AWS configuration")
-	}
+// NewClient returns a new ElastiCache client using the supplied AWS
+// configuration.
+func NewClient(cfg *aws.Config) (Client, error) {
 	return elasticache.New(*cfg), nil
 }
 
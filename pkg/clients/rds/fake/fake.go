@@ -25,6 +25,7 @@ import (
 type MockRDSClient struct {
 	MockGetInstance    func(string) (*rds.Instance, error)
 	MockCreateInstance func(string, string, *v1alpha2.RDSInstanceSpec) (*rds.Instance, error)
+	MockModifyInstance func(string, *v1alpha2.RDSInstanceSpec) (*rds.Instance, error)
 	MockDeleteInstance func(name string) (*rds.Instance, error)
 }
 
@@ -38,6 +39,11 @@ func (m *MockRDSClient) CreateInstance(name, password string, spec *v1alpha2.RDS
 	return m.MockCreateInstance(name, password, spec)
 }
 
+// ModifyInstance modifies RDS Instance with provided Specification
+func (m *MockRDSClient) ModifyInstance(name string, spec *v1alpha2.RDSInstanceSpec) (*rds.Instance, error) {
+	return m.MockModifyInstance(name, spec)
+}
+
 // DeleteInstance deletes RDS Instance
 func (m *MockRDSClient) DeleteInstance(name string) (*rds.Instance, error) {
 	return m.MockDeleteInstance(name)
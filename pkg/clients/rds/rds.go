@@ -18,6 +18,8 @@ package rds
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -33,6 +35,15 @@ type Instance struct {
 	ARN      string
 	Status   string
 	Endpoint string
+
+	// Class, Size and EngineVersion are the values a pending modification
+	// will apply, if any, rather than the current ones. Modifications that
+	// are not applied immediately wait for the next maintenance window.
+	Class             string
+	Size              int64
+	EngineVersion     string
+	SecurityGroupIDs  []string
+	DBSubnetGroupName string
 }
 
 // NewInstance returns new Instance structure
@@ -42,18 +53,40 @@ func NewInstance(instance *rds.DBInstance) *Instance {
 		endpoint = aws.StringValue(instance.Endpoint.Address)
 	}
 
-	return &Instance{
-		Name:     aws.StringValue(instance.DBInstanceIdentifier),
-		ARN:      aws.StringValue(instance.DBInstanceArn),
-		Status:   aws.StringValue(instance.DBInstanceStatus),
-		Endpoint: endpoint,
+	i := &Instance{
+		Name:          aws.StringValue(instance.DBInstanceIdentifier),
+		ARN:           aws.StringValue(instance.DBInstanceArn),
+		Status:        aws.StringValue(instance.DBInstanceStatus),
+		Endpoint:      endpoint,
+		Class:         aws.StringValue(instance.DBInstanceClass),
+		Size:          aws.Int64Value(instance.AllocatedStorage),
+		EngineVersion: aws.StringValue(instance.EngineVersion),
+	}
+	for _, sg := range instance.VpcSecurityGroups {
+		i.SecurityGroupIDs = append(i.SecurityGroupIDs, aws.StringValue(sg.VpcSecurityGroupId))
+	}
+	if instance.DBSubnetGroup != nil {
+		i.DBSubnetGroupName = aws.StringValue(instance.DBSubnetGroup.DBSubnetGroupName)
 	}
+	if p := instance.PendingModifiedValues; p != nil {
+		if p.DBInstanceClass != nil {
+			i.Class = aws.StringValue(p.DBInstanceClass)
+		}
+		if p.AllocatedStorage != nil {
+			i.Size = aws.Int64Value(p.AllocatedStorage)
+		}
+		if p.EngineVersion != nil {
+			i.EngineVersion = aws.StringValue(p.EngineVersion)
+		}
+	}
+	return i
 }
 
 // Client defines RDS RDSClient operations
 type Client interface {
 	CreateInstance(string, string, *v1alpha2.RDSInstanceSpec) (*Instance, error)
 	GetInstance(name string) (*Instance, error)
+	ModifyInstance(name string, spec *v1alpha2.RDSInstanceSpec) (*Instance, error)
 	DeleteInstance(name string) (*Instance, error)
 }
 
@@ -93,6 +126,22 @@ func (r *rdsClient) GetInstance(name string) (*Instance, error) {
 	return NewInstance(&output.DBInstances[0]), nil
 }
 
+// ModifyInstance modifies the class, size, engine version and security groups
+// of an RDS Instance that differ from the provided Specification
+func (r *rdsClient) ModifyInstance(name string, spec *v1alpha2.RDSInstanceSpec) (*Instance, error) {
+	instance, err := r.GetInstance(name)
+	if err != nil {
+		return nil, err
+	}
+
+	input := GenerateModifyDBInstanceInput(name, spec.RDSInstanceParameters, instance)
+	output, err := r.rds.ModifyDBInstanceRequest(input).Send()
+	if err != nil {
+		return nil, err
+	}
+	return NewInstance(output.DBInstance), nil
+}
+
 // DeleteInstance deletes RDS Instance
 func (r *rdsClient) DeleteInstance(name string) (*Instance, error) {
 	input := rds.DeleteDBInstanceInput{
@@ -110,12 +159,12 @@ func (r *rdsClient) DeleteInstance(name string) (*Instance, error) {
 // IsErrorAlreadyExists returns true if the supplied error indicates an instance
 // already exists.
 func IsErrorAlreadyExists(err error) bool {
-	return strings.Contains(err.Error(), rds.ErrCodeDBInstanceAlreadyExistsFault)
+	return err != nil && strings.Contains(err.Error(), rds.ErrCodeDBInstanceAlreadyExistsFault)
 }
 
 // IsErrorNotFound helper function to test for ErrCodeDBInstanceNotFoundFault error
 func IsErrorNotFound(err error) bool {
-	return strings.Contains(err.Error(), rds.ErrCodeDBInstanceNotFoundFault)
+	return err != nil && strings.Contains(err.Error(), rds.ErrCodeDBInstanceNotFoundFault)
 }
 
 // CreateDBInstanceInput from RDSInstanceSpec
@@ -134,3 +183,101 @@ func CreateDBInstanceInput(name, password string, spec *v1alpha2.RDSInstanceSpec
 		DBSubnetGroupName:     aws.String(spec.DBSubnetGroupName),
 	}
 }
+
+// LateInitialize fills the unset parameters of an RDSInstance with the values
+// of the supplied Instance, which are the defaults AWS chose for them. Only the
+// major version of the engine is recorded, so that the automatic minor version
+// upgrades of the instance keep it up to date.
+func LateInitialize(p *v1alpha2.RDSInstanceParameters, instance *Instance) {
+	if p.EngineVersion == "" {
+		p.EngineVersion = majorEngineVersion(p.Engine, instance.EngineVersion)
+	}
+	if p.DBSubnetGroupName == "" {
+		p.DBSubnetGroupName = instance.DBSubnetGroupName
+	}
+	if len(p.SecurityGroupIDs) == 0 && len(instance.SecurityGroupIDs) != 0 {
+		p.SecurityGroupIDs = append([]string{}, instance.SecurityGroupIDs...)
+	}
+}
+
+// NeedsUpdate returns true if the class, size, engine version or security
+// groups of the supplied Instance differ from the desired parameters.
+func NeedsUpdate(p v1alpha2.RDSInstanceParameters, instance *Instance) bool {
+	switch {
+	case p.Class != instance.Class:
+		return true
+	case p.Size != instance.Size:
+		return true
+	case !isEngineVersionUpToDate(p.EngineVersion, instance.EngineVersion):
+		return true
+	case len(p.SecurityGroupIDs) != 0 && !equalStringSets(p.SecurityGroupIDs, instance.SecurityGroupIDs):
+		return true
+	}
+	return false
+}
+
+// GenerateModifyDBInstanceInput returns the input of a ModifyDBInstance
+// request that sets the outdated parameters of the supplied Instance. The
+// modification waits for the next maintenance window unless the parameters
+// ask for it to be applied immediately. A major version upgrade is only
+// allowed when the desired engine version has a different major version than
+// the observed one.
+func GenerateModifyDBInstanceInput(name string, p v1alpha2.RDSInstanceParameters, instance *Instance) *rds.ModifyDBInstanceInput {
+	input := &rds.ModifyDBInstanceInput{
+		DBInstanceIdentifier: aws.String(name),
+		ApplyImmediately:     aws.Bool(p.ApplyModificationsImmediately),
+	}
+	if p.Class != instance.Class {
+		input.DBInstanceClass = aws.String(p.Class)
+	}
+	if p.Size != instance.Size {
+		input.AllocatedStorage = aws.Int64(p.Size)
+	}
+	if !isEngineVersionUpToDate(p.EngineVersion, instance.EngineVersion) {
+		input.EngineVersion = aws.String(p.EngineVersion)
+		if majorEngineVersion(p.Engine, p.EngineVersion) != majorEngineVersion(p.Engine, instance.EngineVersion) {
+			input.AllowMajorVersionUpgrade = aws.Bool(true)
+		}
+	}
+	if len(p.SecurityGroupIDs) != 0 && !equalStringSets(p.SecurityGroupIDs, instance.SecurityGroupIDs) {
+		input.VpcSecurityGroupIds = p.SecurityGroupIDs
+	}
+	return input
+}
+
+// isEngineVersionUpToDate returns true if the observed engine version is the
+// desired one. A desired version such as "5.6" is satisfied by any of its
+// minor versions, since AWS picks one when the instance is created.
+func isEngineVersionUpToDate(desired, observed string) bool {
+	return desired == "" || desired == observed || strings.HasPrefix(observed, desired+".")
+}
+
+// majorEngineVersion returns the major version of the supplied engine version,
+// e.g. "5.6" for "5.6.41". PostgreSQL 10 and later are the exception, since
+// their major version is their first component, e.g. "11" for "11.5".
+func majorEngineVersion(engine, version string) string {
+	parts := strings.Split(version, ".")
+	if n, err := strconv.Atoi(parts[0]); err == nil && engine == v1alpha2.PostgresqlEngine && n >= 10 {
+		return parts[0]
+	}
+	if len(parts) > 2 {
+		parts = parts[:2]
+	}
+	return strings.Join(parts, ".")
+}
+
+func equalStringSets(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	sa := append([]string{}, a...)
+	sb := append([]string{}, b...)
+	sort.Strings(sa)
+	sort.Strings(sb)
+	for i := range sa {
+		if sa[i] != sb[i] {
+			return false
+		}
+	}
+	return true
+}
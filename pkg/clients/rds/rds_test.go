@@ -0,0 +1,250 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rds
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	"github.com/crossplaneio/stack-aws/apis/database/v1alpha2"
+)
+
+func Test_NewInstance(t *testing.T) {
+	d := &rds.DBInstance{
+		DBInstanceIdentifier: aws.String("instance"),
+		DBInstanceArn:        aws.String("arn"),
+		DBInstanceStatus:     aws.String("available"),
+		Endpoint:             &rds.Endpoint{Address: aws.String("instance.rds.amazonaws.com")},
+		DBInstanceClass:      aws.String("db.t2.small"),
+		AllocatedStorage:     aws.Int64(10),
+		EngineVersion:        aws.String("5.6.41"),
+		VpcSecurityGroups: []rds.VpcSecurityGroupMembership{
+			{VpcSecurityGroupId: aws.String("sg-1")},
+			{VpcSecurityGroupId: aws.String("sg-2")},
+		},
+		DBSubnetGroup: &rds.DBSubnetGroup{DBSubnetGroupName: aws.String("default")},
+		PendingModifiedValues: &rds.PendingModifiedValues{
+			DBInstanceClass: aws.String("db.t2.large"),
+		},
+	}
+	want := &Instance{
+		Name:              "instance",
+		ARN:               "arn",
+		Status:            "available",
+		Endpoint:          "instance.rds.amazonaws.com",
+		Class:             "db.t2.large",
+		Size:              10,
+		EngineVersion:     "5.6.41",
+		SecurityGroupIDs:  []string{"sg-1", "sg-2"},
+		DBSubnetGroupName: "default",
+	}
+
+	got := NewInstance(d)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("r: -want, +got:\n%s", diff)
+	}
+}
+
+func Test_LateInitialize(t *testing.T) {
+	i := &Instance{
+		EngineVersion:     "5.6.41",
+		SecurityGroupIDs:  []string{"sg-1"},
+		DBSubnetGroupName: "default",
+	}
+
+	testCases := []struct {
+		name string
+		p    v1alpha2.RDSInstanceParameters
+		want v1alpha2.RDSInstanceParameters
+	}{
+		{
+			"unset parameters are filled from the instance",
+			v1alpha2.RDSInstanceParameters{},
+			v1alpha2.RDSInstanceParameters{
+				EngineVersion:     "5.6",
+				SecurityGroupIDs:  []string{"sg-1"},
+				DBSubnetGroupName: "default",
+			},
+		},
+		{
+			"set parameters are kept",
+			v1alpha2.RDSInstanceParameters{
+				EngineVersion:     "5.6",
+				SecurityGroupIDs:  []string{"sg-2"},
+				DBSubnetGroupName: "group",
+			},
+			v1alpha2.RDSInstanceParameters{
+				EngineVersion:     "5.6",
+				SecurityGroupIDs:  []string{"sg-2"},
+				DBSubnetGroupName: "group",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			LateInitialize(&tc.p, i)
+			if diff := cmp.Diff(tc.want, tc.p); diff != "" {
+				t.Errorf("LateInitialize(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_LateInitializeMinorVersionUpgrade(t *testing.T) {
+	p := v1alpha2.RDSInstanceParameters{Engine: v1alpha2.MysqlEngine, Class: "db.t2.small", Size: 10}
+	LateInitialize(&p, &Instance{Class: "db.t2.small", Size: 10, EngineVersion: "5.6.41"})
+
+	// RDS upgrades the minor version of an instance automatically
+	upgraded := &Instance{Class: "db.t2.small", Size: 10, EngineVersion: "5.6.44"}
+	if NeedsUpdate(p, upgraded) {
+		t.Errorf("NeedsUpdate(...): an automatic minor version upgrade should not need an update")
+	}
+}
+
+func Test_NeedsUpdate(t *testing.T) {
+	i := &Instance{
+		Class:            "db.t2.small",
+		Size:             10,
+		EngineVersion:    "5.6.41",
+		SecurityGroupIDs: []string{"sg-1", "sg-2"},
+	}
+	upToDate := v1alpha2.RDSInstanceParameters{
+		Class:            "db.t2.small",
+		Size:             10,
+		EngineVersion:    "5.6",
+		SecurityGroupIDs: []string{"sg-2", "sg-1"},
+	}
+
+	testCases := []struct {
+		name string
+		p    func(*v1alpha2.RDSInstanceParameters)
+		want bool
+	}{
+		{"a major version matches its minor versions and security groups match in any order", func(*v1alpha2.RDSInstanceParameters) {}, false},
+		{"a different class is outdated", func(p *v1alpha2.RDSInstanceParameters) { p.Class = "db.t2.large" }, true},
+		{"a different size is outdated", func(p *v1alpha2.RDSInstanceParameters) { p.Size = 20 }, true},
+		{"a different engine version is outdated", func(p *v1alpha2.RDSInstanceParameters) { p.EngineVersion = "5.7" }, true},
+		{"a prefix that is not a major version is outdated", func(p *v1alpha2.RDSInstanceParameters) { p.EngineVersion = "5.6.4" }, true},
+		{"different security groups are outdated", func(p *v1alpha2.RDSInstanceParameters) { p.SecurityGroupIDs = []string{"sg-1"} }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := upToDate
+			tc.p(&p)
+			if got := NeedsUpdate(p, i); got != tc.want {
+				t.Errorf("NeedsUpdate(...): want %t, got %t", tc.want, got)
+			}
+		})
+	}
+}
+
+func Test_GenerateModifyDBInstanceInput(t *testing.T) {
+	i := &Instance{
+		Class:            "db.t2.small",
+		Size:             10,
+		EngineVersion:    "5.6.41",
+		SecurityGroupIDs: []string{"sg-1"},
+	}
+
+	testCases := []struct {
+		name string
+		p    v1alpha2.RDSInstanceParameters
+		want *rds.ModifyDBInstanceInput
+	}{
+		{
+			"only outdated parameters are modified",
+			v1alpha2.RDSInstanceParameters{
+				Class:         "db.t2.large",
+				Size:          10,
+				EngineVersion: "5.6",
+			},
+			&rds.ModifyDBInstanceInput{
+				DBInstanceIdentifier: aws.String("instance"),
+				ApplyImmediately:     aws.Bool(false),
+				DBInstanceClass:      aws.String("db.t2.large"),
+			},
+		},
+		{
+			"modifications are applied immediately when asked to",
+			v1alpha2.RDSInstanceParameters{
+				Class:                         "db.t2.small",
+				Size:                          20,
+				EngineVersion:                 "5.7",
+				SecurityGroupIDs:              []string{"sg-2"},
+				ApplyModificationsImmediately: true,
+			},
+			&rds.ModifyDBInstanceInput{
+				DBInstanceIdentifier:     aws.String("instance"),
+				ApplyImmediately:         aws.Bool(true),
+				AllocatedStorage:         aws.Int64(20),
+				EngineVersion:            aws.String("5.7"),
+				AllowMajorVersionUpgrade: aws.Bool(true),
+				VpcSecurityGroupIds:      []string{"sg-2"},
+			},
+		},
+		{
+			"minor version upgrades don't allow major version upgrades",
+			v1alpha2.RDSInstanceParameters{
+				Class:         "db.t2.small",
+				Size:          10,
+				EngineVersion: "5.6.44",
+			},
+			&rds.ModifyDBInstanceInput{
+				DBInstanceIdentifier: aws.String("instance"),
+				ApplyImmediately:     aws.Bool(false),
+				EngineVersion:        aws.String("5.6.44"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := GenerateModifyDBInstanceInput("instance", tc.p, i)
+			if diff := cmp.Diff(tc.want, got, cmpopts.IgnoreUnexported(rds.ModifyDBInstanceInput{})); diff != "" {
+				t.Errorf("r: -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_majorEngineVersion(t *testing.T) {
+	testCases := []struct {
+		engine  string
+		version string
+		want    string
+	}{
+		{v1alpha2.MysqlEngine, "5.6.41", "5.6"},
+		{v1alpha2.MysqlEngine, "5.7", "5.7"},
+		{v1alpha2.PostgresqlEngine, "9.6.15", "9.6"},
+		{v1alpha2.PostgresqlEngine, "11.5", "11"},
+		{v1alpha2.PostgresqlEngine, "11", "11"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.engine+" "+tc.version, func(t *testing.T) {
+			if got := majorEngineVersion(tc.engine, tc.version); got != tc.want {
+				t.Errorf("majorEngineVersion(%q, %q): want %q, got %q", tc.engine, tc.version, tc.want, got)
+			}
+		})
+	}
+}
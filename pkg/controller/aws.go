@@ -21,13 +21,23 @@ import (
 
 	"github.com/crossplaneio/stack-aws/pkg/controller/cache"
 	"github.com/crossplaneio/stack-aws/pkg/controller/compute"
+	"github.com/crossplaneio/stack-aws/pkg/controller/compute/fargateprofile"
+	"github.com/crossplaneio/stack-aws/pkg/controller/compute/nodegroup"
 	"github.com/crossplaneio/stack-aws/pkg/controller/identity/iamrole"
 	"github.com/crossplaneio/stack-aws/pkg/controller/identity/iamrolepolicyattachment"
+	"github.com/crossplaneio/stack-aws/pkg/controller/network/elasticip"
 	"github.com/crossplaneio/stack-aws/pkg/controller/network/internetgateway"
+	"github.com/crossplaneio/stack-aws/pkg/controller/network/natgateway"
+	"github.com/crossplaneio/stack-aws/pkg/controller/network/networkacl"
 	"github.com/crossplaneio/stack-aws/pkg/controller/network/routetable"
 	"github.com/crossplaneio/stack-aws/pkg/controller/network/securitygroup"
 	"github.com/crossplaneio/stack-aws/pkg/controller/network/subnet"
+	"github.com/crossplaneio/stack-aws/pkg/controller/network/transitgateway"
+	"github.com/crossplaneio/stack-aws/pkg/controller/network/transitgatewayvpcattachment"
 	"github.com/crossplaneio/stack-aws/pkg/controller/network/vpc"
+	"github.com/crossplaneio/stack-aws/pkg/controller/network/vpcendpoint"
+	"github.com/crossplaneio/stack-aws/pkg/controller/network/vpcpeeringconnection"
+	"github.com/crossplaneio/stack-aws/pkg/controller/provider"
 	"github.com/crossplaneio/stack-aws/pkg/controller/rds"
 	"github.com/crossplaneio/stack-aws/pkg/controller/rds/dbsubnetgroup"
 	"github.com/crossplaneio/stack-aws/pkg/controller/s3"
@@ -42,11 +52,14 @@ func (c *Controllers) SetupWithManager(mgr ctrl.Manager) error {
 	controllers := []interface {
 		SetupWithManager(ctrl.Manager) error
 	}{
+		&provider.Controller{},
 		&cache.ReplicationGroupClaimController{},
 		&cache.ReplicationGroupController{},
 		&compute.EKSClusterClaimController{},
 		&compute.EKSClusterSecretController{},
 		&compute.EKSClusterController{},
+		&nodegroup.Controller{},
+		&fargateprofile.Controller{},
 		&rds.PostgreSQLInstanceClaimController{},
 		&rds.MySQLInstanceClaimController{},
 		&rds.InstanceController{},
@@ -59,6 +72,13 @@ func (c *Controllers) SetupWithManager(mgr ctrl.Manager) error {
 		&securitygroup.Controller{},
 		&internetgateway.Controller{},
 		&routetable.Controller{},
+		&elasticip.Controller{},
+		&natgateway.Controller{},
+		&vpcendpoint.Controller{},
+		&vpcpeeringconnection.Controller{},
+		&transitgateway.Controller{},
+		&transitgatewayvpcattachment.Controller{},
+		&networkacl.Controller{},
 		&dbsubnetgroup.Controller{},
 	}
 
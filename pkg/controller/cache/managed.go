@@ -26,13 +26,12 @@ import (
 	elasticacheservice "github.com/aws/aws-sdk-go-v2/service/elasticache"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplaneio/stack-aws/apis/cache/v1beta1"
-	awsv1alpha2 "github.com/crossplaneio/stack-aws/apis/v1alpha2"
 	"github.com/crossplaneio/stack-aws/pkg/clients/elasticache"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
 
 	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
@@ -71,6 +70,7 @@ func (c *ReplicationGroupController) SetupWithManager(mgr ctrl.Manager) error {
 		resource.WithExternalConnecter(&connecter{
 			client:      mgr.GetClient(),
 			newClientFn: elasticache.NewClient,
+			awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion,
 		}))
 
 	name := strings.ToLower(fmt.Sprintf("%s.%s", v1beta1.ReplicationGroupKind, v1beta1.Group))
@@ -83,7 +83,8 @@ func (c *ReplicationGroupController) SetupWithManager(mgr ctrl.Manager) error {
 
 type connecter struct {
 	client      client.Client
-	newClientFn func(credentials []byte, region string) (elasticache.Client, error)
+	newClientFn func(*commonaws.Config) (elasticache.Client, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*commonaws.Config, error)
 }
 
 func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (resource.ExternalClient, error) {
@@ -92,18 +93,11 @@ func (c *connecter) Connect(ctx context.Context, mg resource.Managed) (resource.
 		return nil, errors.New(errNotReplicationGroup)
 	}
 
-	p := &awsv1alpha2.Provider{}
-	n := meta.NamespacedNameOf(g.Spec.ProviderReference)
-	if err := c.client.Get(ctx, n, p); err != nil {
-		return nil, errors.Wrapf(err, "cannot get provider %s", n)
-	}
-
-	s := &corev1.Secret{}
-	n = types.NamespacedName{Namespace: p.GetNamespace(), Name: p.Spec.Secret.Name}
-	if err := c.client.Get(ctx, n, s); err != nil {
-		return nil, errors.Wrapf(err, "cannot get provider secret %s", n)
+	cfg, err := c.awsConfigFn(ctx, c.client, g.Spec.ProviderReference, g.Spec.ForProvider.Region)
+	if err != nil {
+		return nil, err
 	}
-	awsClient, err := c.newClientFn(s.Data[p.Spec.Secret.Key], p.Spec.Region)
+	awsClient, err := c.newClientFn(cfg)
 	return &external{client: awsClient, kube: c.client}, errors.Wrap(err, errNewClient)
 }
 
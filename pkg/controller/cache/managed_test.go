@@ -40,6 +40,7 @@ import (
 	awsv1alpha2 "github.com/crossplaneio/stack-aws/apis/v1alpha2"
 	elasticacheclient "github.com/crossplaneio/stack-aws/pkg/clients/elasticache"
 	"github.com/crossplaneio/stack-aws/pkg/clients/elasticache/fake"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
 
 	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
@@ -53,7 +54,7 @@ const (
 	providerName       = "cool-aws"
 	providerSecretName = "cool-aws-secret"
 	providerSecretKey  = "credentials"
-	providerSecretData = "definitelyini"
+	providerSecretData = "[default]\naws_access_key_id = id\naws_secret_access_key = secret"
 
 	connectionSecretName = "cool-connection-secret"
 )
@@ -650,7 +651,8 @@ func TestConnect(t *testing.T) {
 						return nil
 					},
 				},
-				newClientFn: func(_ []byte, _ string) (elasticacheclient.Client, error) { return &fake.MockClient{}, nil },
+				newClientFn: func(_ *aws.Config) (elasticacheclient.Client, error) { return &fake.MockClient{}, nil },
+				awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion,
 			},
 			i: replicationGroup(),
 		},
@@ -660,7 +662,8 @@ func TestConnect(t *testing.T) {
 				client: &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
 					return kerrors.NewNotFound(schema.GroupResource{}, providerName)
 				}},
-				newClientFn: func(_ []byte, _ string) (elasticacheclient.Client, error) { return &fake.MockClient{}, nil },
+				newClientFn: func(_ *aws.Config) (elasticacheclient.Client, error) { return &fake.MockClient{}, nil },
+				awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion,
 			},
 			i:       replicationGroup(),
 			wantErr: errors.WithStack(errors.Errorf("cannot get provider %s/%s:  \"%s\" not found", namespace, providerName, providerName)),
@@ -677,7 +680,8 @@ func TestConnect(t *testing.T) {
 					}
 					return nil
 				}},
-				newClientFn: func(_ []byte, _ string) (elasticacheclient.Client, error) { return &fake.MockClient{}, nil },
+				newClientFn: func(_ *aws.Config) (elasticacheclient.Client, error) { return &fake.MockClient{}, nil },
+				awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion,
 			},
 			i:       replicationGroup(),
 			wantErr: errors.WithStack(errors.Errorf("cannot get provider secret %s/%s:  \"%s\" not found", namespace, providerSecretName, providerSecretName)),
@@ -694,7 +698,8 @@ func TestConnect(t *testing.T) {
 					}
 					return nil
 				}},
-				newClientFn: func(_ []byte, _ string) (elasticacheclient.Client, error) { return nil, errorBoom },
+				newClientFn: func(_ *aws.Config) (elasticacheclient.Client, error) { return nil, errorBoom },
+				awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion,
 			},
 			i:       replicationGroup(),
 			wantErr: errors.Wrap(errorBoom, errNewClient),
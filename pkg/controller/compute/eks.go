@@ -18,60 +18,79 @@ package compute
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
+	"reflect"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	cf "github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/ghodss/yaml"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
-	"github.com/crossplaneio/crossplane-runtime/pkg/logging"
 	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
 	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
 
 	awscomputev1alpha2 "github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
-	awsv1alpha2 "github.com/crossplaneio/stack-aws/apis/v1alpha2"
-	aws "github.com/crossplaneio/stack-aws/pkg/clients"
 	cloudformationclient "github.com/crossplaneio/stack-aws/pkg/clients/cloudformation"
 	"github.com/crossplaneio/stack-aws/pkg/clients/eks"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
 )
 
 const (
-	controllerName    = "eks.compute.aws.crossplane.io"
-	finalizer         = "finalizer." + controllerName
-	clusterNamePrefix = "eks-"
+	controllerName = "eks.compute.aws.crossplane.io"
 
-	eksAuthConfigMapName = "aws-auth"
-	eksAuthMapRolesKey   = "mapRoles"
-	eksAuthMapUsersKey   = "mapUsers"
-)
+	// legacyFinalizer was added to EKSClusters by the EKSCluster controller
+	// before it used the managed reconciler, which adds its own.
+	legacyFinalizer = "finalizer." + controllerName
 
-var (
-	log = logging.Logger.WithName("controller." + controllerName)
-	ctx = context.Background()
-)
+	eksAuthConfigMapName  = "aws-auth"
+	eksAuthMapRolesKey    = "mapRoles"
+	eksAuthMapUsersKey    = "mapUsers"
+	eksAuthMapAccountsKey = "mapAccounts"
 
-// Amounts of time we wait before requeuing a reconcile.
-const (
-	aShortWait = 30 * time.Second
-	aLongWait  = 60 * time.Second
+	eksAuthOwnershipAnnotation = controllerName + "/aws-auth-owned-entries"
+
+	connectionSecretKubeconfigKey = "kubeconfig"
 )
 
+// tokenRefreshWindow is how long before it expires the token in the connection
+// secret is replaced. It is much longer than the wait between two reconciles
+// of a cluster that is up to date, so a cluster is observed at least once while
+// its token is due for a refresh.
+const tokenRefreshWindow = 5 * time.Minute
+
 // Error strings
 const (
-	errUpdateManagedStatus = "cannot update managed resource status"
+	errNotEKSCluster         = "managed resource is not an EKSCluster"
+	errUpdateManaged         = "cannot update EKSCluster custom resource"
+	errGetCluster            = "failed to get the cluster"
+	errCreateCluster         = "failed to create the cluster"
+	errDeleteCluster         = "Master Delete Error: %s"
+	errCreateWorkers         = "failed to create the worker nodes of the cluster"
+	errGetWorkers            = "failed to get the worker nodes of the cluster"
+	errDeleteWorkers         = "Worker Delete Error: %s"
+	errWorkersFailed         = "clusterworker stack failed with status %q and reason %q"
+	errAWSAuth               = "failed to set auth map on eks"
+	errConnectionDetails     = "failed to get the connection details of the cluster"
+	errGetUpdate             = "failed to get the update of the cluster"
+	errUpdateFailed          = "update %s of type %s finished with status %s: %s"
+	errUpdateVersion         = "failed to update the version of the cluster"
+	errUpdateConfig          = "failed to update the configuration of the cluster"
+	errParseAWSAuth          = "cannot parse the entries of the aws-auth configmap"
+	errParseAWSAuthOwnership = "cannot parse the aws-auth entries owned by the controller"
 )
 
 // CloudFormation States that are non-transitory
@@ -90,20 +109,6 @@ var (
 	}
 )
 
-// Reconciler reconciles a Provider object
-type Reconciler struct {
-	client.Client
-	publisher resource.ManagedConnectionPublisher
-	resource.ManagedReferenceResolver
-
-	connect func(*awscomputev1alpha2.EKSCluster) (eks.Client, error)
-	create  func(*awscomputev1alpha2.EKSCluster, eks.Client) (reconcile.Result, error)
-	sync    func(*awscomputev1alpha2.EKSCluster, eks.Client) (reconcile.Result, error)
-	delete  func(*awscomputev1alpha2.EKSCluster, eks.Client) (reconcile.Result, error)
-	secret  func(*eks.Cluster, *awscomputev1alpha2.EKSCluster, eks.Client) error
-	awsauth func(*eks.Cluster, *awscomputev1alpha2.EKSCluster, eks.Client, string) error
-}
-
 // EKSClusterController is responsible for adding the EKSCluster
 // controller and its corresponding reconciler to the manager with any runtime configuration.
 type EKSClusterController struct{}
@@ -111,17 +116,20 @@ type EKSClusterController struct{}
 // SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func (c *EKSClusterController) SetupWithManager(mgr ctrl.Manager) error {
-	r := &Reconciler{
-		Client:                   mgr.GetClient(),
-		publisher:                resource.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme()),
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(mgr.GetClient()),
-	}
-	r.connect = r._connect
-	r.create = r._create
-	r.sync = r._sync
-	r.delete = r._delete
-	r.secret = r._secret
-	r.awsauth = r._awsauth
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(awscomputev1alpha2.EKSClusterGroupVersionKind),
+		resource.WithExternalConnecter(&connector{
+			client:          mgr.GetClient(),
+			newClientFn:     eks.NewClient,
+			newKubeClientFn: newKubeClient,
+			awsConfigFn:     utils.RetrieveAwsConfigFromProviderInRegion,
+		}),
+		resource.WithManagedInitializers(
+			&legacyClusterNameAdopter{client: mgr.GetClient()},
+			resource.NewManagedNameAsExternalName(mgr.GetClient()),
+			resource.NewAPIManagedFinalizerAdder(mgr.GetClient()),
+			&legacyFinalizerRemover{client: mgr.GetClient()},
+		))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(controllerName).
@@ -129,316 +137,563 @@ func (c *EKSClusterController) SetupWithManager(mgr ctrl.Manager) error {
 		Complete(r)
 }
 
-// fail - helper function to set fail condition with reason and message
-func (r *Reconciler) fail(instance *awscomputev1alpha2.EKSCluster, err error) (reconcile.Result, error) {
-	instance.Status.SetConditions(runtimev1alpha1.ReconcileError(err))
+// A legacyClusterNameAdopter uses the name of an EKS cluster that was created
+// before the EKSCluster controller used the managed reconciler as the external
+// name of its EKSCluster. Such clusters are named after the UID of their
+// EKSCluster rather than after its name.
+type legacyClusterNameAdopter struct{ client client.Client }
 
-	// If this is the first time we've encountered this error we'll be requeued
-	// implicitly due to the status update. Otherwise we requeue after a short
-	// wait in case the error condition was resolved.
-	return reconcile.Result{RequeueAfter: aShortWait}, r.Update(ctx, instance)
+// Initialize the external name of the supplied EKSCluster.
+func (a *legacyClusterNameAdopter) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*awscomputev1alpha2.EKSCluster)
+	if !ok {
+		return errors.New(errNotEKSCluster)
+	}
+	if meta.GetExternalName(cr) != "" || cr.Status.ClusterName == "" {
+		return nil
+	}
+	meta.SetExternalName(cr, cr.Status.ClusterName)
+	return errors.Wrap(a.client.Update(ctx, cr), errUpdateManaged)
 }
 
-func (r *Reconciler) _connect(instance *awscomputev1alpha2.EKSCluster) (eks.Client, error) {
-	p := &awsv1alpha2.Provider{}
-	if err := r.Get(ctx, meta.NamespacedNameOf(instance.Spec.ProviderReference), p); err != nil {
-		return nil, err
+// A legacyFinalizerRemover removes the finalizer that the EKSCluster
+// controller added before it used the managed reconciler, which would
+// otherwise block the deletion of the EKSCluster forever.
+type legacyFinalizerRemover struct{ client client.Client }
+
+// Initialize the finalizers of the supplied EKSCluster.
+func (r *legacyFinalizerRemover) Initialize(ctx context.Context, mg resource.Managed) error {
+	if !meta.FinalizerExists(mg, legacyFinalizer) {
+		return nil
 	}
+	meta.RemoveFinalizer(mg, legacyFinalizer)
+	return errors.Wrap(r.client.Update(ctx, mg), errUpdateManaged)
+}
 
-	s := &v1.Secret{}
-	n := types.NamespacedName{Namespace: p.GetNamespace(), Name: p.Spec.Secret.Name}
-	if err := r.Get(ctx, n, s); err != nil {
-		return nil, err
+// newKubeClient returns a client for the Kubernetes API server of an EKS
+// cluster.
+func newKubeClient(config *rest.Config) (kubernetes.Interface, error) {
+	return kubernetes.NewForConfig(config)
+}
+
+type connector struct {
+	client          client.Client
+	newClientFn     func(*aws.Config) eks.Client
+	newKubeClientFn func(*rest.Config) (kubernetes.Interface, error)
+	awsConfigFn     func(context.Context, client.Reader, *v1.ObjectReference, string) (*aws.Config, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mg.(*awscomputev1alpha2.EKSCluster)
+	if !ok {
+		return nil, errors.New(errNotEKSCluster)
 	}
 
 	// NOTE(negz): EKS clusters must specify a region for creation. They never
 	// use the provider's region. This should be addressed per the below issue.
 	// https://github.com/crossplaneio/stack-aws/issues/38
-	config, err := aws.LoadConfig(s.Data[p.Spec.Secret.Key], aws.DefaultSection, string(instance.Spec.Region))
+	config, err := c.awsConfigFn(ctx, c.client, cr.Spec.ProviderReference, string(cr.Spec.Region))
 	if err != nil {
 		return nil, err
 	}
 
-	// Create new EKS Client
-	return eks.NewClient(config), nil
+	return &external{client: c.newClientFn(config), newKubeClientFn: c.newKubeClientFn}, nil
 }
 
-func (r *Reconciler) _create(instance *awscomputev1alpha2.EKSCluster, client eks.Client) (reconcile.Result, error) {
-	instance.Status.SetConditions(runtimev1alpha1.Creating())
-	clusterName := fmt.Sprintf("%s%s", clusterNamePrefix, instance.UID)
-
-	// Create Master
-	createdCluster, err := client.Create(clusterName, instance.Spec)
-	if err != nil && !eks.IsErrorAlreadyExists(err) {
-		if eks.IsErrorBadRequest(err) {
-			// If this was the first time we encountered this error we'll be
-			// requeued implicitly. Otherwise there's no point requeuing, since
-			// the error indicates our spec is bad and needs updating before
-			// anything will work.
-			instance.Status.SetConditions(runtimev1alpha1.ReconcileError(err))
-			return reconcile.Result{}, r.Update(ctx, instance)
-		}
-		return r.fail(instance, err)
+type external struct {
+	client          eks.Client
+	newKubeClientFn func(*rest.Config) (kubernetes.Interface, error)
+}
+
+// Observe the EKS cluster of the supplied EKSCluster. A cluster is only ready
+// for use once it has worker nodes, which are created by a CloudFormation stack
+// as soon as the cluster is active. The stack can only be created once the
+// cluster has been created, so it is created while the cluster is observed.
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (resource.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mg.(*awscomputev1alpha2.EKSCluster)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errNotEKSCluster)
+	}
+
+	cluster, err := e.client.Get(meta.GetExternalName(cr))
+	if err != nil {
+		return resource.ExternalObservation{ResourceExists: false}, errors.Wrap(resource.Ignore(eks.IsErrorNotFound, err), errGetCluster)
 	}
 
+	cr.Status.ClusterName = cluster.Name
+	cr.Status.State = cluster.Status
+	cr.Status.Endpoint = cluster.Endpoint
+
 	// we will need to set State.ClusterVersion it. this is needed to retrieve
 	// the right ami image for the worker nodes
-	instance.Status.ClusterVersion = createdCluster.Version
+	cr.Status.ClusterVersion = cluster.Version
+
+	// A cluster that is being deleted, or that is not active, needs no
+	// worker nodes, connection details or updates.
+	upToDate := resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}
+	switch {
+	case meta.WasDeleted(cr) || cluster.Status == awscomputev1alpha2.ClusterStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+		return upToDate, nil
+	case cluster.Status == awscomputev1alpha2.ClusterStatusCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+		return upToDate, nil
+	case cluster.Status != awscomputev1alpha2.ClusterStatusActive && cluster.Status != awscomputev1alpha2.ClusterStatusUpdating:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+		return upToDate, nil
+	}
+
+	// EKS runs one update of a cluster at a time, so an update that is in
+	// progress has to finish before the cluster is updated again.
+	if cr.Status.UpdateID != "" {
+		if err := e.awaitUpdate(cr); err != nil {
+			return resource.ExternalObservation{}, err
+		}
+	}
 
-	// Update status
-	instance.Status.State = awscomputev1alpha2.ClusterStatusCreating
-	instance.Status.ClusterName = clusterName
+	workers, err := e.observeWorkers(cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if !completedCFState[workers.WorkersStatus] {
+		cr.SetConditions(runtimev1alpha1.Creating())
+		return upToDate, nil
+	}
 
-	instance.Status.SetConditions(runtimev1alpha1.ReconcileSuccess())
+	if err := e.awsauth(cluster, cr, workers.WorkerARN); err != nil {
+		return resource.ExternalObservation{}, errors.Wrap(err, errAWSAuth)
+	}
 
-	// We'll be requeued immediately the first time we update our status
-	// condition. Otherwise we want to requeue after a short wait in order to
-	// determine whether the cluster is ready.
-	return reconcile.Result{RequeueAfter: aShortWait}, r.Update(ctx, instance)
+	details, err := e.connectionDetails(cluster, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, errors.Wrap(err, errConnectionDetails)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Available())
+	resource.SetBindable(cr)
+
+	return resource.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  cr.Status.UpdateID != "" || (eks.IsClusterVersionUpToDate(cr.Spec, cluster) && eks.IsClusterConfigUpToDate(cr.Spec, cluster)),
+		ConnectionDetails: details,
+	}, nil
 }
 
-// generateAWSAuthConfigMap generates the configmap for configure auth
-func generateAWSAuthConfigMap(instance *awscomputev1alpha2.EKSCluster, workerARN string) (*v1.ConfigMap, error) {
-	data := map[string]string{}
-	defaultRole := awscomputev1alpha2.MapRole{
-		RoleARN:  workerARN,
-		Username: "system:node:{{EC2PrivateDNSName}}",
-		Groups:   []string{"system:bootstrappers", "system:nodes"},
+// observeWorkers returns the worker nodes of the cluster, and creates them if
+// they don't exist yet.
+func (e *external) observeWorkers(cr *awscomputev1alpha2.EKSCluster) (*eks.ClusterWorkers, error) {
+	if cr.Status.CloudFormationStackID == "" {
+		workers, err := e.client.CreateWorkerNodes(meta.GetExternalName(cr), cr.Status.ClusterVersion, cr.Spec)
+		if err != nil {
+			return nil, errors.Wrap(err, errCreateWorkers)
+		}
+		cr.Status.CloudFormationStackID = workers.WorkerStackID
 	}
 
-	// Serialize mapRoles
-	roles := make([]awscomputev1alpha2.MapRole, len(instance.Spec.MapRoles))
-	copy(roles, instance.Spec.MapRoles)
-	roles = append(roles, defaultRole)
+	workers, err := e.client.GetWorkerNodes(cr.Status.CloudFormationStackID)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetWorkers)
+	}
+	if failedCFState[workers.WorkersStatus] {
+		return nil, errors.Errorf(errWorkersFailed, workers.WorkersStatus, workers.WorkerReason)
+	}
+	return workers, nil
+}
+
+// awaitUpdate checks on the update of the cluster that is in progress, and
+// forgets about it once it has finished.
+func (e *external) awaitUpdate(cr *awscomputev1alpha2.EKSCluster) error {
+	update, err := e.client.GetUpdate(meta.GetExternalName(cr), cr.Status.UpdateID)
+	if err != nil {
+		return errors.Wrap(err, errGetUpdate)
+	}
 
-	rolesMarshalled, err := yaml.Marshal(roles)
+	switch update.Status {
+	case eks.UpdateStatusInProgress:
+		return nil
+	case eks.UpdateStatusSuccessful:
+		cr.Status.UpdateID = ""
+		return nil
+	default:
+		cr.Status.UpdateID = ""
+		return errors.Errorf(errUpdateFailed, update.ID, update.Type, update.Status, strings.Join(update.Errors, "; "))
+	}
+}
+
+// connectionDetails returns the connection details of the cluster. They are
+// published additively, so a token that is not yet due for a refresh is left
+// out and remains in the connection secret as is.
+func (e *external) connectionDetails(cluster *eks.Cluster, cr *awscomputev1alpha2.EKSCluster) (resource.ConnectionDetails, error) {
+	// Avoid double base64 encoding on secret
+	caData, err := base64.StdEncoding.DecodeString(cluster.CA)
 	if err != nil {
 		return nil, err
 	}
 
-	data[eksAuthMapRolesKey] = string(rolesMarshalled)
+	details := resource.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(cluster.Endpoint),
+		runtimev1alpha1.ResourceCredentialsSecretCAKey:       caData,
+	}
 
-	// Serialize mapUsers
-	if len(instance.Spec.MapUsers) > 0 {
-		usersMarshalled, err := yaml.Marshal(instance.Spec.MapUsers)
+	if cr.Spec.PublishExecKubeconfig {
+		kubeconfig, err := eks.GenerateExecKubeconfig(meta.GetExternalName(cr), cluster.Endpoint, caData)
 		if err != nil {
 			return nil, err
 		}
-		data[eksAuthMapUsersKey] = string(usersMarshalled)
+		details[connectionSecretKubeconfigKey] = kubeconfig
 	}
 
-	name := eksAuthConfigMapName
-	namespace := "kube-system"
-	cm := v1.ConfigMap{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-		},
-		Data: data,
+	exp := cr.Status.TokenExpiration
+	if exp != nil && time.Until(exp.Time) > tokenRefreshWindow {
+		return details, nil
 	}
 
-	return &cm, nil
+	token, err := e.client.ConnectionToken(meta.GetExternalName(cr))
+	if err != nil {
+		return nil, err
+	}
+	details[runtimev1alpha1.ResourceCredentialsTokenKey] = []byte(token.Token)
+	cr.Status.TokenExpiration = &metav1.Time{Time: token.Expiration}
+
+	return details, nil
 }
 
-// _awsauth generates an aws-auth configmap and pushes it to the remote eks cluster to configure auth
-func (r *Reconciler) _awsauth(cluster *eks.Cluster, instance *awscomputev1alpha2.EKSCluster, client eks.Client, workerARN string) error {
-	cm, err := generateAWSAuthConfigMap(instance, workerARN)
-	if err != nil {
-		return err
+func (e *external) Create(ctx context.Context, mg resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mg.(*awscomputev1alpha2.EKSCluster)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errNotEKSCluster)
 	}
 
-	// Sync aws-auth to remote eks cluster to configure it's auth.
-	token, err := client.ConnectionToken(instance.Status.ClusterName)
+	cr.SetConditions(runtimev1alpha1.Creating())
+
+	cluster, err := e.client.Create(meta.GetExternalName(cr), cr.Spec)
 	if err != nil {
-		return err
+		return resource.ExternalCreation{}, errors.Wrap(resource.Ignore(eks.IsErrorResourceInUse, err), errCreateCluster)
 	}
 
-	// Client to eks cluster
-	caData, err := base64.StdEncoding.DecodeString(cluster.CA)
+	cr.Status.State = awscomputev1alpha2.ClusterStatusCreating
+	cr.Status.ClusterName = meta.GetExternalName(cr)
+	cr.Status.ClusterVersion = cluster.Version
+
+	return resource.ExternalCreation{}, nil
+}
+
+// Update starts an update of the cluster if its version or its configuration
+// differ from the spec. The version is brought up to date first, the
+// configuration in a later update.
+func (e *external) Update(ctx context.Context, mg resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mg.(*awscomputev1alpha2.EKSCluster)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errNotEKSCluster)
+	}
+
+	cluster, err := e.client.Get(meta.GetExternalName(cr))
 	if err != nil {
-		return err
+		return resource.ExternalUpdate{}, errors.Wrap(err, errGetCluster)
 	}
 
-	c := rest.Config{
-		Host: cluster.Endpoint,
-		TLSClientConfig: rest.TLSClientConfig{
-			CAData: caData,
-		},
-		BearerToken: token,
+	if !eks.IsClusterVersionUpToDate(cr.Spec, cluster) {
+		updateID, err := e.client.UpdateVersion(meta.GetExternalName(cr), cr.Spec.ClusterVersion)
+		cr.Status.UpdateID = updateID
+		return resource.ExternalUpdate{}, errors.Wrap(err, errUpdateVersion)
 	}
 
-	clientset, err := kubernetes.NewForConfig(&c)
-	if err != nil {
-		return err
+	if !eks.IsClusterConfigUpToDate(cr.Spec, cluster) {
+		updateID, err := e.client.UpdateConfig(meta.GetExternalName(cr), cr.Spec, cluster)
+		cr.Status.UpdateID = updateID
+		return resource.ExternalUpdate{}, errors.Wrap(err, errUpdateConfig)
 	}
 
-	// Create or update aws-auth configmap on eks cluster
-	_, err = clientset.CoreV1().ConfigMaps(cm.Namespace).Create(cm)
-	if err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			_, err = clientset.CoreV1().ConfigMaps(cm.Namespace).Update(cm)
+	return resource.ExternalUpdate{}, nil
+}
+
+// Delete the cluster and its worker nodes.
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*awscomputev1alpha2.EKSCluster)
+	if !ok {
+		return errors.New(errNotEKSCluster)
+	}
+
+	cr.SetConditions(runtimev1alpha1.Deleting())
+
+	var deleteErrors []string
+	// The cluster need not be deleted again once it is being deleted, but its
+	// worker nodes are until their stack is gone in case their deletion failed.
+	if cr.Status.State != awscomputev1alpha2.ClusterStatusDeleting {
+		if err := e.client.Delete(meta.GetExternalName(cr)); err != nil && !eks.IsErrorNotFound(err) {
+			deleteErrors = append(deleteErrors, fmt.Sprintf(errDeleteCluster, err.Error()))
 		}
 	}
 
-	return err
+	if cr.Status.CloudFormationStackID != "" {
+		if err := e.client.DeleteWorkerNodes(cr.Status.CloudFormationStackID); err != nil && !cloudformationclient.IsErrorNotFound(err) {
+			deleteErrors = append(deleteErrors, fmt.Sprintf(errDeleteWorkers, err.Error()))
+		}
+	}
+
+	if len(deleteErrors) > 0 {
+		return errors.New(strings.Join(deleteErrors, ", "))
+	}
+	return nil
 }
 
-func (r *Reconciler) _sync(instance *awscomputev1alpha2.EKSCluster, client eks.Client) (reconcile.Result, error) {
-	cluster, err := client.Get(instance.Status.ClusterName)
-	if err != nil {
-		return r.fail(instance, err)
+// awsAuthEntries are the entries of an aws-auth ConfigMap.
+type awsAuthEntries struct {
+	roles    []awscomputev1alpha2.MapRole
+	users    []awscomputev1alpha2.MapUser
+	accounts []string
+}
+
+// awsAuthOwnership identifies the aws-auth entries the controller owns, so
+// that it can remove them once they are no longer desired without touching
+// the entries it does not own.
+type awsAuthOwnership struct {
+	RoleARNs []string `json:"roleARNs,omitempty"`
+	UserARNs []string `json:"userARNs,omitempty"`
+	Accounts []string `json:"accounts,omitempty"`
+}
+
+// parseAWSAuthEntries parses the entries of aws-auth ConfigMap data
+func parseAWSAuthEntries(data map[string]string) (awsAuthEntries, error) {
+	e := awsAuthEntries{}
+	if err := yaml.Unmarshal([]byte(data[eksAuthMapRolesKey]), &e.roles); err != nil {
+		return e, err
 	}
+	if err := yaml.Unmarshal([]byte(data[eksAuthMapUsersKey]), &e.users); err != nil {
+		return e, err
+	}
+	err := yaml.Unmarshal([]byte(data[eksAuthMapAccountsKey]), &e.accounts)
+	return e, err
+}
 
-	if cluster.Status != awscomputev1alpha2.ClusterStatusActive {
-		instance.Status.SetConditions(runtimev1alpha1.ReconcileSuccess())
+// data serializes the entries to aws-auth ConfigMap data
+func (e awsAuthEntries) data() (map[string]string, error) {
+	data := map[string]string{}
 
-		// Requeue after a short wait to see if the cluster has become ready.
-		return reconcile.Result{RequeueAfter: aShortWait}, nil
+	rolesMarshalled, err := yaml.Marshal(e.roles)
+	if err != nil {
+		return nil, err
 	}
+	data[eksAuthMapRolesKey] = string(rolesMarshalled)
 
-	// Create workers
-	if instance.Status.CloudFormationStackID == "" {
-		clusterWorkers, err := client.CreateWorkerNodes(instance.Status.ClusterName, instance.Status.ClusterVersion, instance.Spec)
+	if len(e.users) > 0 {
+		usersMarshalled, err := yaml.Marshal(e.users)
 		if err != nil {
-			return r.fail(instance, err)
+			return nil, err
 		}
-		instance.Status.CloudFormationStackID = clusterWorkers.WorkerStackID
-		instance.Status.SetConditions(runtimev1alpha1.ReconcileSuccess())
+		data[eksAuthMapUsersKey] = string(usersMarshalled)
+	}
 
-		// We'll likely be requeued implicitly due to the status update, but
-		// otherwise we want to requeue a reconcile after a short wait to check
-		// on the worker node creation.
-		return reconcile.Result{RequeueAfter: aShortWait}, r.Update(ctx, instance)
+	if len(e.accounts) > 0 {
+		accountsMarshalled, err := yaml.Marshal(e.accounts)
+		if err != nil {
+			return nil, err
+		}
+		data[eksAuthMapAccountsKey] = string(accountsMarshalled)
 	}
 
-	clusterWorker, err := client.GetWorkerNodes(instance.Status.CloudFormationStackID)
-	if err != nil {
-		return r.fail(instance, err)
+	return data, nil
+}
+
+// ownership returns the ownership record of the entries
+func (e awsAuthEntries) ownership() awsAuthOwnership {
+	o := awsAuthOwnership{Accounts: e.accounts}
+	for _, r := range e.roles {
+		o.RoleARNs = append(o.RoleARNs, r.RoleARN)
+	}
+	for _, u := range e.users {
+		o.UserARNs = append(o.UserARNs, u.UserARN)
 	}
+	return o
+}
 
-	if failedCFState[clusterWorker.WorkersStatus] {
-		return r.fail(instance, fmt.Errorf("clusterworker stack failed with status %q and reason %q", clusterWorker.WorkersStatus, clusterWorker.WorkerReason))
+// generateAWSAuthConfigMap generates the configmap for configure auth
+func generateAWSAuthConfigMap(instance *awscomputev1alpha2.EKSCluster, workerARN string) (*v1.ConfigMap, error) {
+	defaultRole := awscomputev1alpha2.MapRole{
+		RoleARN:  workerARN,
+		Username: "system:node:{{EC2PrivateDNSName}}",
+		Groups:   []string{"system:bootstrappers", "system:nodes"},
 	}
 
-	if !completedCFState[clusterWorker.WorkersStatus] {
-		instance.Status.SetConditions(runtimev1alpha1.ReconcileSuccess())
+	roles := make([]awscomputev1alpha2.MapRole, len(instance.Spec.MapRoles))
+	copy(roles, instance.Spec.MapRoles)
+	roles = append(roles, defaultRole)
 
-		return reconcile.Result{RequeueAfter: aShortWait}, r.Update(ctx, instance)
+	entries := awsAuthEntries{roles: roles, users: instance.Spec.MapUsers, accounts: instance.Spec.MapAccounts}
+	data, err := entries.data()
+	if err != nil {
+		return nil, err
 	}
 
-	if err := r.awsauth(cluster, instance, client, clusterWorker.WorkerARN); err != nil {
-		return r.fail(instance, errors.Wrap(err, "failed to set auth map on eks"))
+	ownership, err := json.Marshal(entries.ownership())
+	if err != nil {
+		return nil, err
 	}
 
-	if err := r.secret(cluster, instance, client); err != nil {
-		return r.fail(instance, err)
+	name := eksAuthConfigMapName
+	namespace := "kube-system"
+	cm := v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Annotations: map[string]string{eksAuthOwnershipAnnotation: string(ownership)},
+		},
+		Data: data,
 	}
 
-	// update resource status
-	instance.Status.Endpoint = cluster.Endpoint
-	instance.Status.State = awscomputev1alpha2.ClusterStatusActive
-	instance.Status.SetConditions(runtimev1alpha1.Available(), runtimev1alpha1.ReconcileSuccess())
-	resource.SetBindable(instance)
-
-	// Our cluster is available. Requeue speculative yafter a long wait in case
-	// the cluster has changed.
-	return reconcile.Result{RequeueAfter: aLongWait}, r.Update(ctx, instance)
+	return &cm, nil
 }
 
-func (r *Reconciler) _secret(cluster *eks.Cluster, instance *awscomputev1alpha2.EKSCluster, client eks.Client) error {
-	token, err := client.ConnectionToken(instance.Status.ClusterName)
+// mergeAWSAuthConfigMap merges the entries of the desired aws-auth configmap
+// into the current one. Entries the controller owned but no longer desires are
+// removed, while entries it never owned are preserved. It returns true if the
+// current configmap changed.
+func mergeAWSAuthConfigMap(current, desired *v1.ConfigMap) (bool, error) {
+	previous := awsAuthOwnership{}
+	if o, ok := current.GetAnnotations()[eksAuthOwnershipAnnotation]; ok {
+		if err := json.Unmarshal([]byte(o), &previous); err != nil {
+			return false, errors.Wrap(err, errParseAWSAuthOwnership)
+		}
+	}
+
+	have, err := parseAWSAuthEntries(current.Data)
 	if err != nil {
-		return err
+		return false, errors.Wrap(err, errParseAWSAuth)
 	}
 
-	// Avoid double base64 encoding on secret
-	caData, err := base64.StdEncoding.DecodeString(cluster.CA)
+	want, err := parseAWSAuthEntries(desired.Data)
 	if err != nil {
-		return err
+		return false, errors.Wrap(err, errParseAWSAuth)
 	}
 
-	return r.publisher.PublishConnection(ctx, instance, resource.ConnectionDetails{
-		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(cluster.Endpoint),
-		runtimev1alpha1.ResourceCredentialsSecretCAKey:       caData,
-		runtimev1alpha1.ResourceCredentialsTokenKey:          []byte(token),
-	})
+	data, err := mergeAWSAuthEntries(have, want, previous).data()
+	if err != nil {
+		return false, err
+	}
+
+	ownership := desired.GetAnnotations()[eksAuthOwnershipAnnotation]
+	changed := !reflect.DeepEqual(data, current.Data) || current.GetAnnotations()[eksAuthOwnershipAnnotation] != ownership
+
+	current.Data = data
+	meta.AddAnnotations(current, map[string]string{eksAuthOwnershipAnnotation: ownership})
+	return changed, nil
 }
 
-// _delete check reclaim policy and if needed delete the eks cluster resource
-func (r *Reconciler) _delete(instance *awscomputev1alpha2.EKSCluster, client eks.Client) (reconcile.Result, error) {
-	instance.Status.SetConditions(runtimev1alpha1.Deleting())
-	if instance.Spec.ReclaimPolicy == runtimev1alpha1.ReclaimDelete {
-		var deleteErrors []string
-		if err := client.Delete(instance.Status.ClusterName); err != nil && !eks.IsErrorNotFound(err) {
-			deleteErrors = append(deleteErrors, fmt.Sprintf("Master Delete Error: %s", err.Error()))
-		}
+// mergeAWSAuthEntries returns the entries that are not owned by the
+// controller, followed by the entries it wants.
+func mergeAWSAuthEntries(have, want awsAuthEntries, previous awsAuthOwnership) awsAuthEntries {
+	owned := want.ownership()
+	merged := awsAuthEntries{}
 
-		if instance.Status.CloudFormationStackID != "" {
-			if err := client.DeleteWorkerNodes(instance.Status.CloudFormationStackID); err != nil && !cloudformationclient.IsErrorNotFound(err) {
-				deleteErrors = append(deleteErrors, fmt.Sprintf("Worker Delete Error: %s", err.Error()))
-			}
+	for _, r := range have.roles {
+		if !isOwned(r.RoleARN, previous.RoleARNs, owned.RoleARNs) {
+			merged.roles = append(merged.roles, r)
 		}
-
-		if len(deleteErrors) > 0 {
-			return r.fail(instance, errors.New(strings.Join(deleteErrors, ", ")))
+	}
+	for _, u := range have.users {
+		if !isOwned(u.UserARN, previous.UserARNs, owned.UserARNs) {
+			merged.users = append(merged.users, u)
+		}
+	}
+	for _, a := range have.accounts {
+		if !isOwned(a, previous.Accounts, owned.Accounts) {
+			merged.accounts = append(merged.accounts, a)
 		}
 	}
 
-	meta.RemoveFinalizer(instance, finalizer)
-	instance.Status.SetConditions(runtimev1alpha1.ReconcileSuccess())
+	merged.roles = append(merged.roles, want.roles...)
+	merged.users = append(merged.users, want.users...)
+	merged.accounts = append(merged.accounts, want.accounts...)
+	return merged
+}
 
-	// No need to requeue a reconcile if we've successfully asked for the
-	// cluster to be deleted.
-	return reconcile.Result{Requeue: false}, r.Update(ctx, instance)
+// isOwned returns true if the supplied key appears in any of the supplied
+// ownership records
+func isOwned(key string, owned ...[]string) bool {
+	for _, keys := range owned {
+		for _, k := range keys {
+			if k == key {
+				return true
+			}
+		}
+	}
+	return false
 }
 
-// Reconcile reads that state of the cluster for a Provider object and makes changes based on the state read
-// and what is in the Provider.Spec
-func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
-	log.V(logging.Debug).Info("reconciling", "kind", awscomputev1alpha2.EKSClusterKindAPIVersion, "request", request)
-	// Fetch the Provider instance
-	instance := &awscomputev1alpha2.EKSCluster{}
-	err := r.Get(ctx, request.NamespacedName, instance)
+// awsAuthHash returns the hash of the data of an aws-auth configmap
+func awsAuthHash(cm *v1.ConfigMap) (string, error) {
+	// JSON serializes map keys in sorted order, so equal data hashes equally.
+	b, err := json.Marshal(cm.Data)
 	if err != nil {
-		// No need to requeue if the resource no longer exists, otherwise we'll
-		// be requeued because we return an error.
-		return reconcile.Result{}, resource.IgnoreNotFound(err)
+		return "", err
 	}
+	return fmt.Sprintf("%x", sha256.Sum256(b)), nil
+}
 
-	// Create EKS Client
-	eksClient, err := r.connect(instance)
+// applyAWSAuthConfigMap creates the desired aws-auth configmap, or merges it
+// into the existing one
+func applyAWSAuthConfigMap(configMaps corev1client.ConfigMapInterface, desired *v1.ConfigMap) error {
+	current, err := configMaps.Get(desired.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = configMaps.Create(desired)
+		return err
+	}
 	if err != nil {
-		return r.fail(instance, err)
+		return err
 	}
 
-	if !resource.IsConditionTrue(instance.GetCondition(runtimev1alpha1.TypeReferencesResolved)) {
-		if err := r.ResolveReferences(ctx, instance); err != nil {
-			condition := runtimev1alpha1.ReconcileError(err)
-			if resource.IsReferencesAccessError(err) {
-				condition = runtimev1alpha1.ReferenceResolutionBlocked(err)
-			}
+	changed, err := mergeAWSAuthConfigMap(current, desired)
+	if err != nil || !changed {
+		return err
+	}
 
-			instance.Status.SetConditions(condition)
-			return reconcile.Result{RequeueAfter: aLongWait}, errors.Wrap(r.Update(ctx, instance), errUpdateManagedStatus)
-		}
+	// The update is rejected if the configmap changed since we got it, in
+	// which case the merge is retried on the next sync.
+	_, err = configMaps.Update(current)
+	return err
+}
 
-		// Add ReferenceResolutionSuccess to the conditions
-		instance.Status.SetConditions(runtimev1alpha1.ReferenceResolutionSuccess())
+// awsauth generates an aws-auth configmap and merges it into the one of the
+// remote eks cluster to configure auth
+func (e *external) awsauth(cluster *eks.Cluster, cr *awscomputev1alpha2.EKSCluster, workerARN string) error {
+	cm, err := generateAWSAuthConfigMap(cr, workerARN)
+	if err != nil {
+		return err
 	}
 
-	// Add finalizer
-	meta.AddFinalizer(instance, finalizer)
+	// Sync aws-auth to remote eks cluster to configure it's auth.
+	token, err := e.client.ConnectionToken(meta.GetExternalName(cr))
+	if err != nil {
+		return err
+	}
 
-	// Check for deletion
-	if instance.DeletionTimestamp != nil {
-		return r.delete(instance, eksClient)
+	// Client to eks cluster
+	caData, err := base64.StdEncoding.DecodeString(cluster.CA)
+	if err != nil {
+		return err
 	}
 
-	// Create cluster instance
-	if instance.Status.ClusterName == "" {
-		return r.create(instance, eksClient)
+	c := rest.Config{
+		Host: cluster.Endpoint,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAData: caData,
+		},
+		BearerToken: token.Token,
+	}
+
+	clientset, err := e.newKubeClientFn(&c)
+	if err != nil {
+		return err
 	}
 
-	// Sync cluster instance status with cluster status
-	return r.sync(instance, eksClient)
+	if err := applyAWSAuthConfigMap(clientset.CoreV1().ConfigMaps(cm.Namespace), cm); err != nil {
+		return err
+	}
+
+	hash, err := awsAuthHash(cm)
+	if err != nil {
+		return err
+	}
+	cr.Status.AWSAuthHash = hash
+	return nil
 }
@@ -19,10 +19,13 @@ package compute
 import (
 	"context"
 	"encoding/base64"
-	"fmt"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/ghodss/yaml"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
@@ -30,9 +33,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	. "sigs.k8s.io/controller-runtime/pkg/client/fake"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/crossplaneio/stack-aws/apis"
 	. "github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
@@ -40,6 +46,7 @@ import (
 	"github.com/crossplaneio/stack-aws/pkg/clients/eks/fake"
 
 	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
 	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
 	"github.com/crossplaneio/crossplane-runtime/pkg/test"
 )
@@ -48,24 +55,34 @@ const (
 	namespace    = "default"
 	providerName = "test-provider"
 	clusterName  = "test-cluster"
+
+	fakeStackID   = "fake-stack-id"
+	fakeWorkerARN = "fake-worker-arn"
+	fakeUpdateID  = "fake-update-id"
+	fakeEndpoint  = "https://test-ep"
 )
 
 var (
-	key = types.NamespacedName{
-		Namespace: namespace,
-		Name:      clusterName,
-	}
-	request = reconcile.Request{
-		NamespacedName: key,
-	}
+	deletionTimestamp = metav1.Now()
+	ctx               = context.Background()
+	errorBoom         = errors.New("boom")
+
+	errNotFound = awserr.New(awseks.ErrCodeResourceNotFoundException, "", nil)
+	errInUse    = awserr.New(awseks.ErrCodeResourceInUseException, "", nil)
+
+	fakeCA = []byte("test-ca")
 )
 
 func init() {
 	_ = apis.AddToScheme(scheme.Scheme)
 }
 
+// Test that our external client and connector satisfy their interfaces.
+var _ resource.ExternalClient = &external{}
+var _ resource.ExternalConnecter = &connector{}
+
 func testCluster() *EKSCluster {
-	return &EKSCluster{
+	cr := &EKSCluster{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      clusterName,
 			Namespace: namespace,
@@ -78,15 +95,80 @@ func testCluster() *EKSCluster {
 			},
 		},
 	}
+	meta.SetExternalName(cr, clusterName)
+	return cr
 }
 
-// assertResource a helper function to check on cluster and its status
-func assertResource(g *GomegaWithT, r *Reconciler, s runtimev1alpha1.ConditionedStatus) *EKSCluster {
-	rc := &EKSCluster{}
-	err := r.Get(ctx, key, rc)
-	g.Expect(err).To(BeNil())
-	g.Expect(cmp.Diff(s, rc.Status.ConditionedStatus, test.EquateConditions())).Should(BeZero())
-	return rc
+type eksClusterModifier func(*EKSCluster)
+
+func withConditions(c ...runtimev1alpha1.Condition) eksClusterModifier {
+	return func(cr *EKSCluster) { cr.Status.ConditionedStatus.Conditions = c }
+}
+
+func withBindingPhase(p runtimev1alpha1.BindingPhase) eksClusterModifier {
+	return func(cr *EKSCluster) { cr.Status.SetBindingPhase(p) }
+}
+
+func withObservedCluster(c *eks.Cluster) eksClusterModifier {
+	return func(cr *EKSCluster) {
+		cr.Status.ClusterName = c.Name
+		cr.Status.State = c.Status
+		cr.Status.Endpoint = c.Endpoint
+		cr.Status.ClusterVersion = c.Version
+	}
+}
+
+func withStackID(id string) eksClusterModifier {
+	return func(cr *EKSCluster) { cr.Status.CloudFormationStackID = id }
+}
+
+func withUpdateID(id string) eksClusterModifier {
+	return func(cr *EKSCluster) { cr.Status.UpdateID = id }
+}
+
+func withTokenExpiration(t time.Time) eksClusterModifier {
+	return func(cr *EKSCluster) { cr.Status.TokenExpiration = &metav1.Time{Time: t} }
+}
+
+func withAWSAuthHash(cr *EKSCluster) {
+	cm, _ := generateAWSAuthConfigMap(cr, fakeWorkerARN)
+	cr.Status.AWSAuthHash, _ = awsAuthHash(cm)
+}
+
+func withClusterVersion(v string) eksClusterModifier {
+	return func(cr *EKSCluster) { cr.Spec.ClusterVersion = v }
+}
+
+func withDeletionTimestamp(cr *EKSCluster) {
+	cr.SetDeletionTimestamp(&deletionTimestamp)
+}
+
+func eksCluster(m ...eksClusterModifier) *EKSCluster {
+	cr := testCluster()
+	for _, mod := range m {
+		mod(cr)
+	}
+	return cr
+}
+
+func activeCluster() *eks.Cluster {
+	return &eks.Cluster{
+		Name:     clusterName,
+		Status:   ClusterStatusActive,
+		Endpoint: fakeEndpoint,
+		CA:       base64.StdEncoding.EncodeToString(fakeCA),
+		Version:  "1.14",
+	}
+}
+
+func clusterWithStatus(s string) *eks.Cluster {
+	c := activeCluster()
+	c.Status = s
+	return c
+}
+
+func fakeKubeClient(*rest.Config) (kubernetes.Interface, error) {
+	return kubefake.NewSimpleClientset(), nil
 }
 
 func TestGenerateEksAuth(t *testing.T) {
@@ -143,473 +225,706 @@ func TestGenerateEksAuth(t *testing.T) {
 	g.Expect(outputUsers).To(Equal(expectUsers))
 }
 
-func TestCreate(t *testing.T) {
+func TestMergeAWSAuthConfigMap(t *testing.T) {
 	g := NewGomegaWithT(t)
+	workerARN := "test-arn"
 
-	test := func(cluster *EKSCluster, client eks.Client, expectedResult reconcile.Result, expectedStatus runtimev1alpha1.ConditionedStatus) *EKSCluster {
-		r := &Reconciler{
-			Client:                   NewFakeClient(cluster),
-			ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient(cluster)),
-		}
-
-		rs, err := r._create(cluster, client)
-		g.Expect(rs).To(Equal(expectedResult))
-		g.Expect(err).To(BeNil())
-		return assertResource(g, r, expectedStatus)
+	ownedRole := MapRole{
+		RoleARN:  "arn:aws:iam::000000000000:role/KubernetesAdmin",
+		Username: "kubernetes-admin",
+		Groups:   []string{"system:masters"},
 	}
-
-	// new cluster
-	cluster := testCluster()
-	cluster.ObjectMeta.UID = types.UID("test-uid")
-
-	client := &fake.MockEKSClient{
-		MockCreate: func(string, EKSClusterSpec) (*eks.Cluster, error) { return &eks.Cluster{}, nil },
+	removedRole := MapRole{
+		RoleARN:  "arn:aws:iam::000000000000:role/KubernetesViewer",
+		Username: "kubernetes-viewer",
+		Groups:   []string{"view"},
+	}
+	foreignRole := MapRole{
+		RoleARN:  "arn:aws:iam::000000000000:role/ManagedNodeGroup",
+		Username: "system:node:{{EC2PrivateDNSName}}",
+		Groups:   []string{"system:bootstrappers", "system:nodes"},
 	}
 
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileSuccess())
+	// the cluster used to want the removed role and the account
+	previous := testCluster()
+	previous.Spec.MapRoles = []MapRole{ownedRole, removedRole}
+	previous.Spec.MapAccounts = []string{"000000000000"}
+	current, err := generateAWSAuthConfigMap(previous, workerARN)
+	g.Expect(err).To(BeNil())
 
-	reconciledCluster := test(cluster, client, reconcile.Result{RequeueAfter: aShortWait}, expectedStatus)
+	// someone else added a role since
+	var currentRoles []MapRole
+	g.Expect(yaml.Unmarshal([]byte(current.Data["mapRoles"]), &currentRoles)).To(Succeed())
+	currentRoles = append(currentRoles, foreignRole)
+	rolesMarshalled, err := yaml.Marshal(currentRoles)
+	g.Expect(err).To(BeNil())
+	current.Data["mapRoles"] = string(rolesMarshalled)
 
-	g.Expect(reconciledCluster.Status.ClusterName).To(Equal(fmt.Sprintf("%s%s", clusterNamePrefix, cluster.UID)))
-	g.Expect(reconciledCluster.Status.State).To(Equal(ClusterStatusCreating))
+	cluster := testCluster()
+	cluster.Spec.MapRoles = []MapRole{ownedRole}
+	cluster.Spec.MapAccounts = []string{"111111111111"}
+	desired, err := generateAWSAuthConfigMap(cluster, workerARN)
+	g.Expect(err).To(BeNil())
 
-	// cluster create error - bad request
-	cluster = testCluster()
-	cluster.ObjectMeta.UID = types.UID("test-uid")
-	errorBadRequest := errors.New("InvalidParameterException")
-	client.MockCreate = func(string, EKSClusterSpec) (*eks.Cluster, error) {
-		return &eks.Cluster{}, errorBadRequest
-	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileError(errorBadRequest))
+	changed, err := mergeAWSAuthConfigMap(current, desired)
+	g.Expect(err).To(BeNil())
+	g.Expect(changed).To(BeTrue())
 
-	reconciledCluster = test(cluster, client, reconcile.Result{}, expectedStatus)
-	g.Expect(reconciledCluster.Finalizers).To(BeEmpty())
-	g.Expect(reconciledCluster.Status.ClusterName).To(BeEmpty())
-	g.Expect(reconciledCluster.Status.State).To(BeEmpty())
-	g.Expect(reconciledCluster.Status.CloudFormationStackID).To(BeEmpty())
+	var outputRoles []MapRole
+	g.Expect(yaml.Unmarshal([]byte(current.Data["mapRoles"]), &outputRoles)).To(Succeed())
+	g.Expect(outputRoles).To(Equal([]MapRole{foreignRole, ownedRole, {
+		RoleARN:  workerARN,
+		Username: "system:node:{{EC2PrivateDNSName}}",
+		Groups:   []string{"system:bootstrappers", "system:nodes"},
+	}}))
 
-	// cluster create error - other
-	cluster = testCluster()
-	cluster.ObjectMeta.UID = types.UID("test-uid")
-	errorOther := errors.New("other")
-	client.MockCreate = func(string, EKSClusterSpec) (*eks.Cluster, error) {
-		return &eks.Cluster{}, errorOther
-	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileError(errorOther))
+	var outputAccounts []string
+	g.Expect(yaml.Unmarshal([]byte(current.Data["mapAccounts"]), &outputAccounts)).To(Succeed())
+	g.Expect(outputAccounts).To(Equal([]string{"111111111111"}))
+	g.Expect(current.Annotations).To(Equal(desired.Annotations))
 
-	reconciledCluster = test(cluster, client, reconcile.Result{RequeueAfter: aShortWait}, expectedStatus)
-	g.Expect(reconciledCluster.Finalizers).To(BeEmpty())
-	g.Expect(reconciledCluster.Status.ClusterName).To(BeEmpty())
-	g.Expect(reconciledCluster.Status.State).To(BeEmpty())
-	g.Expect(reconciledCluster.Status.CloudFormationStackID).To(BeEmpty())
+	// merging again changes nothing
+	changed, err = mergeAWSAuthConfigMap(current, desired)
+	g.Expect(err).To(BeNil())
+	g.Expect(changed).To(BeFalse())
 }
 
-func TestSync(t *testing.T) {
+func TestApplyAWSAuthConfigMap(t *testing.T) {
 	g := NewGomegaWithT(t)
-	fakeStackID := "fake-stack-id"
-
-	test := func(tc *EKSCluster, cl *fake.MockEKSClient, sec func(*eks.Cluster, *EKSCluster, eks.Client) error, auth func(*eks.Cluster, *EKSCluster, eks.Client, string) error,
-		rslt reconcile.Result, exp runtimev1alpha1.ConditionedStatus) *EKSCluster {
-		r := &Reconciler{
-			Client:                   NewFakeClient(tc),
-			secret:                   sec,
-			awsauth:                  auth,
-			ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient()),
-		}
 
-		rs, err := r._sync(tc, cl)
-		g.Expect(rs).To(Equal(rslt))
-		g.Expect(err).NotTo(HaveOccurred())
-		return assertResource(g, r, exp)
-	}
+	cluster := testCluster()
+	cluster.Spec.MapUsers = []MapUser{{
+		UserARN:  "arn:aws:iam::000000000000:user/Alice",
+		Username: "alice",
+		Groups:   []string{"system:masters"},
+	}}
+	desired, err := generateAWSAuthConfigMap(cluster, "test-arn")
+	g.Expect(err).To(BeNil())
 
-	fakeWorkerARN := "fake-worker-arn"
-	mockClusterWorker := eks.ClusterWorkers{
-		WorkerStackID: fakeStackID,
-		WorkerARN:     fakeWorkerARN,
+	// the configmap is created if it does not exist
+	configMaps := kubefake.NewSimpleClientset().CoreV1().ConfigMaps(desired.Namespace)
+	g.Expect(applyAWSAuthConfigMap(configMaps, desired)).To(Succeed())
+	got, err := configMaps.Get(desired.Name, metav1.GetOptions{})
+	g.Expect(err).To(BeNil())
+	g.Expect(got.Data).To(Equal(desired.Data))
+
+	// entries the controller does not own survive an update
+	foreign := "- groups:\n  - view\n  rolearn: arn:aws:iam::000000000000:role/Viewer\n  username: viewer\n"
+	existing := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: desired.Name, Namespace: desired.Namespace},
+		Data:       map[string]string{"mapRoles": foreign},
 	}
+	configMaps = kubefake.NewSimpleClientset(existing).CoreV1().ConfigMaps(desired.Namespace)
+	g.Expect(applyAWSAuthConfigMap(configMaps, desired)).To(Succeed())
+	got, err = configMaps.Get(desired.Name, metav1.GetOptions{})
+	g.Expect(err).To(BeNil())
+	g.Expect(got.Data["mapRoles"]).To(HavePrefix(foreign))
+	g.Expect(got.Data["mapUsers"]).To(Equal(desired.Data["mapUsers"]))
+	g.Expect(got.Annotations).To(Equal(desired.Annotations))
+}
 
-	// error retrieving the cluster
-	errorGet := errors.New("retrieving cluster")
-	cl := &fake.MockEKSClient{
-		MockGet: func(string) (*eks.Cluster, error) {
-			return nil, errorGet
+func TestConnect(t *testing.T) {
+	type args struct {
+		newClientFn func(*aws.Config) eks.Client
+		awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
+		cr          resource.Managed
+	}
+	type want struct {
+		err error
+	}
+
+	cases := map[string]struct {
+		args args
+		want want
+	}{
+		"Successful": {
+			args: args{
+				newClientFn: func(*aws.Config) eks.Client { return &fake.MockEKSClient{} },
+				awsConfigFn: func(_ context.Context, _ client.Reader, p *corev1.ObjectReference, region string) (*aws.Config, error) {
+					return &aws.Config{}, nil
+				},
+				cr: eksCluster(),
+			},
+		},
+		"NotEKSCluster": {
+			args: args{
+				cr: &NodeGroup{},
+			},
+			want: want{err: errors.New(errNotEKSCluster)},
+		},
+		"AWSConfigFailed": {
+			args: args{
+				awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+					return nil, errorBoom
+				},
+				cr: eksCluster(),
+			},
+			want: want{err: errorBoom},
 		},
-		MockCreateWorkerNodes: func(string, string, EKSClusterSpec) (*eks.ClusterWorkers, error) { return &mockClusterWorker, nil },
 	}
 
-	cl.MockGetWorkerNodes = func(string) (*eks.ClusterWorkers, error) {
-		return &eks.ClusterWorkers{
-			WorkersStatus: cloudformation.StackStatusCreateInProgress,
-			WorkerReason:  "",
-			WorkerStackID: fakeStackID}, nil
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{newClientFn: tc.args.newClientFn, awsConfigFn: tc.args.awsConfigFn}
+			_, err := c.Connect(ctx, tc.args.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("c.Connect(...): -want error, +got error:\n%s", diff)
+			}
+		})
 	}
+}
 
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(errorGet))
-	tc := testCluster()
-	test(tc, cl, nil, nil, reconcile.Result{RequeueAfter: aShortWait}, expectedStatus)
+func TestInitialize(t *testing.T) {
+	legacy := eksCluster(func(cr *EKSCluster) {
+		meta.SetExternalName(cr, "")
+		cr.Status.ClusterName = "eks-test-uid"
+		cr.Finalizers = []string{legacyFinalizer}
+	})
 
-	// cluster is not ready
-	cl.MockGet = func(string) (*eks.Cluster, error) {
-		return &eks.Cluster{
-			Status: ClusterStatusCreating,
-		}, nil
+	kube := NewFakeClient(legacy)
+	i := resource.InitializerChain{&legacyClusterNameAdopter{client: kube}, &legacyFinalizerRemover{client: kube}}
+	if err := i.Initialize(ctx, legacy); err != nil {
+		t.Fatalf("i.Initialize(...): %s", err)
 	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	tc = testCluster()
-	test(tc, cl, nil, nil, reconcile.Result{RequeueAfter: aShortWait}, expectedStatus)
 
-	// cluster is ready, but lets create workers that error
-	cl.MockGet = func(string) (*eks.Cluster, error) {
-		return &eks.Cluster{
-			Status: ClusterStatusActive,
-		}, nil
+	got := &EKSCluster{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: clusterName}, got); err != nil {
+		t.Fatalf("kube.Get(...): %s", err)
 	}
-
-	errorCreateNodes := errors.New("create nodes")
-	cl.MockCreateWorkerNodes = func(string, string, EKSClusterSpec) (*eks.ClusterWorkers, error) {
-		return nil, errorCreateNodes
+	if diff := cmp.Diff("eks-test-uid", meta.GetExternalName(got)); diff != "" {
+		t.Errorf("i.Initialize(...): -want external name, +got external name:\n%s", diff)
 	}
-
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(errorCreateNodes))
-	tc = testCluster()
-	reconciledCluster := test(tc, cl, nil, nil, reconcile.Result{RequeueAfter: aShortWait}, expectedStatus)
-	g.Expect(reconciledCluster.Status.CloudFormationStackID).To(BeEmpty())
-
-	// cluster is ready, lets create workers
-	cl.MockGet = func(string) (*eks.Cluster, error) {
-		return &eks.Cluster{
-			Status: ClusterStatusActive,
-		}, nil
+	if meta.FinalizerExists(got, legacyFinalizer) {
+		t.Errorf("i.Initialize(...): want legacy finalizer removed, got %v", got.Finalizers)
 	}
 
-	cl.MockCreateWorkerNodes = func(string, string, EKSClusterSpec) (*eks.ClusterWorkers, error) {
-		return &eks.ClusterWorkers{WorkerStackID: fakeStackID}, nil
+	// the external name of a cluster that is not a legacy one is left to the
+	// managed reconciler
+	cr := eksCluster(func(cr *EKSCluster) { meta.SetExternalName(cr, "") })
+	if err := i.Initialize(ctx, cr); err != nil {
+		t.Fatalf("i.Initialize(...): %s", err)
 	}
-
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileSuccess())
-	tc = testCluster()
-	reconciledCluster = test(tc, cl, nil, nil, reconcile.Result{RequeueAfter: aShortWait}, expectedStatus)
-	g.Expect(reconciledCluster.Status.CloudFormationStackID).To(Equal(fakeStackID))
-
-	// cluster is ready, but auth sync failed
-	cl.MockGetWorkerNodes = func(string) (*eks.ClusterWorkers, error) {
-		return &eks.ClusterWorkers{
-			WorkersStatus: cloudformation.StackStatusCreateComplete,
-			WorkerReason:  "",
-			WorkerStackID: fakeStackID,
-			WorkerARN:     fakeWorkerARN,
-		}, nil
+	if diff := cmp.Diff("", meta.GetExternalName(cr)); diff != "" {
+		t.Errorf("i.Initialize(...): -want external name, +got external name:\n%s", diff)
 	}
+}
 
-	errorAuth := errors.New("auth")
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(errors.Wrap(errorAuth, "failed to set auth map on eks")))
-	tc = testCluster()
-	tc.Status.CloudFormationStackID = fakeStackID
-	auth := func(*eks.Cluster, *EKSCluster, eks.Client, string) error {
-		return errorAuth
-
+func TestObserve(t *testing.T) {
+	expiration := time.Now().Add(14 * time.Minute)
+	token := func(string) (*eks.Token, error) {
+		return &eks.Token{Token: "test-token", Expiration: expiration}, nil
 	}
-	test(tc, cl, nil, auth, reconcile.Result{RequeueAfter: aShortWait}, expectedStatus)
-
-	// cluster is ready, but secret failed
-	cl.MockGetWorkerNodes = func(string) (*eks.ClusterWorkers, error) {
-		return &eks.ClusterWorkers{
-			WorkersStatus: cloudformation.StackStatusCreateComplete,
-			WorkerReason:  "",
-			WorkerStackID: fakeStackID,
-			WorkerARN:     fakeWorkerARN,
-		}, nil
+	workersWithStatus := func(s cloudformation.StackStatus) func(string) (*eks.ClusterWorkers, error) {
+		return func(string) (*eks.ClusterWorkers, error) {
+			return &eks.ClusterWorkers{WorkersStatus: s, WorkerReason: "reason", WorkerStackID: fakeStackID, WorkerARN: fakeWorkerARN}, nil
+		}
 	}
-
-	auth = func(*eks.Cluster, *EKSCluster, eks.Client, string) error {
-		return nil
+	getCluster := func(c *eks.Cluster) func(string) (*eks.Cluster, error) {
+		return func(string) (*eks.Cluster, error) { return c, nil }
 	}
-
-	errorSecret := errors.New("secret")
-	fSec := func(*eks.Cluster, *EKSCluster, eks.Client) error {
-		return errorSecret
+	getUpdate := func(s string) func(string, string) (*eks.ClusterUpdate, error) {
+		return func(string, string) (*eks.ClusterUpdate, error) {
+			return &eks.ClusterUpdate{ID: fakeUpdateID, Type: "VersionUpdate", Status: s, Errors: []string{"code: message"}}, nil
+		}
 	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(errorSecret))
-	tc = testCluster()
-	tc.Status.CloudFormationStackID = fakeStackID
-	test(tc, cl, fSec, auth, reconcile.Result{RequeueAfter: aShortWait}, expectedStatus)
-
-	// cluster is ready
-	fSec = func(*eks.Cluster, *EKSCluster, eks.Client) error {
-		return nil
+	details := resource.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(fakeEndpoint),
+		runtimev1alpha1.ResourceCredentialsSecretCAKey:       fakeCA,
+		runtimev1alpha1.ResourceCredentialsTokenKey:          []byte("test-token"),
+	}
+	available := []eksClusterModifier{
+		withObservedCluster(activeCluster()),
+		withStackID(fakeStackID),
+		withConditions(runtimev1alpha1.Available()),
+		withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+		withTokenExpiration(expiration),
+		withAWSAuthHash,
+	}
+
+	type want struct {
+		cr          *EKSCluster
+		observation resource.ExternalObservation
+		err         error
+	}
+
+	cases := map[string]struct {
+		client          *fake.MockEKSClient
+		newKubeClientFn func(*rest.Config) (kubernetes.Interface, error)
+		cr              *EKSCluster
+		want            want
+	}{
+		"NotFound": {
+			client: &fake.MockEKSClient{MockGet: func(string) (*eks.Cluster, error) { return nil, errNotFound }},
+			cr:     eksCluster(),
+			want:   want{cr: eksCluster()},
+		},
+		"GetFailed": {
+			client: &fake.MockEKSClient{MockGet: func(string) (*eks.Cluster, error) { return nil, errorBoom }},
+			cr:     eksCluster(),
+			want:   want{cr: eksCluster(), err: errors.Wrap(errorBoom, errGetCluster)},
+		},
+		"Creating": {
+			client: &fake.MockEKSClient{MockGet: getCluster(clusterWithStatus(ClusterStatusCreating))},
+			cr:     eksCluster(),
+			want: want{
+				cr: eksCluster(
+					withObservedCluster(clusterWithStatus(ClusterStatusCreating)),
+					withConditions(runtimev1alpha1.Creating()),
+				),
+				observation: resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Failed": {
+			client: &fake.MockEKSClient{MockGet: getCluster(clusterWithStatus(ClusterStatusFailed))},
+			cr:     eksCluster(),
+			want: want{
+				cr: eksCluster(
+					withObservedCluster(clusterWithStatus(ClusterStatusFailed)),
+					withConditions(runtimev1alpha1.Unavailable()),
+				),
+				observation: resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"Deleted": {
+			client: &fake.MockEKSClient{MockGet: getCluster(activeCluster())},
+			cr:     eksCluster(withDeletionTimestamp),
+			want: want{
+				cr: eksCluster(
+					withDeletionTimestamp,
+					withObservedCluster(activeCluster()),
+					withConditions(runtimev1alpha1.Deleting()),
+				),
+				observation: resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"CreateWorkers": {
+			client: &fake.MockEKSClient{
+				MockGet: getCluster(activeCluster()),
+				MockCreateWorkerNodes: func(name string, version string, _ EKSClusterSpec) (*eks.ClusterWorkers, error) {
+					if name != clusterName || version != "1.14" {
+						return nil, errors.Errorf("unexpected cluster %s of version %s", name, version)
+					}
+					return &eks.ClusterWorkers{WorkerStackID: fakeStackID}, nil
+				},
+				MockGetWorkerNodes: workersWithStatus(cloudformation.StackStatusCreateInProgress),
+			},
+			cr: eksCluster(),
+			want: want{
+				cr: eksCluster(
+					withObservedCluster(activeCluster()),
+					withStackID(fakeStackID),
+					withConditions(runtimev1alpha1.Creating()),
+				),
+				observation: resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"CreateWorkersFailed": {
+			client: &fake.MockEKSClient{
+				MockGet:               getCluster(activeCluster()),
+				MockCreateWorkerNodes: func(string, string, EKSClusterSpec) (*eks.ClusterWorkers, error) { return nil, errorBoom },
+			},
+			cr: eksCluster(),
+			want: want{
+				cr:  eksCluster(withObservedCluster(activeCluster())),
+				err: errors.Wrap(errorBoom, errCreateWorkers),
+			},
+		},
+		"WorkersFailed": {
+			client: &fake.MockEKSClient{
+				MockGet:            getCluster(activeCluster()),
+				MockGetWorkerNodes: workersWithStatus(cloudformation.StackStatusRollbackComplete),
+			},
+			cr: eksCluster(withStackID(fakeStackID)),
+			want: want{
+				cr:  eksCluster(withObservedCluster(activeCluster()), withStackID(fakeStackID)),
+				err: errors.Errorf(errWorkersFailed, cloudformation.StackStatusRollbackComplete, "reason"),
+			},
+		},
+		"AWSAuthFailed": {
+			client: &fake.MockEKSClient{
+				MockGet:             getCluster(activeCluster()),
+				MockGetWorkerNodes:  workersWithStatus(cloudformation.StackStatusCreateComplete),
+				MockConnectionToken: token,
+			},
+			newKubeClientFn: func(*rest.Config) (kubernetes.Interface, error) { return nil, errorBoom },
+			cr:              eksCluster(withStackID(fakeStackID)),
+			want: want{
+				cr:  eksCluster(withObservedCluster(activeCluster()), withStackID(fakeStackID)),
+				err: errors.Wrap(errorBoom, errAWSAuth),
+			},
+		},
+		"Available": {
+			client: &fake.MockEKSClient{
+				MockGet:             getCluster(activeCluster()),
+				MockGetWorkerNodes:  workersWithStatus(cloudformation.StackStatusCreateComplete),
+				MockConnectionToken: token,
+			},
+			newKubeClientFn: fakeKubeClient,
+			cr:              eksCluster(withStackID(fakeStackID)),
+			want: want{
+				cr:          eksCluster(available...),
+				observation: resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: details},
+			},
+		},
+		"OutdatedVersion": {
+			client: &fake.MockEKSClient{
+				MockGet:             getCluster(activeCluster()),
+				MockGetWorkerNodes:  workersWithStatus(cloudformation.StackStatusCreateComplete),
+				MockConnectionToken: token,
+			},
+			newKubeClientFn: fakeKubeClient,
+			cr:              eksCluster(withStackID(fakeStackID), withClusterVersion("1.15")),
+			want: want{
+				cr:          eksCluster(append(available, withClusterVersion("1.15"))...),
+				observation: resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: details},
+			},
+		},
+		"UpdateInProgress": {
+			client: &fake.MockEKSClient{
+				MockGet:             getCluster(clusterWithStatus(ClusterStatusUpdating)),
+				MockGetUpdate:       getUpdate(eks.UpdateStatusInProgress),
+				MockGetWorkerNodes:  workersWithStatus(cloudformation.StackStatusCreateComplete),
+				MockConnectionToken: token,
+			},
+			newKubeClientFn: fakeKubeClient,
+			cr:              eksCluster(withStackID(fakeStackID), withClusterVersion("1.15"), withUpdateID(fakeUpdateID)),
+			want: want{
+				cr: eksCluster(append(available,
+					withObservedCluster(clusterWithStatus(ClusterStatusUpdating)),
+					withClusterVersion("1.15"),
+					withUpdateID(fakeUpdateID))...),
+				observation: resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: details},
+			},
+		},
+		"UpdateSuccessful": {
+			client: &fake.MockEKSClient{
+				MockGet:             getCluster(activeCluster()),
+				MockGetUpdate:       getUpdate(eks.UpdateStatusSuccessful),
+				MockGetWorkerNodes:  workersWithStatus(cloudformation.StackStatusCreateComplete),
+				MockConnectionToken: token,
+			},
+			newKubeClientFn: fakeKubeClient,
+			cr:              eksCluster(withStackID(fakeStackID), withUpdateID(fakeUpdateID)),
+			want: want{
+				cr:          eksCluster(available...),
+				observation: resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: details},
+			},
+		},
+		"UpdateFailed": {
+			client: &fake.MockEKSClient{
+				MockGet:       getCluster(activeCluster()),
+				MockGetUpdate: getUpdate(eks.UpdateStatusFailed),
+			},
+			cr: eksCluster(withStackID(fakeStackID), withUpdateID(fakeUpdateID)),
+			want: want{
+				cr:  eksCluster(withObservedCluster(activeCluster()), withStackID(fakeStackID)),
+				err: errors.Errorf(errUpdateFailed, fakeUpdateID, "VersionUpdate", eks.UpdateStatusFailed, "code: message"),
+			},
+		},
 	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Available(), runtimev1alpha1.ReconcileSuccess())
-	tc = testCluster()
-	tc.Status.CloudFormationStackID = fakeStackID
-	test(tc, cl, fSec, auth, reconcile.Result{RequeueAfter: aLongWait}, expectedStatus)
-}
 
-func TestSecret(t *testing.T) {
-	clusterCA := []byte("test-ca")
-	cluster := &eks.Cluster{
-		Status:   ClusterStatusActive,
-		Endpoint: "test-ep",
-		CA:       base64.StdEncoding.EncodeToString(clusterCA),
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, newKubeClientFn: tc.newKubeClientFn}
+			o, err := e.Observe(ctx, tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.observation, o); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("e.Observe(...): -want cr, +got cr:\n%s", diff)
+			}
+		})
 	}
+}
 
-	r := &Reconciler{
-		publisher: resource.ManagedConnectionPublisherFns{
-			PublishConnectionFn: func(_ context.Context, _ resource.Managed, got resource.ConnectionDetails) error {
-				want := resource.ConnectionDetails{
-					runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(cluster.Endpoint),
-					runtimev1alpha1.ResourceCredentialsSecretCAKey:       clusterCA,
-					runtimev1alpha1.ResourceCredentialsTokenKey:          []byte("test-token"),
-				}
-
-				if diff := cmp.Diff(want, got); diff != "" {
-					t.Errorf("-want, +got\n%s", diff)
-				}
-
-				return nil
-			},
-		},
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient()),
-	}
+func TestConnectionDetails(t *testing.T) {
+	cluster := activeCluster()
+	expiration := time.Now().Add(14 * time.Minute)
 
 	tc := testCluster()
 	client := &fake.MockEKSClient{}
+	e := &external{client: client}
 
 	// Ensure we return an error when we can't get a new token.
 	testError := "test-connection-token-error"
-	client.MockConnectionToken = func(string) (string, error) { return "", errors.New(testError) }
+	client.MockConnectionToken = func(string) (*eks.Token, error) { return nil, errors.New(testError) }
 	want := errors.New(testError)
-	got := r._secret(cluster, tc, client)
+	_, got := e.connectionDetails(cluster, tc)
 	if diff := cmp.Diff(want, got, test.EquateErrors()); diff != "" {
-		t.Errorf("r._secret(...): -want error, +got error:\n%s", diff)
+		t.Errorf("e.connectionDetails(...): -want error, +got error:\n%s", diff)
 	}
-
-	// Ensure we don't return an error when we can get a new token.
-	client.MockConnectionToken = func(string) (string, error) { return "test-token", nil }
-	if err := r._secret(cluster, tc, client); err != nil {
-		t.Errorf("r._secret(...): %s", err)
+	if tc.Status.TokenExpiration != nil {
+		t.Errorf("e.connectionDetails(...): want no token expiration, got %s", tc.Status.TokenExpiration)
 	}
-}
-
-func TestDelete(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	test := func(cluster *EKSCluster, client eks.Client, expectedResult reconcile.Result, expectedStatus runtimev1alpha1.ConditionedStatus) *EKSCluster {
-		r := &Reconciler{
-			Client:                   NewFakeClient(cluster),
-			ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient(cluster)),
-		}
 
-		rs, err := r._delete(cluster, client)
-		g.Expect(rs).To(Equal(expectedResult))
-		g.Expect(err).To(BeNil())
-		return assertResource(g, r, expectedStatus)
+	// Ensure we include a new token and track its expiration when we can get
+	// a new token.
+	client.MockConnectionToken = func(string) (*eks.Token, error) {
+		return &eks.Token{Token: "test-token", Expiration: expiration}, nil
 	}
-
-	// reclaim - delete
-	cluster := testCluster()
-	cluster.Finalizers = []string{finalizer}
-	cluster.Spec.ReclaimPolicy = runtimev1alpha1.ReclaimDelete
-	cluster.Status.CloudFormationStackID = "fake-stack-id"
-	cluster.Status.SetConditions(runtimev1alpha1.Available())
-
-	client := &fake.MockEKSClient{}
-	client.MockDelete = func(string) error { return nil }
-	client.MockDeleteWorkerNodes = func(string) error { return nil }
-
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Deleting(), runtimev1alpha1.ReconcileSuccess())
-
-	reconciledCluster := test(cluster, client, reconcile.Result{}, expectedStatus)
-	g.Expect(reconciledCluster.Finalizers).To(BeEmpty())
-
-	// reclaim - retain
-	cluster.Spec.ReclaimPolicy = runtimev1alpha1.ReclaimRetain
-	cluster.Status.SetConditions(runtimev1alpha1.Available())
-	cluster.Finalizers = []string{finalizer}
-	client.MockDelete = nil // should not be called
-
-	reconciledCluster = test(cluster, client, reconcile.Result{}, expectedStatus)
-	g.Expect(reconciledCluster.Finalizers).To(BeEmpty())
-
-	// reclaim - delete, delete error
-	cluster.Spec.ReclaimPolicy = runtimev1alpha1.ReclaimDelete
-	cluster.Status.SetConditions(runtimev1alpha1.Available())
-	cluster.Finalizers = []string{finalizer}
-	errorDelete := errors.New("test-delete-error")
-	client.MockDelete = func(string) error { return errorDelete }
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(
-		runtimev1alpha1.Deleting(),
-		runtimev1alpha1.ReconcileError(errors.Wrap(errorDelete, "Master Delete Error")),
-	)
-
-	reconciledCluster = test(cluster, client, reconcile.Result{RequeueAfter: aShortWait}, expectedStatus)
-	g.Expect(reconciledCluster.Finalizers).To(ContainElement(finalizer))
-
-	// reclaim - delete, delete error with worker
-	cluster.Spec.ReclaimPolicy = runtimev1alpha1.ReclaimDelete
-	cluster.Status.SetConditions(runtimev1alpha1.Available())
-	cluster.Finalizers = []string{finalizer}
-	testErrorWorker := errors.New("test-delete-error-worker")
-	client.MockDelete = func(string) error { return nil }
-	client.MockDeleteWorkerNodes = func(string) error { return testErrorWorker }
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(
-		runtimev1alpha1.Deleting(),
-		runtimev1alpha1.ReconcileError(errors.Wrap(testErrorWorker, "Worker Delete Error")),
-	)
-
-	reconciledCluster = test(cluster, client, reconcile.Result{RequeueAfter: aShortWait}, expectedStatus)
-	g.Expect(reconciledCluster.Finalizers).To(ContainElement(finalizer))
-
-	// reclaim - delete, delete error in cluster and cluster workers
-	cluster.Spec.ReclaimPolicy = runtimev1alpha1.ReclaimDelete
-	cluster.Status.SetConditions(runtimev1alpha1.Available())
-	cluster.Finalizers = []string{finalizer}
-	client.MockDelete = func(string) error { return nil }
-	client.MockDelete = func(string) error { return errorDelete }
-	client.MockDeleteWorkerNodes = func(string) error { return testErrorWorker }
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(
-		runtimev1alpha1.Deleting(),
-		runtimev1alpha1.ReconcileError(errors.New("Master Delete Error: test-delete-error, Worker Delete Error: test-delete-error-worker")),
-	)
-
-	reconciledCluster = test(cluster, client, reconcile.Result{RequeueAfter: aShortWait}, expectedStatus)
-	g.Expect(reconciledCluster.Finalizers).To(ContainElement(finalizer))
-}
-
-func TestReconcileObjectNotFound(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	r := &Reconciler{
-		Client:                   NewFakeClient(),
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient()),
+	details, err := e.connectionDetails(cluster, tc)
+	if err != nil {
+		t.Errorf("e.connectionDetails(...): %s", err)
 	}
-	rs, err := r.Reconcile(request)
-	g.Expect(rs).To(Equal(reconcile.Result{}))
-	g.Expect(err).To(BeNil())
-}
-
-func TestReconcileClientError(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	testError := errors.New("test-client-error")
-
-	called := false
-
-	r := &Reconciler{
-		Client: NewFakeClient(testCluster()),
-		connect: func(*EKSCluster) (eks.Client, error) {
-			called = true
-			return nil, testError
-		},
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient()),
+	wantDetails := resource.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(cluster.Endpoint),
+		runtimev1alpha1.ResourceCredentialsSecretCAKey:       fakeCA,
+		runtimev1alpha1.ResourceCredentialsTokenKey:          []byte("test-token"),
+	}
+	if diff := cmp.Diff(wantDetails, details); diff != "" {
+		t.Errorf("e.connectionDetails(...): -want, +got\n%s", diff)
+	}
+	if diff := cmp.Diff(&metav1.Time{Time: expiration}, tc.Status.TokenExpiration); diff != "" {
+		t.Errorf("e.connectionDetails(...): -want token expiration, +got token expiration\n%s", diff)
 	}
 
-	// expected to have a failed condition
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(testError))
-
-	rs, err := r.Reconcile(request)
-	g.Expect(rs).To(Equal(reconcile.Result{RequeueAfter: aShortWait}))
-	g.Expect(err).To(BeNil())
-	g.Expect(called).To(BeTrue())
+	// Ensure we keep a token that is not due for a refresh, and include an
+	// exec based kubeconfig when asked to.
+	client.MockConnectionToken = func(string) (*eks.Token, error) {
+		t.Errorf("e.connectionDetails(...): unexpected refresh of a token that expires at %s", tc.Status.TokenExpiration)
+		return nil, nil
+	}
+	tc.Spec.PublishExecKubeconfig = true
+	details, err = e.connectionDetails(cluster, tc)
+	if err != nil {
+		t.Errorf("e.connectionDetails(...): %s", err)
+	}
+	if _, ok := details[runtimev1alpha1.ResourceCredentialsTokenKey]; ok {
+		t.Errorf("e.connectionDetails(...): unexpected token in connection details")
+	}
+	kubeconfig, err := eks.GenerateExecKubeconfig(clusterName, cluster.Endpoint, fakeCA)
+	if err != nil {
+		t.Fatalf("eks.GenerateExecKubeconfig(...): %s", err)
+	}
+	if diff := cmp.Diff(kubeconfig, details[connectionSecretKubeconfigKey]); diff != "" {
+		t.Errorf("e.connectionDetails(...): -want kubeconfig, +got kubeconfig\n%s", diff)
+	}
 
-	assertResource(g, r, expectedStatus)
+	// Ensure we refresh a token that is about to expire.
+	client.MockConnectionToken = func(string) (*eks.Token, error) {
+		return &eks.Token{Token: "test-token-2", Expiration: expiration}, nil
+	}
+	tc.Status.TokenExpiration = &metav1.Time{Time: time.Now().Add(tokenRefreshWindow - time.Minute)}
+	details, err = e.connectionDetails(cluster, tc)
+	if err != nil {
+		t.Errorf("e.connectionDetails(...): %s", err)
+	}
+	if diff := cmp.Diff([]byte("test-token-2"), details[runtimev1alpha1.ResourceCredentialsTokenKey]); diff != "" {
+		t.Errorf("e.connectionDetails(...): -want token, +got token\n%s", diff)
+	}
 }
 
-func TestReconcileDelete(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	// test objects
-	tc := testCluster()
-	dt := metav1.Now()
-	tc.DeletionTimestamp = &dt
-
-	called := false
-
-	r := &Reconciler{
-		Client: NewFakeClient(tc),
-		connect: func(*EKSCluster) (eks.Client, error) {
-			return nil, nil
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr  *EKSCluster
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockEKSClient
+		cr     *EKSCluster
+		want   want
+	}{
+		"Successful": {
+			client: &fake.MockEKSClient{
+				MockCreate: func(name string, _ EKSClusterSpec) (*eks.Cluster, error) {
+					if name != clusterName {
+						return nil, errors.Errorf("unexpected cluster %s", name)
+					}
+					return &eks.Cluster{Version: "1.14"}, nil
+				},
+			},
+			cr: eksCluster(),
+			want: want{
+				cr: eksCluster(
+					withObservedCluster(&eks.Cluster{Name: clusterName, Status: ClusterStatusCreating, Version: "1.14"}),
+					withConditions(runtimev1alpha1.Creating()),
+				),
+			},
 		},
-		delete: func(*EKSCluster, eks.Client) (reconcile.Result, error) {
-			called = true
-			return reconcile.Result{}, nil
+		"AlreadyExists": {
+			client: &fake.MockEKSClient{
+				MockCreate: func(string, EKSClusterSpec) (*eks.Cluster, error) { return nil, errInUse },
+			},
+			cr:   eksCluster(),
+			want: want{cr: eksCluster(withConditions(runtimev1alpha1.Creating()))},
+		},
+		"Failed": {
+			client: &fake.MockEKSClient{
+				MockCreate: func(string, EKSClusterSpec) (*eks.Cluster, error) { return nil, errorBoom },
+			},
+			cr: eksCluster(),
+			want: want{
+				cr:  eksCluster(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errorBoom, errCreateCluster),
+			},
 		},
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient()),
 	}
 
-	rs, err := r.Reconcile(request)
-	g.Expect(rs).To(Equal(reconcile.Result{}))
-	g.Expect(err).To(BeNil())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, runtimev1alpha1.ConditionedStatus{})
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Create(ctx, tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("e.Create(...): -want cr, +got cr:\n%s", diff)
+			}
+		})
+	}
 }
 
-func TestReconcileCreate(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	called := false
-
-	r := &Reconciler{
-		Client: NewFakeClient(testCluster()),
-		connect: func(*EKSCluster) (eks.Client, error) {
-			return nil, nil
+func TestUpdate(t *testing.T) {
+	getCluster := func(string) (*eks.Cluster, error) { return activeCluster(), nil }
+
+	type want struct {
+		cr  *EKSCluster
+		err error
+	}
+
+	cases := map[string]struct {
+		client *fake.MockEKSClient
+		cr     *EKSCluster
+		want   want
+	}{
+		"OutdatedVersion": {
+			client: &fake.MockEKSClient{
+				MockGet: getCluster,
+				MockUpdateVersion: func(_ string, version string) (string, error) {
+					if version != "1.15" {
+						return "", errors.Errorf("unexpected version %s", version)
+					}
+					return fakeUpdateID, nil
+				},
+			},
+			cr:   eksCluster(withClusterVersion("1.15"), func(cr *EKSCluster) { cr.Spec.EnabledLogTypes = []LogType{LogTypeAPI} }),
+			want: want{cr: eksCluster(withClusterVersion("1.15"), func(cr *EKSCluster) { cr.Spec.EnabledLogTypes = []LogType{LogTypeAPI} }, withUpdateID(fakeUpdateID))},
+		},
+		"OutdatedConfig": {
+			client: &fake.MockEKSClient{
+				MockGet: getCluster,
+				MockUpdateConfig: func(string, EKSClusterSpec, *eks.Cluster) (string, error) {
+					return fakeUpdateID, nil
+				},
+			},
+			cr:   eksCluster(func(cr *EKSCluster) { cr.Spec.EnabledLogTypes = []LogType{LogTypeAPI} }),
+			want: want{cr: eksCluster(func(cr *EKSCluster) { cr.Spec.EnabledLogTypes = []LogType{LogTypeAPI} }, withUpdateID(fakeUpdateID))},
 		},
-		create: func(*EKSCluster, eks.Client) (reconcile.Result, error) {
-			called = true
-			return reconcile.Result{RequeueAfter: aShortWait}, nil
+		"UpToDate": {
+			client: &fake.MockEKSClient{MockGet: getCluster},
+			cr:     eksCluster(),
+			want:   want{cr: eksCluster()},
+		},
+		"GetFailed": {
+			client: &fake.MockEKSClient{MockGet: func(string) (*eks.Cluster, error) { return nil, errorBoom }},
+			cr:     eksCluster(),
+			want:   want{cr: eksCluster(), err: errors.Wrap(errorBoom, errGetCluster)},
+		},
+		"UpdateVersionFailed": {
+			client: &fake.MockEKSClient{
+				MockGet:           getCluster,
+				MockUpdateVersion: func(string, string) (string, error) { return "", errorBoom },
+			},
+			cr:   eksCluster(withClusterVersion("1.15")),
+			want: want{cr: eksCluster(withClusterVersion("1.15")), err: errors.Wrap(errorBoom, errUpdateVersion)},
 		},
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient()),
 	}
 
-	rs, err := r.Reconcile(request)
-	g.Expect(rs).To(Equal(reconcile.Result{RequeueAfter: aShortWait}))
-	g.Expect(err).To(BeNil())
-	g.Expect(called).To(BeTrue())
-
-	assertResource(g, r, runtimev1alpha1.ConditionedStatus{})
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(ctx, tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("e.Update(...): -want cr, +got cr:\n%s", diff)
+			}
+		})
+	}
 }
 
-func TestReconcileSync(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	called := false
+func TestDelete(t *testing.T) {
+	deleting := func(cr *EKSCluster) { cr.Status.State = ClusterStatusDeleting }
+	testErrorWorker := errors.New("test-delete-error-worker")
 
-	tc := testCluster()
-	tc.Status.ClusterName = "test-status- cluster-name"
-	tc.Finalizers = []string{finalizer}
+	type want struct {
+		cr  *EKSCluster
+		err error
+	}
 
-	r := &Reconciler{
-		Client: NewFakeClient(tc),
-		connect: func(*EKSCluster) (eks.Client, error) {
-			return nil, nil
+	cases := map[string]struct {
+		client *fake.MockEKSClient
+		cr     *EKSCluster
+		want   want
+	}{
+		"Successful": {
+			client: &fake.MockEKSClient{
+				MockDelete:            func(string) error { return nil },
+				MockDeleteWorkerNodes: func(string) error { return nil },
+			},
+			cr:   eksCluster(withStackID(fakeStackID)),
+			want: want{cr: eksCluster(withStackID(fakeStackID), withConditions(runtimev1alpha1.Deleting()))},
 		},
-		sync: func(*EKSCluster, eks.Client) (reconcile.Result, error) {
-			called = true
-			return reconcile.Result{RequeueAfter: aShortWait}, nil
+		"AlreadyDeleting": {
+			client: &fake.MockEKSClient{
+				MockDelete:            func(string) error { return errorBoom },
+				MockDeleteWorkerNodes: func(string) error { return nil },
+			},
+			cr:   eksCluster(withStackID(fakeStackID), deleting),
+			want: want{cr: eksCluster(withStackID(fakeStackID), deleting, withConditions(runtimev1alpha1.Deleting()))},
+		},
+		"AlreadyDeletingWorkersNotFound": {
+			client: &fake.MockEKSClient{
+				MockDeleteWorkerNodes: func(string) error {
+					return awserr.New(cloudformation.ErrCodeStackInstanceNotFoundException, "", nil)
+				},
+			},
+			cr:   eksCluster(withStackID(fakeStackID), deleting),
+			want: want{cr: eksCluster(withStackID(fakeStackID), deleting, withConditions(runtimev1alpha1.Deleting()))},
+		},
+		"AlreadyDeletingWorkersFailed": {
+			client: &fake.MockEKSClient{
+				MockDeleteWorkerNodes: func(string) error { return testErrorWorker },
+			},
+			cr: eksCluster(withStackID(fakeStackID), deleting),
+			want: want{
+				cr:  eksCluster(withStackID(fakeStackID), deleting, withConditions(runtimev1alpha1.Deleting())),
+				err: errors.New("Worker Delete Error: test-delete-error-worker"),
+			},
+		},
+		"NotFound": {
+			client: &fake.MockEKSClient{MockDelete: func(string) error { return errNotFound }},
+			cr:     eksCluster(),
+			want:   want{cr: eksCluster(withConditions(runtimev1alpha1.Deleting()))},
+		},
+		"DeleteFailed": {
+			client: &fake.MockEKSClient{
+				MockDelete:            func(string) error { return errorBoom },
+				MockDeleteWorkerNodes: func(string) error { return testErrorWorker },
+			},
+			cr: eksCluster(withStackID(fakeStackID)),
+			want: want{
+				cr:  eksCluster(withStackID(fakeStackID), withConditions(runtimev1alpha1.Deleting())),
+				err: errors.New("Master Delete Error: boom, Worker Delete Error: test-delete-error-worker"),
+			},
 		},
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient()),
 	}
 
-	rs, err := r.Reconcile(request)
-	g.Expect(rs).To(Equal(reconcile.Result{RequeueAfter: aShortWait}))
-	g.Expect(err).To(BeNil())
-	g.Expect(called).To(BeTrue())
-
-	rc := assertResource(g, r, runtimev1alpha1.ConditionedStatus{})
-	g.Expect(rc.Finalizers).To(HaveLen(1))
-	g.Expect(rc.Finalizers).To(ContainElement(finalizer))
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(ctx, tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("e.Delete(...): -want cr, +got cr:\n%s", diff)
+			}
+		})
+	}
 }
@@ -0,0 +1,214 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fargateprofile
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
+	awsclients "github.com/crossplaneio/stack-aws/pkg/clients"
+	"github.com/crossplaneio/stack-aws/pkg/clients/eks"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an EKSFargateProfile resource"
+	errClient           = "cannot create a new FargateProfileClient"
+	errDescribe         = "failed to describe EKSFargateProfile with name: %v"
+	errDescribeCluster  = "failed to describe the EKS cluster with name: %v"
+	errCreate           = "failed to create the EKSFargateProfile resource with name: %v"
+	errDelete           = "failed to delete the EKSFargateProfile resource"
+)
+
+// Controller is the controller for EKSFargateProfile objects
+type Controller struct{}
+
+// SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(v1alpha2.EKSFargateProfileGroupVersionKind),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: eks.NewFargateProfileClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
+		resource.WithManagedConnectionPublishers())
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.EKSFargateProfileKindAPIVersion, v1alpha2.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.EKSFargateProfile{}).
+		Complete(r)
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (eks.FargateProfileClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha2.EKSFargateProfile)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, string(cr.Spec.Region))
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{c}, nil
+}
+
+type external struct {
+	client eks.FargateProfileClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha2.EKSFargateProfile)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// a Fargate profile is identified by its name within its cluster, which
+	// is the external name of the EKSFargateProfile
+	req := e.client.DescribeFargateProfileRequest(&eks.DescribeFargateProfileInput{
+		ClusterName:        aws.String(cr.Spec.ClusterName),
+		FargateProfileName: aws.String(meta.GetExternalName(cr)),
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil && eks.IsErrorNotFound(err) {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	if err != nil {
+		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, meta.GetExternalName(cr))
+	}
+
+	observed := *response.FargateProfile
+
+	switch aws.StringValue(observed.Status) {
+	case v1alpha2.FargateProfileStatusActive:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha2.FargateProfileStatusCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha2.FargateProfileStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	cr.Status.EKSFargateProfileExternalStatus = eks.GenerateFargateProfileObservation(observed)
+
+	// Fargate profiles cannot be updated, so an existing one is always
+	// considered up to date
+	return resource.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  true,
+		ConnectionDetails: resource.ConnectionDetails{},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha2.EKSFargateProfile)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+
+	// a Fargate profile can only be created in an active cluster, so we wait
+	// for the cluster until it is
+	clusterReq := e.client.DescribeClusterRequest(&eks.DescribeClusterInput{Name: aws.String(cr.Spec.ClusterName)})
+	clusterReq.SetContext(ctx)
+
+	cluster, err := clusterReq.Send()
+	if err != nil {
+		return resource.ExternalCreation{}, errors.Wrapf(err, errDescribeCluster, cr.Spec.ClusterName)
+	}
+	if aws.StringValue(cluster.Cluster.Status) != v1alpha2.ClusterStatusActive {
+		return resource.ExternalCreation{}, nil
+	}
+
+	req := e.client.CreateFargateProfileRequest(eks.GenerateCreateFargateProfileInput(meta.GetExternalName(cr), cr.Spec.EKSFargateProfileParameters, awsclients.String(string(cr.GetUID()))))
+	req.SetContext(ctx)
+
+	rsp, err := req.Send()
+
+	// EKS creates or deletes one Fargate profile of a cluster at a time, and
+	// rejects the request while another one is in progress. It is retried
+	// once the resource is requeued.
+	if err != nil && eks.IsErrorResourceInUse(err) {
+		return resource.ExternalCreation{}, nil
+	}
+	if err != nil {
+		return resource.ExternalCreation{}, errors.Wrapf(err, errCreate, meta.GetExternalName(cr))
+	}
+
+	cr.Status.EKSFargateProfileExternalStatus = eks.GenerateFargateProfileObservation(*rsp.FargateProfile)
+
+	return resource.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
+	// Fargate profiles cannot be updated
+	return resource.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha2.EKSFargateProfile)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	if cr.Status.Status == v1alpha2.FargateProfileStatusDeleting {
+		return nil
+	}
+
+	req := e.client.DeleteFargateProfileRequest(&eks.DeleteFargateProfileInput{
+		ClusterName:        aws.String(cr.Spec.ClusterName),
+		FargateProfileName: aws.String(meta.GetExternalName(cr)),
+	})
+	req.SetContext(ctx)
+
+	// like creation, deletion is retried while EKS is busy with another
+	// Fargate profile of the cluster
+	_, err := req.Send()
+	if err != nil && (eks.IsErrorNotFound(err) || eks.IsErrorResourceInUse(err)) {
+		return nil
+	}
+	return errors.Wrap(err, errDelete)
+}
@@ -0,0 +1,478 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fargateprofile
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/eks"
+	"github.com/crossplaneio/stack-aws/pkg/clients/eks/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockFargateProfileClient
+
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+
+	errNotFound = awserr.New(awseks.ErrCodeResourceNotFoundException, "", nil)
+	errInUse    = awserr.New(awseks.ErrCodeResourceInUseException, "", nil)
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockFargateProfileClient{}
+	mockExternalClient = external{&mockClient}
+
+	os.Exit(m.Run())
+}
+
+func managedFargateProfile() *v1alpha2.EKSFargateProfile {
+	fp := &v1alpha2.EKSFargateProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-name", UID: "some-uid"},
+		Spec: v1alpha2.EKSFargateProfileSpec{
+			EKSFargateProfileParameters: v1alpha2.EKSFargateProfileParameters{
+				ClusterName:         "some-cluster",
+				PodExecutionRoleARN: "arn:aws:iam::123456789012:role/pods",
+				SubnetIDs:           []string{"subnet-1"},
+				Selectors:           []v1alpha2.FargateProfileSelector{{Namespace: "default"}},
+			},
+		},
+	}
+	meta.SetExternalName(fp, "profile")
+	return fp
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha2.EKSFargateProfile{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (eks.FargateProfileClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged,
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged,
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	var mockClientErr error
+	var item *eks.FargateProfile
+	mockClient.MockDescribeFargateProfileRequest = func(input *eks.DescribeFargateProfileInput) eks.DescribeFargateProfileRequest {
+		g.Expect(aws.StringValue(input.ClusterName)).To(gomega.Equal("some-cluster"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.FargateProfileName)).To(gomega.Equal("profile"), "the passed parameters are not valid")
+		return eks.DescribeFargateProfileRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &eks.DescribeFargateProfileOutput{
+					FargateProfile: item,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	withStatus := func(s string) *eks.FargateProfile {
+		return &eks.FargateProfile{
+			FargateProfileName: aws.String("profile"),
+			Status:             aws.String(s),
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemReturned          *eks.FargateProfile
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedReason        corev1alpha1.ConditionReason
+	}{
+		{
+			"active Fargate profile should be available",
+			managedFargateProfile(),
+			withStatus(v1alpha2.FargateProfileStatusActive),
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"creating Fargate profile should be creating",
+			managedFargateProfile(),
+			withStatus(v1alpha2.FargateProfileStatusCreating),
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"deleting Fargate profile should be deleting",
+			managedFargateProfile(),
+			withStatus(v1alpha2.FargateProfileStatusDeleting),
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonDeleting,
+		},
+		{
+			"failed Fargate profile should be unavailable",
+			managedFargateProfile(),
+			withStatus(v1alpha2.FargateProfileStatusCreateFailed),
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonUnavailable,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			managedFargateProfile(),
+			nil,
+			errNotFound,
+			true,
+			false,
+			"",
+		},
+		{
+			"if external resource fails, it should return error",
+			managedFargateProfile(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		item = tc.itemReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha2.EKSFargateProfile)
+			g.Expect(result.ResourceUpToDate).To(gomega.BeTrue(), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(tc.expectedReason), tc.description)
+			g.Expect(mgd.Status.FargateProfileName).To(gomega.Equal("profile"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := managedFargateProfile()
+	mockExternal := &eks.FargateProfile{
+		FargateProfileName: aws.String("profile"),
+		Status:             aws.String(v1alpha2.FargateProfileStatusCreating),
+	}
+
+	var clusterStatus string
+	var describeErr, createErr error
+	var created bool
+	mockClient.MockDescribeClusterRequest = func(input *eks.DescribeClusterInput) eks.DescribeClusterRequest {
+		g.Expect(aws.StringValue(input.Name)).To(gomega.Equal(mockManaged.Spec.ClusterName), "the passed parameters are not valid")
+		return eks.DescribeClusterRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &eks.DescribeClusterOutput{
+					Cluster: &eks.ClusterDescription{Status: aws.String(clusterStatus)},
+				},
+				Error: describeErr,
+			},
+		}
+	}
+	mockClient.MockCreateFargateProfileRequest = func(input *eks.CreateFargateProfileInput) eks.CreateFargateProfileRequest {
+		created = true
+		g.Expect(aws.StringValue(input.ClusterName)).To(gomega.Equal(mockManaged.Spec.ClusterName), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.FargateProfileName)).To(gomega.Equal("profile"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.PodExecutionRoleARN)).To(gomega.Equal(mockManaged.Spec.PodExecutionRoleARN), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.ClientRequestToken)).To(gomega.Equal("some-uid"), "the passed parameters are not valid")
+		g.Expect(input.Subnets).To(gomega.Equal(mockManaged.Spec.SubnetIDs), "the passed parameters are not valid")
+		return eks.CreateFargateProfileRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &eks.CreateFargateProfileOutput{
+					FargateProfile: mockExternal,
+				},
+				Error: createErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description     string
+		managedObj      resource.Managed
+		clusterStatus   string
+		describeErr     error
+		createErr       error
+		expectedErrNil  bool
+		expectedCreated bool
+		expectedStatus  string
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			v1alpha2.ClusterStatusActive,
+			nil,
+			nil,
+			true,
+			true,
+			v1alpha2.FargateProfileStatusCreating,
+		},
+		{
+			"a Fargate profile should not be created before the cluster is active",
+			mockManaged.DeepCopy(),
+			v1alpha2.ClusterStatusCreating,
+			nil,
+			nil,
+			true,
+			false,
+			"",
+		},
+		{
+			"a Fargate profile should be created later while another one is in progress",
+			mockManaged.DeepCopy(),
+			v1alpha2.ClusterStatusActive,
+			nil,
+			errInUse,
+			true,
+			true,
+			"",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			v1alpha2.ClusterStatusActive,
+			nil,
+			nil,
+			false,
+			false,
+			"",
+		},
+		{
+			"if describing the cluster fails, it should return error",
+			mockManaged.DeepCopy(),
+			"",
+			errors.New("some error"),
+			nil,
+			false,
+			false,
+			"",
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			v1alpha2.ClusterStatusActive,
+			nil,
+			errors.New("some error"),
+			false,
+			true,
+			"",
+		},
+	} {
+		clusterStatus = tc.clusterStatus
+		describeErr = tc.describeErr
+		createErr = tc.createErr
+		created = false
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(created).To(gomega.Equal(tc.expectedCreated), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.EKSFargateProfile)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.Status).To(gomega.Equal(tc.expectedStatus), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := managedFargateProfile()
+	deleting := managedFargateProfile()
+	deleting.Status.Status = v1alpha2.FargateProfileStatusDeleting
+
+	var mockClientErr error
+	var deleted bool
+	mockClient.MockDeleteFargateProfileRequest = func(input *eks.DeleteFargateProfileInput) eks.DeleteFargateProfileRequest {
+		deleted = true
+		g.Expect(aws.StringValue(input.ClusterName)).To(gomega.Equal(mockManaged.Spec.ClusterName), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.FargateProfileName)).To(gomega.Equal("profile"), "the passed parameters are not valid")
+		return eks.DeleteFargateProfileRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &eks.DeleteFargateProfileOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description     string
+		managedObj      resource.Managed
+		clientErr       error
+		expectedErrNil  bool
+		expectedDeleted bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+			true,
+		},
+		{
+			"a Fargate profile that is being deleted should not be deleted again",
+			deleting,
+			nil,
+			true,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			errNotFound,
+			true,
+			true,
+		},
+		{
+			"a Fargate profile should be deleted later while another one is in progress",
+			mockManaged.DeepCopy(),
+			errInUse,
+			true,
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+			true,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		deleted = false
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(deleted).To(gomega.Equal(tc.expectedDeleted), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.EKSFargateProfile)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
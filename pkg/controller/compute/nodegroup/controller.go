@@ -0,0 +1,237 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodegroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
+	awsclients "github.com/crossplaneio/stack-aws/pkg/clients"
+	"github.com/crossplaneio/stack-aws/pkg/clients/eks"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a NodeGroup resource"
+	errClient           = "cannot create a new NodeGroupClient"
+	errDescribe         = "failed to describe NodeGroup with name: %v"
+	errCreate           = "failed to create the NodeGroup resource with name: %v"
+	errUpdateConfig     = "failed to update the configuration of the NodeGroup resource"
+	errUpdateVersion    = "failed to update the version of the NodeGroup resource"
+	errDelete           = "failed to delete the NodeGroup resource"
+)
+
+// Controller is the controller for NodeGroup objects
+type Controller struct{}
+
+// SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(v1alpha2.NodeGroupGroupVersionKind),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: eks.NewNodeGroupClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
+		resource.WithManagedConnectionPublishers())
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.NodeGroupKindAPIVersion, v1alpha2.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.NodeGroup{}).
+		Complete(r)
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (eks.NodeGroupClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha2.NodeGroup)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, string(cr.Spec.Region))
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{c}, nil
+}
+
+type external struct {
+	client eks.NodeGroupClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha2.NodeGroup)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// a node group is identified by its name within its cluster, which is
+	// the external name of the NodeGroup
+	req := e.client.DescribeNodegroupRequest(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(cr.Spec.ClusterName),
+		NodegroupName: aws.String(meta.GetExternalName(cr)),
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil && eks.IsErrorNotFound(err) {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	if err != nil {
+		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, meta.GetExternalName(cr))
+	}
+
+	observed := *response.Nodegroup
+
+	switch aws.StringValue(observed.Status) {
+	case v1alpha2.NodeGroupStatusActive, v1alpha2.NodeGroupStatusUpdating:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case v1alpha2.NodeGroupStatusCreating:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case v1alpha2.NodeGroupStatusDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	cr.Status.NodeGroupExternalStatus = eks.GenerateNodeGroupObservation(observed)
+
+	// a node group can only be updated while it's active, so a node group
+	// in any other state is considered up to date until it is
+	return resource.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  aws.StringValue(observed.Status) != v1alpha2.NodeGroupStatusActive || eks.IsNodeGroupUpToDate(cr.Spec.NodeGroupParameters, observed),
+		ConnectionDetails: resource.ConnectionDetails{},
+	}, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha2.NodeGroup)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+
+	req := e.client.CreateNodegroupRequest(eks.GenerateCreateNodegroupInput(meta.GetExternalName(cr), cr.Spec.NodeGroupParameters, awsclients.String(string(cr.GetUID()))))
+	req.SetContext(ctx)
+
+	rsp, err := req.Send()
+	if err != nil {
+		return resource.ExternalCreation{}, errors.Wrapf(err, errCreate, meta.GetExternalName(cr))
+	}
+
+	cr.Status.NodeGroupExternalStatus = eks.GenerateNodeGroupObservation(*rsp.Nodegroup)
+
+	return resource.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha2.NodeGroup)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	req := e.client.DescribeNodegroupRequest(&eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(cr.Spec.ClusterName),
+		NodegroupName: aws.String(meta.GetExternalName(cr)),
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return resource.ExternalUpdate{}, errors.Wrapf(err, errDescribe, meta.GetExternalName(cr))
+	}
+	observed := *response.Nodegroup
+
+	// EKS runs one update of a node group at a time, and the node group is
+	// no longer active while it does. The configuration is brought up to date
+	// first, the version in a later reconcile.
+	if !eks.IsNodeGroupConfigUpToDate(cr.Spec.NodeGroupParameters, observed) {
+		input := eks.GenerateUpdateNodegroupConfigInput(cr.Spec.NodeGroupParameters, observed)
+		input.ClusterName = aws.String(cr.Spec.ClusterName)
+		input.NodegroupName = aws.String(meta.GetExternalName(cr))
+
+		configReq := e.client.UpdateNodegroupConfigRequest(input)
+		configReq.SetContext(ctx)
+
+		_, err := configReq.Send()
+		return resource.ExternalUpdate{}, errors.Wrap(err, errUpdateConfig)
+	}
+
+	if eks.IsNodeGroupVersionUpToDate(cr.Spec.NodeGroupParameters, observed) {
+		return resource.ExternalUpdate{}, nil
+	}
+
+	versionReq := e.client.UpdateNodegroupVersionRequest(&eks.UpdateNodegroupVersionInput{
+		ClusterName:    aws.String(cr.Spec.ClusterName),
+		NodegroupName:  aws.String(meta.GetExternalName(cr)),
+		Version:        awsclients.String(cr.Spec.Version),
+		ReleaseVersion: awsclients.String(cr.Spec.ReleaseVersion),
+	})
+	versionReq.SetContext(ctx)
+
+	_, err = versionReq.Send()
+	return resource.ExternalUpdate{}, errors.Wrap(err, errUpdateVersion)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha2.NodeGroup)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	if cr.Status.Status == v1alpha2.NodeGroupStatusDeleting {
+		return nil
+	}
+
+	req := e.client.DeleteNodegroupRequest(&eks.DeleteNodegroupInput{
+		ClusterName:   aws.String(cr.Spec.ClusterName),
+		NodegroupName: aws.String(meta.GetExternalName(cr)),
+	})
+	req.SetContext(ctx)
+
+	_, err := req.Send()
+	if err != nil && eks.IsErrorNotFound(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDelete)
+}
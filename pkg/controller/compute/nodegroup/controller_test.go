@@ -0,0 +1,580 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nodegroup
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/compute/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/eks"
+	"github.com/crossplaneio/stack-aws/pkg/clients/eks/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockNodeGroupClient
+
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+
+	errNotFound = awserr.New(awseks.ErrCodeResourceNotFoundException, "", nil)
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockNodeGroupClient{}
+	mockExternalClient = external{&mockClient}
+
+	os.Exit(m.Run())
+}
+
+func managedNodeGroup() *v1alpha2.NodeGroup {
+	ng := &v1alpha2.NodeGroup{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-name", UID: "some-uid"},
+		Spec: v1alpha2.NodeGroupSpec{
+			NodeGroupParameters: v1alpha2.NodeGroupParameters{
+				ClusterName:   "some-cluster",
+				NodeRoleARN:   "arn:aws:iam::123456789012:role/node",
+				SubnetIDs:     []string{"subnet-1"},
+				InstanceTypes: []string{"m5.large"},
+				Labels:        map[string]string{"pool": "general"},
+			},
+		},
+	}
+	meta.SetExternalName(ng, "workers")
+	return ng
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha2.NodeGroup{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (eks.NodeGroupClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	var mockClientErr error
+	var item *eks.Nodegroup
+	mockClient.MockDescribeNodegroupRequest = func(input *eks.DescribeNodegroupInput) eks.DescribeNodegroupRequest {
+		g.Expect(aws.StringValue(input.ClusterName)).To(gomega.Equal("some-cluster"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.NodegroupName)).To(gomega.Equal("workers"), "the passed parameters are not valid")
+		return eks.DescribeNodegroupRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &eks.DescribeNodegroupOutput{
+					Nodegroup: item,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	withStatus := func(s string) *eks.Nodegroup {
+		return &eks.Nodegroup{
+			NodegroupName: aws.String("workers"),
+			Status:        aws.String(s),
+			InstanceTypes: []string{"m5.large"},
+			Labels:        map[string]string{"pool": "general"},
+		}
+	}
+	relabeled := withStatus(v1alpha2.NodeGroupStatusActive)
+	relabeled.Labels = map[string]string{"pool": "batch"}
+	relabeledUpdating := withStatus(v1alpha2.NodeGroupStatusUpdating)
+	relabeledUpdating.Labels = relabeled.Labels
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemReturned          *eks.Nodegroup
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+		expectedReason        corev1alpha1.ConditionReason
+	}{
+		{
+			"active node group should be available",
+			managedNodeGroup(),
+			withStatus(v1alpha2.NodeGroupStatusActive),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"active node group with different labels should not be up to date",
+			managedNodeGroup(),
+			relabeled,
+			nil,
+			true,
+			true,
+			false,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"updating node group should be available and up to date",
+			managedNodeGroup(),
+			relabeledUpdating,
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"creating node group should be creating",
+			managedNodeGroup(),
+			withStatus(v1alpha2.NodeGroupStatusCreating),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"deleting node group should be deleting",
+			managedNodeGroup(),
+			withStatus(v1alpha2.NodeGroupStatusDeleting),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonDeleting,
+		},
+		{
+			"degraded node group should be unavailable",
+			managedNodeGroup(),
+			withStatus(v1alpha2.NodeGroupStatusDegraded),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonUnavailable,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			managedNodeGroup(),
+			nil,
+			errNotFound,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource fails, it should return error",
+			managedNodeGroup(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		item = tc.itemReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha2.NodeGroup)
+			g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(tc.expectedReason), tc.description)
+			g.Expect(mgd.Status.NodeGroupName).To(gomega.Equal("workers"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := managedNodeGroup()
+	mockExternal := &eks.Nodegroup{
+		NodegroupName: aws.String("workers"),
+		Status:        aws.String(v1alpha2.NodeGroupStatusCreating),
+	}
+	var mockClientErr error
+	mockClient.MockCreateNodegroupRequest = func(input *eks.CreateNodegroupInput) eks.CreateNodegroupRequest {
+		g.Expect(aws.StringValue(input.ClusterName)).To(gomega.Equal(mockManaged.Spec.ClusterName), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.NodegroupName)).To(gomega.Equal("workers"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.NodeRole)).To(gomega.Equal(mockManaged.Spec.NodeRoleARN), "the passed parameters are not valid")
+		g.Expect(input.Subnets).To(gomega.Equal(mockManaged.Spec.SubnetIDs), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.ClientRequestToken)).To(gomega.Equal("some-uid"), "the passed parameters are not valid")
+		return eks.CreateNodegroupRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &eks.CreateNodegroupOutput{
+					Nodegroup: mockExternal,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.NodeGroup)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.Status).To(gomega.Equal(v1alpha2.NodeGroupStatusCreating), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	upgraded := managedNodeGroup()
+	upgraded.Spec.Version = "1.15"
+
+	var observed *eks.Nodegroup
+	var describeErr, updateErr error
+	var configUpdated, versionUpdated bool
+	mockClient.MockDescribeNodegroupRequest = func(input *eks.DescribeNodegroupInput) eks.DescribeNodegroupRequest {
+		return eks.DescribeNodegroupRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &eks.DescribeNodegroupOutput{Nodegroup: observed},
+				Error:       describeErr,
+			},
+		}
+	}
+	mockClient.MockUpdateNodegroupConfigRequest = func(input *eks.UpdateNodegroupConfigInput) eks.UpdateNodegroupConfigRequest {
+		configUpdated = true
+		g.Expect(aws.StringValue(input.ClusterName)).To(gomega.Equal("some-cluster"), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.NodegroupName)).To(gomega.Equal("workers"), "the passed parameters are not valid")
+		g.Expect(input.Labels).To(gomega.Equal(&eks.UpdateLabelsPayload{AddOrUpdateLabels: map[string]string{"pool": "general"}}), "the passed parameters are not valid")
+		return eks.UpdateNodegroupConfigRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &eks.UpdateNodegroupConfigOutput{},
+				Error:       updateErr,
+			},
+		}
+	}
+	mockClient.MockUpdateNodegroupVersionRequest = func(input *eks.UpdateNodegroupVersionInput) eks.UpdateNodegroupVersionRequest {
+		versionUpdated = true
+		g.Expect(aws.StringValue(input.Version)).To(gomega.Equal("1.15"), "the passed parameters are not valid")
+		g.Expect(input.ReleaseVersion).To(gomega.BeNil(), "the passed parameters are not valid")
+		return eks.UpdateNodegroupVersionRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &eks.UpdateNodegroupVersionOutput{},
+				Error:       updateErr,
+			},
+		}
+	}
+
+	current := &eks.Nodegroup{Version: aws.String("1.14"), Labels: map[string]string{"pool": "general"}}
+	unlabeled := &eks.Nodegroup{Version: aws.String("1.14")}
+
+	for _, tc := range []struct {
+		description            string
+		managedObj             resource.Managed
+		observed               *eks.Nodegroup
+		describeErr            error
+		updateErr              error
+		expectedErrNil         bool
+		expectedConfigUpdated  bool
+		expectedVersionUpdated bool
+	}{
+		{
+			"an outdated configuration should be updated first",
+			upgraded.DeepCopy(),
+			unlabeled,
+			nil,
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"an outdated version should be updated",
+			upgraded.DeepCopy(),
+			current,
+			nil,
+			nil,
+			true,
+			false,
+			true,
+		},
+		{
+			"an up to date node group should not be updated",
+			managedNodeGroup(),
+			current,
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if describing the resource fails, it should return error",
+			upgraded.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if updating the configuration fails, it should return error",
+			upgraded.DeepCopy(),
+			unlabeled,
+			nil,
+			errors.New("some error"),
+			false,
+			true,
+			false,
+		},
+		{
+			"if updating the version fails, it should return error",
+			upgraded.DeepCopy(),
+			current,
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			true,
+		},
+	} {
+		observed = tc.observed
+		describeErr = tc.describeErr
+		updateErr = tc.updateErr
+		configUpdated, versionUpdated = false, false
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(configUpdated).To(gomega.Equal(tc.expectedConfigUpdated), tc.description)
+		g.Expect(versionUpdated).To(gomega.Equal(tc.expectedVersionUpdated), tc.description)
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := managedNodeGroup()
+	deleting := managedNodeGroup()
+	deleting.Status.Status = v1alpha2.NodeGroupStatusDeleting
+
+	var mockClientErr error
+	var deleted bool
+	mockClient.MockDeleteNodegroupRequest = func(input *eks.DeleteNodegroupInput) eks.DeleteNodegroupRequest {
+		deleted = true
+		g.Expect(aws.StringValue(input.ClusterName)).To(gomega.Equal(mockManaged.Spec.ClusterName), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.NodegroupName)).To(gomega.Equal("workers"), "the passed parameters are not valid")
+		return eks.DeleteNodegroupRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &eks.DeleteNodegroupOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description     string
+		managedObj      resource.Managed
+		clientErr       error
+		expectedErrNil  bool
+		expectedDeleted bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+			true,
+		},
+		{
+			"a node group that is being deleted should not be deleted again",
+			deleting,
+			nil,
+			true,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			errNotFound,
+			true,
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+			true,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		deleted = false
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(deleted).To(gomega.Equal(tc.expectedDeleted), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.NodeGroup)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
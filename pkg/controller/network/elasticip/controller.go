@@ -0,0 +1,256 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticip
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	awsclients "github.com/crossplaneio/stack-aws/pkg/clients"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not an ElasticIP resource"
+	errClient           = "cannot create a new ElasticIPClient"
+	errDescribe         = "failed to describe ElasticIP with allocation id: %v"
+	errMultipleItems    = "retrieved multiple ElasticIPs for the given allocationId: %v"
+	errCreate           = "failed to allocate the ElasticIP resource"
+	errCreateTags       = "failed to create tags for the ElasticIP resource"
+	errDeleteNotPresent = "cannot release the ElasticIP, since the allocationID is not present"
+	errDelete           = "failed to release the ElasticIP resource"
+)
+
+// Controller is the controller for ElasticIP objects
+type Controller struct{}
+
+// SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(v1alpha2.ElasticIPGroupVersionKind),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewElasticIPClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
+		resource.WithManagedConnectionPublishers())
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.ElasticIPKindAPIVersion, v1alpha2.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.ElasticIP{}).
+		Complete(r)
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.ElasticIPClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha2.ElasticIP)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, cr.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{c}, nil
+}
+
+type external struct {
+	client ec2.ElasticIPClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha2.ElasticIP)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// To find out whether an ElasticIP exist:
+	// - the ID of the ElasticIP should be known, see identify
+	// - an address with the given allocationID should exist
+	id, err := e.identify(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if id == "" {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	req := e.client.DescribeAddressesRequest(&awsec2.DescribeAddressesInput{
+		AllocationIds: []string{id},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+
+	if ec2.IsAddressNotFoundErr(err) {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	if err != nil {
+		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, id)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.Addresses) != 1 {
+		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, id)
+	}
+
+	// an allocated address is ready to be used right away
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	cr.UpdateExternalStatus(response.Addresses[0])
+
+	return resource.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  ec2.AreTagsUpToDate(cr, cr.Spec.Tags, response.Addresses[0].Tags),
+		ConnectionDetails: resource.ConnectionDetails{},
+	}, nil
+}
+
+// identify returns the ID of the ElasticIP that belongs to the supplied object,
+// see ec2.Identify.
+func (e *external) identify(ctx context.Context, cr *v1alpha2.ElasticIP) (string, error) {
+	return ec2.Identify(ctx, cr, cr.Status.AllocationID, "", e.describeIDs)
+}
+
+// describeIDs returns the IDs of the ElasticIPs that match the supplied
+// filters.
+func (e *external) describeIDs(ctx context.Context, filters []awsec2.Filter) ([]string, error) {
+	req := e.client.DescribeAddressesRequest(&awsec2.DescribeAddressesInput{Filters: filters})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(response.Addresses))
+	for i, r := range response.Addresses {
+		ids[i] = aws.StringValue(r.AllocationId)
+	}
+	return ids, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha2.ElasticIP)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+
+	req := e.client.AllocateAddressRequest(&awsec2.AllocateAddressInput{
+		Address: awsclients.String(cr.Spec.Address),
+		Domain:  awsec2.DomainTypeVpc,
+	})
+	req.SetContext(ctx)
+
+	rsp, err := req.Send()
+	if err != nil {
+		return resource.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	cr.UpdateExternalStatus(awsec2.Address{
+		AllocationId: rsp.AllocationId,
+		Domain:       rsp.Domain,
+		PublicIp:     rsp.PublicIp,
+	})
+
+	if tags := ec2.GenerateTags(cr, cr.Spec.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.AllocationID},
+			Tags:      v1alpha2.BuildEC2Tags(tags),
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalCreation{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	return resource.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha2.ElasticIP)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// the tags are the only mutable parameters of an allocated address
+
+	// CreateTags overwrites the values of existing tags, so the desired tags
+	// can be sent as they are.
+	req := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+		Resources: []string{cr.Status.AllocationID},
+		Tags:      v1alpha2.BuildEC2Tags(ec2.GenerateTags(cr, cr.Spec.Tags)),
+	})
+	req.SetContext(ctx)
+
+	_, err := req.Send()
+	return resource.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha2.ElasticIP)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	if cr.Status.AllocationID == "" {
+		return errors.New(errDeleteNotPresent)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	req := e.client.ReleaseAddressRequest(&awsec2.ReleaseAddressInput{
+		AllocationId: aws.String(cr.Status.AllocationID),
+	})
+	req.SetContext(ctx)
+
+	_, err := req.Send()
+	if ec2.IsAddressNotFoundErr(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDelete)
+}
@@ -0,0 +1,425 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package elasticip
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockElasticIPClient
+
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockElasticIPClient{}
+	mockExternalClient = external{&mockClient}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha2.ElasticIP{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.ElasticIPClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.ElasticIP{
+		Status: v1alpha2.ElasticIPStatus{
+			ElasticIPExternalStatus: v1alpha2.ElasticIPExternalStatus{
+				AllocationID: "some arbitrary id",
+			},
+		},
+	}
+
+	mockExternal := &awsec2.Address{
+		AllocationId: aws.String("some arbitrary id"),
+		PublicIp:     aws.String("some arbitrary ip"),
+	}
+	var mockClientErr error
+	var itemsList []awsec2.Address
+	mockClient.MockDescribeAddressesRequest = func(input *awsec2.DescribeAddressesInput) awsec2.DescribeAddressesRequest {
+		return awsec2.DescribeAddressesRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeAddressesOutput{
+					Addresses: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.Address
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsec2.Address{*mockExternal},
+			nil,
+			true,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			false,
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha2.ElasticIP{},
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.AllocationIDNotFound, "", nil),
+			true,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.Address{},
+			nil,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha2.ElasticIP)
+			g.Expect(result.ResourceUpToDate).To(gomega.BeTrue(), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionTrue), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonAvailable), tc.description)
+			g.Expect(mgd.Status.PublicIP).To(gomega.Equal(aws.StringValue(mockExternal.PublicIp)), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.ElasticIP{}
+	var mockClientErr error
+	mockClient.MockAllocateAddressRequest = func(input *awsec2.AllocateAddressInput) awsec2.AllocateAddressRequest {
+		g.Expect(input.Domain).To(gomega.Equal(awsec2.DomainTypeVpc), "the passed parameters are not valid")
+		return awsec2.AllocateAddressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.AllocateAddressOutput{
+					AllocationId: aws.String("some arbitrary id"),
+					PublicIp:     aws.String("some arbitrary ip"),
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if allocating the address fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.ElasticIP)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.AllocationID).To(gomega.Equal("some arbitrary id"), tc.description)
+			g.Expect(mgd.Status.PublicIP).To(gomega.Equal("some arbitrary ip"), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.ElasticIP{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-name"},
+		Spec: v1alpha2.ElasticIPSpec{
+			ElasticIPParameters: v1alpha2.ElasticIPParameters{
+				Tags: []v1alpha2.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha2.ElasticIPStatus{
+			ElasticIPExternalStatus: v1alpha2.ElasticIPExternalStatus{
+				AllocationID: "some arbitrary id",
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		g.Expect(input.Resources).To(gomega.Equal([]string{mockManaged.Status.AllocationID}), "the passed parameters are not valid")
+		g.Expect(input.Tags).To(gomega.Equal([]awsec2.Tag{
+			{Key: aws.String("k"), Value: aws.String("v")},
+			{Key: aws.String(ec2.TagKeyName), Value: aws.String("some-name")},
+		}), "the passed parameters are not valid")
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTagsOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating the tags fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.ElasticIP{
+		Status: v1alpha2.ElasticIPStatus{
+			ElasticIPExternalStatus: v1alpha2.ElasticIPExternalStatus{
+				AllocationID: "some arbitrary id",
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockReleaseAddressRequest = func(input *awsec2.ReleaseAddressInput) awsec2.ReleaseAddressRequest {
+		g.Expect(aws.StringValue(input.AllocationId)).To(gomega.Equal(mockManaged.Status.AllocationID), "the passed parameters are not valid")
+		return awsec2.ReleaseAddressRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.ReleaseAddressOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"if status doesn't have the resource ID, it should return an error",
+			&v1alpha2.ElasticIP{},
+			nil,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist releasing the address should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.AllocationIDNotFound, "", nil),
+			true,
+		},
+		{
+			"if releasing the address fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.ElasticIP)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
@@ -42,6 +42,8 @@ const (
 	errDescribe         = "failed to describe InternetGateway with id: %v"
 	errMultipleItems    = "retrieved multiple InternetGateways for the given internetGatewaysId: %v"
 	errCreate           = "failed to create the InternetGateway resource"
+	errAdopt            = "cannot adopt InternetGateway %v named by the external name annotation, since it does not exist"
+	errCreateTags       = "failed to create tags for the InternetGateway resource"
 	errDeleteNotPresent = "cannot delete the InternetGateway, since the internetGatewayID is not present"
 	errDetach           = "failed to detach the InternetGateway %v from VPC %v"
 	errDelete           = "failed to delete the InternetGateway resource"
@@ -55,7 +57,7 @@ type Controller struct{}
 func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
 	r := resource.NewManagedReconciler(mgr,
 		resource.ManagedKind(v1alpha2.InternetGatewayGroupVersionKind),
-		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewInternetGatewayClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewInternetGatewayClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
 		resource.WithManagedConnectionPublishers())
 	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.InternetGatewayKindAPIVersion, v1alpha2.Group))
 	return ctrl.NewControllerManagedBy(mgr).
@@ -67,7 +69,7 @@ func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
 type connector struct {
 	client      client.Client
 	newClientFn func(*aws.Config) (ec2.InternetGatewayClient, error)
-	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
 }
 
 func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
@@ -76,7 +78,7 @@ func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resou
 		return nil, errors.New(errUnexpectedObject)
 	}
 
-	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, cr.Spec.Region)
 	if err != nil {
 		return nil, err
 	}
@@ -93,23 +95,27 @@ type external struct {
 	client ec2.InternetGatewayClient
 }
 
-func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) {
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) { // nolint:gocyclo
 	cr, ok := mgd.(*v1alpha2.InternetGateway)
 	if !ok {
 		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
 	// To find out whether an InternetGateway exist:
-	// - the object's ExternalState should have internetGatewayID populated
+	// - the ID of the InternetGateway should be known, see identify
 	// - an InternetGateway with the given internetGatewayID should exist
-	if cr.Status.InternetGatewayID == "" {
+	id, err := e.identify(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if id == "" {
 		return resource.ExternalObservation{
 			ResourceExists: false,
 		}, nil
 	}
 
 	req := e.client.DescribeInternetGatewaysRequest(&awsec2.DescribeInternetGatewaysInput{
-		InternetGatewayIds: []string{cr.Status.InternetGatewayID},
+		InternetGatewayIds: []string{id},
 	})
 	req.SetContext(ctx)
 
@@ -122,12 +128,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.
 	}
 
 	if err != nil {
-		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, cr.Status.InternetGatewayID)
+		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, id)
 	}
 
 	// in a successful response, there should be one and only one object
 	if len(response.InternetGateways) != 1 {
-		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, cr.Status.InternetGatewayID)
+		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, id)
 	}
 
 	observed := response.InternetGateways[0]
@@ -149,16 +155,47 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.
 
 	return resource.ExternalObservation{
 		ResourceExists:    true,
+		ResourceUpToDate:  ec2.AreTagsUpToDate(cr, cr.Spec.Tags, observed.Tags),
 		ConnectionDetails: resource.ConnectionDetails{},
 	}, nil
 }
 
+// identify returns the ID of the InternetGateway that belongs to the supplied
+// object, see ec2.Identify.
+func (e *external) identify(ctx context.Context, cr *v1alpha2.InternetGateway) (string, error) {
+	return ec2.Identify(ctx, cr, cr.Status.InternetGatewayID, ec2.InternetGatewayIDPrefix, e.describeIDs)
+}
+
+// describeIDs returns the IDs of the InternetGateways that match the supplied
+// filters.
+func (e *external) describeIDs(ctx context.Context, filters []awsec2.Filter) ([]string, error) {
+	req := e.client.DescribeInternetGatewaysRequest(&awsec2.DescribeInternetGatewaysInput{Filters: filters})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(response.InternetGateways))
+	for i, r := range response.InternetGateways {
+		ids[i] = aws.StringValue(r.InternetGatewayId)
+	}
+	return ids, nil
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
 	cr, ok := mgd.(*v1alpha2.InternetGateway)
 	if !ok {
 		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	// an external name that refers to an existing InternetGateway is adopted by Observe,
+	// so getting here means the InternetGateway doesn't exist (anymore)
+	if id := ec2.ExternalNameID(cr, ec2.InternetGatewayIDPrefix); id != "" {
+		return resource.ExternalCreation{}, errors.Errorf(errAdopt, id)
+	}
+
 	cr.Status.SetConditions(runtimev1alpha1.Creating())
 
 	req := e.client.CreateInternetGatewayRequest(&awsec2.CreateInternetGatewayInput{})
@@ -171,6 +208,18 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.E
 
 	cr.UpdateExternalStatus(*ig.InternetGateway)
 
+	if tags := ec2.GenerateTags(cr, cr.Spec.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.InternetGatewayID},
+			Tags:      v1alpha2.BuildEC2Tags(tags),
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalCreation{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
 	// after creating the IG, attach the VPC
 	aReq := e.client.AttachInternetGatewayRequest(&awsec2.AttachInternetGatewayInput{
 		InternetGatewayId: ig.InternetGateway.InternetGatewayId,
@@ -184,10 +233,24 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.E
 }
 
 func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha2.InternetGateway)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
 	// TODO(soorena776): add more sophisticated Update logic, once we
 	// categorize immutable vs mutable fields (see #727)
 
-	return resource.ExternalUpdate{}, nil
+	// CreateTags overwrites the values of existing tags, so the desired tags
+	// can be sent as they are.
+	req := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+		Resources: []string{cr.Status.InternetGatewayID},
+		Tags:      v1alpha2.BuildEC2Tags(ec2.GenerateTags(cr, cr.Spec.Tags)),
+	})
+	req.SetContext(ctx)
+
+	_, err := req.Send()
+	return resource.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
 }
 
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
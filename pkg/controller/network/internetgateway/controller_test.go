@@ -28,6 +28,7 @@ import (
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
@@ -66,7 +67,7 @@ func Test_Connect(t *testing.T) {
 		newClientFn: func(conf *aws.Config) (ec2.InternetGatewayClient, error) {
 			return &mockClient, clientErr
 		},
-		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
 			return &aws.Config{}, configErr
 		},
 	}
@@ -365,11 +366,66 @@ func Test_Create(t *testing.T) {
 func Test_Update(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
-	mockManaged := v1alpha2.InternetGateway{}
+	mockManaged := v1alpha2.InternetGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-name"},
+		Spec: v1alpha2.InternetGatewaySpec{
+			InternetGatewayParameters: v1alpha2.InternetGatewayParameters{
+				Tags: []v1alpha2.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha2.InternetGatewayStatus{
+			InternetGatewayExternalStatus: v1alpha2.InternetGatewayExternalStatus{
+				InternetGatewayID: "some arbitrary id",
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		g.Expect(input.Resources).To(gomega.Equal([]string{mockManaged.Status.InternetGatewayID}), "the passed parameters are not valid")
+		g.Expect(input.Tags).To(gomega.Equal([]awsec2.Tag{
+			{Key: aws.String("k"), Value: aws.String("v")},
+			{Key: aws.String(ec2.TagKeyName), Value: aws.String("some-name")},
+		}), "the passed parameters are not valid")
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTagsOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating the tags fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
 
-	_, err := mockExternalClient.Update(context.Background(), &mockManaged)
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
 
-	g.Expect(err).To(gomega.BeNil())
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
 }
 
 func Test_Delete(t *testing.T) {
@@ -0,0 +1,277 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package natgateway
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a NATGateway resource"
+	errClient           = "cannot create a new NATGatewayClient"
+	errDescribe         = "failed to describe NATGateway with id: %v"
+	errMultipleItems    = "retrieved multiple NATGateways for the given natGatewayId: %v"
+	errCreate           = "failed to create the NATGateway resource"
+	errCreateTags       = "failed to create tags for the NATGateway resource"
+	errDeleteNotPresent = "cannot delete the NATGateway, since the natGatewayID is not present"
+	errDelete           = "failed to delete the NATGateway resource"
+)
+
+// Controller is the controller for NATGateway objects
+type Controller struct{}
+
+// SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(v1alpha2.NATGatewayGroupVersionKind),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewNATGatewayClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
+		resource.WithManagedConnectionPublishers())
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.NATGatewayKindAPIVersion, v1alpha2.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.NATGateway{}).
+		Complete(r)
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.NATGatewayClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha2.NATGateway)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, cr.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{c}, nil
+}
+
+type external struct {
+	client ec2.NATGatewayClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha2.NATGateway)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// To find out whether a NATGateway exist:
+	// - the ID of the NATGateway should be known, see identify
+	// - a NATGateway with the given natGatewayID should exist, and not be
+	//   deleted
+	id, err := e.identify(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if id == "" {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	req := e.client.DescribeNatGatewaysRequest(&awsec2.DescribeNatGatewaysInput{
+		NatGatewayIds: []string{id},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+
+	if ec2.IsNATGatewayNotFoundErr(err) {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	if err != nil {
+		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, id)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.NatGateways) != 1 {
+		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, id)
+	}
+
+	observed := response.NatGateways[0]
+
+	// deleted NAT gateways remain visible for a while, but can't be used anymore
+	if observed.State == awsec2.NatGatewayStateDeleted {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	switch observed.State {
+	case awsec2.NatGatewayStateAvailable:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.NatGatewayStatePending:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsec2.NatGatewayStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	case awsec2.NatGatewayStateFailed:
+		cr.SetConditions(runtimev1alpha1.Unavailable().WithMessage(aws.StringValue(observed.FailureMessage)))
+	}
+
+	cr.UpdateExternalStatus(observed)
+
+	return resource.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  ec2.AreTagsUpToDate(cr, cr.Spec.Tags, observed.Tags),
+		ConnectionDetails: resource.ConnectionDetails{},
+	}, nil
+}
+
+// identify returns the ID of the NATGateway that belongs to the supplied
+// object, see ec2.Identify.
+func (e *external) identify(ctx context.Context, cr *v1alpha2.NATGateway) (string, error) {
+	// deleted and failed NAT gateways remain visible for a while
+	return ec2.Identify(ctx, cr, cr.Status.NATGatewayID, "", e.describeIDs, awsec2.Filter{
+		Name:   aws.String("state"),
+		Values: []string{string(awsec2.NatGatewayStatePending), string(awsec2.NatGatewayStateAvailable)},
+	})
+}
+
+// describeIDs returns the IDs of the NATGateways that match the supplied
+// filters.
+func (e *external) describeIDs(ctx context.Context, filters []awsec2.Filter) ([]string, error) {
+	req := e.client.DescribeNatGatewaysRequest(&awsec2.DescribeNatGatewaysInput{Filter: filters})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(response.NatGateways))
+	for i, r := range response.NatGateways {
+		ids[i] = aws.StringValue(r.NatGatewayId)
+	}
+	return ids, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha2.NATGateway)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+
+	req := e.client.CreateNatGatewayRequest(&awsec2.CreateNatGatewayInput{
+		AllocationId: aws.String(cr.Spec.AllocationID),
+		SubnetId:     aws.String(cr.Spec.SubnetID),
+		// a deleted NAT gateway is returned again for the token it was created
+		// by, so the token is varied by the gateway it replaces, if any
+		ClientToken: ec2.ReplacementClientToken(cr, cr.Status.NATGatewayID),
+	})
+	req.SetContext(ctx)
+
+	rsp, err := req.Send()
+	if err != nil {
+		return resource.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	cr.UpdateExternalStatus(*rsp.NatGateway)
+
+	if tags := ec2.GenerateTags(cr, cr.Spec.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.NATGatewayID},
+			Tags:      v1alpha2.BuildEC2Tags(tags),
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalCreation{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	return resource.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha2.NATGateway)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// the subnet and the allocation of a NAT gateway can't be changed after
+	// creation, only its tags can
+
+	// CreateTags overwrites the values of existing tags, so the desired tags
+	// can be sent as they are.
+	req := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+		Resources: []string{cr.Status.NATGatewayID},
+		Tags:      v1alpha2.BuildEC2Tags(ec2.GenerateTags(cr, cr.Spec.Tags)),
+	})
+	req.SetContext(ctx)
+
+	_, err := req.Send()
+	return resource.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha2.NATGateway)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	if cr.Status.NATGatewayID == "" {
+		return errors.New(errDeleteNotPresent)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	req := e.client.DeleteNatGatewayRequest(&awsec2.DeleteNatGatewayInput{
+		NatGatewayId: aws.String(cr.Status.NATGatewayID),
+	})
+	req.SetContext(ctx)
+
+	_, err := req.Send()
+	if ec2.IsNATGatewayNotFoundErr(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDelete)
+}
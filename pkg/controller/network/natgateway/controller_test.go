@@ -0,0 +1,495 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package natgateway
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockNATGatewayClient
+
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockNATGatewayClient{}
+	mockExternalClient = external{&mockClient}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha2.NATGateway{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.NATGatewayClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.NATGateway{
+		Status: v1alpha2.NATGatewayStatus{
+			NATGatewayExternalStatus: v1alpha2.NATGatewayExternalStatus{
+				NATGatewayID: "some arbitrary id",
+			},
+		},
+	}
+
+	var mockClientErr error
+	var itemsList []awsec2.NatGateway
+	mockClient.MockDescribeNatGatewaysRequest = func(input *awsec2.DescribeNatGatewaysInput) awsec2.DescribeNatGatewaysRequest {
+		return awsec2.DescribeNatGatewaysRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeNatGatewaysOutput{
+					NatGateways: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	withState := func(s awsec2.NatGatewayState) []awsec2.NatGateway {
+		return []awsec2.NatGateway{{NatGatewayId: aws.String("some arbitrary id"), State: s}}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.NatGateway
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedReason        corev1alpha1.ConditionReason
+	}{
+		{
+			"available NAT gateway should be available",
+			mockManaged.DeepCopy(),
+			withState(awsec2.NatGatewayStateAvailable),
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"pending NAT gateway should be creating",
+			mockManaged.DeepCopy(),
+			withState(awsec2.NatGatewayStatePending),
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"failed NAT gateway should be unavailable",
+			mockManaged.DeepCopy(),
+			withState(awsec2.NatGatewayStateFailed),
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonUnavailable,
+		},
+		{
+			"deleted NAT gateway should not exist",
+			mockManaged.DeepCopy(),
+			withState(awsec2.NatGatewayStateDeleted),
+			nil,
+			true,
+			false,
+			"",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			false,
+			"",
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha2.NATGateway{},
+			nil,
+			nil,
+			true,
+			false,
+			"",
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.NATGatewayNotFound, "", nil),
+			true,
+			false,
+			"",
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.NatGateway{},
+			nil,
+			false,
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha2.NATGateway)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(tc.expectedReason), tc.description)
+			g.Expect(mgd.Status.NATGatewayID).To(gomega.Equal("some arbitrary id"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.NATGateway{
+		ObjectMeta: metav1.ObjectMeta{UID: "some-uid"},
+		Spec: v1alpha2.NATGatewaySpec{
+			NATGatewayParameters: v1alpha2.NATGatewayParameters{
+				AllocationID: "arbitrary allocationId",
+				SubnetID:     "arbitrary subnetId",
+			},
+		},
+	}
+	mockExternal := &awsec2.NatGateway{
+		NatGatewayId: aws.String("some arbitrary id"),
+		State:        awsec2.NatGatewayStatePending,
+	}
+	replacing := mockManaged.DeepCopy()
+	replacing.Status.NATGatewayID = "nat-deleted"
+	var mockClientErr error
+	var mockClientToken string
+	mockClient.MockCreateNatGatewayRequest = func(input *awsec2.CreateNatGatewayInput) awsec2.CreateNatGatewayRequest {
+		g.Expect(aws.StringValue(input.AllocationId)).To(gomega.Equal(mockManaged.Spec.AllocationID), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.SubnetId)).To(gomega.Equal(mockManaged.Spec.SubnetID), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.ClientToken)).To(gomega.Equal(mockClientToken), "the client token should derive from the UID")
+		return awsec2.CreateNatGatewayRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.CreateNatGatewayOutput{
+					NatGateway: mockExternal,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		g.Expect(input.Tags).To(gomega.Equal([]awsec2.Tag{
+			{Key: aws.String(ec2.TagKeyUID), Value: aws.String(string(mockManaged.GetUID()))},
+		}), "the passed parameters are not valid")
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTagsOutput{},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		clientToken    string
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			"some-uid",
+			true,
+		},
+		{
+			"replacing a deleted NAT gateway should vary the client token",
+			replacing,
+			nil,
+			"some-uid-nat-deleted",
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			"some-uid",
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			"some-uid",
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		mockClientToken = tc.clientToken
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.NATGateway)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.NATGatewayID).To(gomega.Equal(aws.StringValue(mockExternal.NatGatewayId)), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.NATGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-name"},
+		Spec: v1alpha2.NATGatewaySpec{
+			NATGatewayParameters: v1alpha2.NATGatewayParameters{
+				Tags: []v1alpha2.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha2.NATGatewayStatus{
+			NATGatewayExternalStatus: v1alpha2.NATGatewayExternalStatus{
+				NATGatewayID: "some arbitrary id",
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		g.Expect(input.Resources).To(gomega.Equal([]string{mockManaged.Status.NATGatewayID}), "the passed parameters are not valid")
+		g.Expect(input.Tags).To(gomega.Equal([]awsec2.Tag{
+			{Key: aws.String("k"), Value: aws.String("v")},
+			{Key: aws.String(ec2.TagKeyName), Value: aws.String("some-name")},
+		}), "the passed parameters are not valid")
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTagsOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating the tags fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.NATGateway{
+		Status: v1alpha2.NATGatewayStatus{
+			NATGatewayExternalStatus: v1alpha2.NATGatewayExternalStatus{
+				NATGatewayID: "some arbitrary id",
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockDeleteNatGatewayRequest = func(input *awsec2.DeleteNatGatewayInput) awsec2.DeleteNatGatewayRequest {
+		g.Expect(aws.StringValue(input.NatGatewayId)).To(gomega.Equal(mockManaged.Status.NATGatewayID), "the passed parameters are not valid")
+		return awsec2.DeleteNatGatewayRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteNatGatewayOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"if status doesn't have the resource ID, it should return an error",
+			&v1alpha2.NATGateway{},
+			nil,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.NATGatewayNotFound, "", nil),
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.NATGateway)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
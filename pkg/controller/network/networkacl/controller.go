@@ -0,0 +1,409 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject   = "The managed resource is not an NetworkACL resource"
+	errClient             = "cannot create a new NetworkACL client"
+	errDescribe           = "failed to describe NetworkACL with id: %v"
+	errMultipleItems      = "retrieved multiple NetworkACLs for the given networkAclId: %v"
+	errCreate             = "failed to create the NetworkACL resource"
+	errCreateTags         = "failed to create tags for the NetworkACL resource"
+	errDeleteNotPresent   = "cannot delete the NetworkACL, since the NetworkACLID is not present"
+	errDelete             = "failed to delete the NetworkACL resource"
+	errCreateEntry        = "failed to create entry %v in the NetworkACL resource"
+	errReplaceEntry       = "failed to replace entry %v in the NetworkACL resource"
+	errDeleteEntry        = "failed to delete entry %v in the NetworkACL resource"
+	errDescribeSubnet     = "failed to describe the network ACL association of subnet %v"
+	errDescribeDefault    = "failed to describe the default network ACL of VPC %v"
+	errAssociateSubnet    = "failed to associate subnet %v to the NetworkACL resource"
+	errDisassociateSubnet = "failed to disassociate subnet %v from the NetworkACL resource"
+)
+
+// Controller is the controller for NetworkACL objects
+type Controller struct{}
+
+// SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(v1alpha2.NetworkACLGroupVersionKind),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewNetworkACLClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
+		resource.WithManagedConnectionPublishers())
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.NetworkACLKindAPIVersion, v1alpha2.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.NetworkACL{}).
+		Complete(r)
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.NetworkACLClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha2.NetworkACL)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, cr.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{c}, nil
+}
+
+type external struct {
+	client ec2.NetworkACLClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) {
+	cr, ok := mgd.(*v1alpha2.NetworkACL)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// To find out whether a NetworkACL exist:
+	// - the ID of the NetworkACL should be known, see identify
+	// - a NetworkACL with the given networkAclId should exist
+	id, err := e.identify(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if id == "" {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, id)
+	if ec2.IsNetworkACLNotFoundErr(err) {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+
+	// network ACLs are usable as soon as they are created
+	cr.SetConditions(runtimev1alpha1.Available())
+
+	cr.UpdateExternalStatus(observed)
+
+	return resource.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  ec2.IsNetworkACLUpToDate(cr.Spec.NetworkACLParameters, observed) && ec2.AreTagsUpToDate(cr, cr.Spec.Tags, observed.Tags),
+		ConnectionDetails: resource.ConnectionDetails{},
+	}, nil
+}
+
+// identify returns the ID of the NetworkACL that belongs to the supplied
+// object, see ec2.Identify.
+func (e *external) identify(ctx context.Context, cr *v1alpha2.NetworkACL) (string, error) {
+	return ec2.Identify(ctx, cr, cr.Status.NetworkACLID, "", e.describeIDs)
+}
+
+// describeIDs returns the IDs of the NetworkACLs that match the supplied
+// filters.
+func (e *external) describeIDs(ctx context.Context, filters []awsec2.Filter) ([]string, error) {
+	req := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{Filters: filters})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(response.NetworkAcls))
+	for i, r := range response.NetworkAcls {
+		ids[i] = aws.StringValue(r.NetworkAclId)
+	}
+	return ids, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha2.NetworkACL)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+
+	req := e.client.CreateNetworkAclRequest(&awsec2.CreateNetworkAclInput{
+		VpcId: aws.String(cr.Spec.VPCID),
+	})
+	req.SetContext(ctx)
+	result, err := req.Send()
+	if err != nil {
+		return resource.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	cr.UpdateExternalStatus(*result.NetworkAcl)
+
+	// a new network ACL only has its default entries, and no associations
+	return resource.ExternalCreation{}, e.sync(ctx, cr, *result.NetworkAcl)
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha2.NetworkACL)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr.Status.NetworkACLID)
+	if err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+
+	return resource.ExternalUpdate{}, e.sync(ctx, cr, observed)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha2.NetworkACL)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	if cr.Status.NetworkACLID == "" {
+		return errors.New(errDeleteNotPresent)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	// a network ACL cannot be deleted while it is associated with subnets, so
+	// they are associated with the default network ACL of the VPC first
+	if len(cr.Status.Associations) > 0 {
+		defaultID, err := e.defaultNetworkACLID(ctx, cr.Spec.VPCID)
+		if err != nil {
+			return err
+		}
+		for _, asc := range cr.Status.Associations {
+			if err := e.replaceAssociation(ctx, asc.AssociationID, defaultID); err != nil && !ec2.IsAssociationIDNotFoundErr(err) {
+				return errors.Wrapf(err, errDisassociateSubnet, asc.SubnetID)
+			}
+		}
+	}
+
+	req := e.client.DeleteNetworkAclRequest(&awsec2.DeleteNetworkAclInput{
+		NetworkAclId: aws.String(cr.Status.NetworkACLID),
+	})
+	req.SetContext(ctx)
+
+	_, err := req.Send()
+
+	if ec2.IsNetworkACLNotFoundErr(err) {
+		return nil
+	}
+
+	return errors.Wrap(err, errDelete)
+}
+
+// sync creates, replaces and deletes the entries of the supplied observed
+// network ACL, and moves subnets to and from it, until it matches the
+// desired state of the supplied NetworkACL.
+func (e *external) sync(ctx context.Context, cr *v1alpha2.NetworkACL, observed awsec2.NetworkAcl) error { // nolint:gocyclo
+	id := cr.Status.NetworkACLID
+
+	if tags := ec2.TagsToCreate(ec2.GenerateTags(cr, cr.Spec.Tags), observed.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{id},
+			Tags:      tags,
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	for _, egress := range []bool{false, true} {
+		desired := cr.Spec.IngressEntries
+		if egress {
+			desired = cr.Spec.EgressEntries
+		}
+
+		create, replace, remove := ec2.DiffNetworkACLEntries(desired, observed.Entries, egress)
+
+		// entries are removed first, so that a rule number that is freed is
+		// never briefly shared with another rule
+		for _, entry := range remove {
+			req := e.client.DeleteNetworkAclEntryRequest(ec2.GenerateDeleteNetworkACLEntryInput(id, entry))
+			req.SetContext(ctx)
+
+			if _, err := req.Send(); err != nil && !ec2.IsNetworkACLEntryNotFoundErr(err) {
+				return errors.Wrapf(err, errDeleteEntry, aws.Int64Value(entry.RuleNumber))
+			}
+		}
+
+		for _, entry := range replace {
+			req := e.client.ReplaceNetworkAclEntryRequest(ec2.GenerateReplaceNetworkACLEntryInput(id, egress, entry))
+			req.SetContext(ctx)
+
+			if _, err := req.Send(); err != nil {
+				return errors.Wrapf(err, errReplaceEntry, entry.RuleNumber)
+			}
+		}
+
+		for _, entry := range create {
+			req := e.client.CreateNetworkAclEntryRequest(ec2.GenerateCreateNetworkACLEntryInput(id, egress, entry))
+			req.SetContext(ctx)
+
+			if _, err := req.Send(); err != nil {
+				return errors.Wrapf(err, errCreateEntry, entry.RuleNumber)
+			}
+		}
+	}
+
+	associate, disassociate := ec2.DiffNetworkACLAssociations(cr.Spec.Associations, observed.Associations)
+	for _, subnetID := range associate {
+		associationID, err := e.associationID(ctx, subnetID)
+		if err != nil {
+			return err
+		}
+		if err := e.replaceAssociation(ctx, associationID, id); err != nil {
+			return errors.Wrapf(err, errAssociateSubnet, subnetID)
+		}
+	}
+
+	if len(disassociate) == 0 {
+		return nil
+	}
+
+	defaultID, err := e.defaultNetworkACLID(ctx, cr.Spec.VPCID)
+	if err != nil {
+		return err
+	}
+	for _, asc := range disassociate {
+		err := e.replaceAssociation(ctx, aws.StringValue(asc.NetworkAclAssociationId), defaultID)
+		if err != nil && !ec2.IsAssociationIDNotFoundErr(err) {
+			return errors.Wrapf(err, errDisassociateSubnet, aws.StringValue(asc.SubnetId))
+		}
+	}
+
+	return nil
+}
+
+func (e *external) describe(ctx context.Context, id string) (awsec2.NetworkAcl, error) {
+	req := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		NetworkAclIds: []string{id},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if ec2.IsNetworkACLNotFoundErr(err) {
+		return awsec2.NetworkAcl{}, err
+	}
+	if err != nil {
+		return awsec2.NetworkAcl{}, errors.Wrapf(err, errDescribe, id)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.NetworkAcls) != 1 {
+		return awsec2.NetworkAcl{}, errors.Errorf(errMultipleItems, id)
+	}
+
+	return response.NetworkAcls[0], nil
+}
+
+// associationID returns the ID of the association between the supplied
+// subnet and the network ACL it is currently associated with. Every subnet is
+// associated with exactly one network ACL.
+func (e *external) associationID(ctx context.Context, subnetID string) (string, error) {
+	req := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2.Filter{{Name: aws.String("association.subnet-id"), Values: []string{subnetID}}},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return "", errors.Wrapf(err, errDescribeSubnet, subnetID)
+	}
+
+	for _, acl := range response.NetworkAcls {
+		for _, asc := range acl.Associations {
+			if aws.StringValue(asc.SubnetId) == subnetID {
+				return aws.StringValue(asc.NetworkAclAssociationId), nil
+			}
+		}
+	}
+
+	return "", errors.Errorf(errDescribeSubnet, subnetID)
+}
+
+// defaultNetworkACLID returns the ID of the default network ACL of the
+// supplied VPC.
+func (e *external) defaultNetworkACLID(ctx context.Context, vpcID string) (string, error) {
+	req := e.client.DescribeNetworkAclsRequest(&awsec2.DescribeNetworkAclsInput{
+		Filters: []awsec2.Filter{
+			{Name: aws.String("vpc-id"), Values: []string{vpcID}},
+			{Name: aws.String("default"), Values: []string{"true"}},
+		},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return "", errors.Wrapf(err, errDescribeDefault, vpcID)
+	}
+
+	if len(response.NetworkAcls) != 1 {
+		return "", errors.Errorf(errDescribeDefault, vpcID)
+	}
+
+	return aws.StringValue(response.NetworkAcls[0].NetworkAclId), nil
+}
+
+func (e *external) replaceAssociation(ctx context.Context, associationID, aclID string) error {
+	req := e.client.ReplaceNetworkAclAssociationRequest(&awsec2.ReplaceNetworkAclAssociationInput{
+		AssociationId: aws.String(associationID),
+		NetworkAclId:  aws.String(aclID),
+	})
+	req.SetContext(ctx)
+
+	_, err := req.Send()
+	return err
+}
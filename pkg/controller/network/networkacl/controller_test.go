@@ -0,0 +1,605 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package networkacl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockNetworkACLClient
+
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockNetworkACLClient{}
+	mockExternalClient = external{&mockClient}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha2.NetworkACL{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.NetworkACLClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.NetworkACL{
+		Spec: v1alpha2.NetworkACLSpec{
+			NetworkACLParameters: v1alpha2.NetworkACLParameters{
+				IngressEntries: []v1alpha2.NetworkACLEntry{
+					{RuleNumber: 100, Protocol: "-1", RuleAction: "allow", CIDRBlock: "10.0.0.0/8"},
+				},
+			},
+		},
+		Status: v1alpha2.NetworkACLStatus{
+			NetworkACLExternalStatus: v1alpha2.NetworkACLExternalStatus{
+				NetworkACLID: "some arbitrary id",
+			},
+		},
+	}
+
+	upToDate := awsec2.NetworkAcl{
+		NetworkAclId: aws.String("some arbitrary id"),
+		Entries: []awsec2.NetworkAclEntry{
+			{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionAllow, CidrBlock: aws.String("10.0.0.0/8")},
+			{RuleNumber: aws.Int64(32767), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+		},
+	}
+	stale := awsec2.NetworkAcl{
+		NetworkAclId: aws.String("some arbitrary id"),
+		Entries: []awsec2.NetworkAclEntry{
+			{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionDeny, CidrBlock: aws.String("10.0.0.0/8")},
+		},
+	}
+
+	var mockClientErr error
+	var itemsList []awsec2.NetworkAcl
+	mockClient.MockDescribeNetworkAclsRequest = func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+		return awsec2.DescribeNetworkAclsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeNetworkAclsOutput{
+					NetworkAcls: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.NetworkAcl
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			[]awsec2.NetworkAcl{upToDate},
+			nil,
+			true,
+			true,
+			true,
+		},
+		{
+			"network ACL with changed entries should not be up to date",
+			mockManaged.DeepCopy(),
+			[]awsec2.NetworkAcl{stale},
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha2.NetworkACL{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.NetworkACLIDNotFound, "", nil),
+			true,
+			false,
+			false,
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.NetworkAcl{},
+			nil,
+			false,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha2.NetworkACL)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionTrue), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonAvailable), tc.description)
+			g.Expect(mgd.Status.NetworkACLID).To(gomega.Equal("some arbitrary id"), tc.description)
+		}
+	}
+}
+
+// mockNetworkACLRequests sets up the client mocks of entry and association
+// requests, and returns a function that returns the names of the requests
+// that were sent since it was last called.
+func mockNetworkACLRequests(acl awsec2.NetworkAcl, err *error) func() []string {
+	sent := []string{}
+
+	mockClient.MockDescribeNetworkAclsRequest = func(input *awsec2.DescribeNetworkAclsInput) awsec2.DescribeNetworkAclsRequest {
+		acls := []awsec2.NetworkAcl{acl}
+		for _, f := range input.Filters {
+			switch aws.StringValue(f.Name) {
+			case "default":
+				acls = []awsec2.NetworkAcl{{NetworkAclId: aws.String("acl-default")}}
+			case "association.subnet-id":
+				acls = []awsec2.NetworkAcl{{
+					NetworkAclId: aws.String("acl-default"),
+					Associations: []awsec2.NetworkAclAssociation{
+						{NetworkAclAssociationId: aws.String("aclassoc-" + f.Values[0]), SubnetId: aws.String(f.Values[0])},
+					},
+				}}
+			}
+		}
+		return awsec2.DescribeNetworkAclsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DescribeNetworkAclsOutput{NetworkAcls: acls}},
+		}
+	}
+	mockClient.MockCreateNetworkAclEntryRequest = func(input *awsec2.CreateNetworkAclEntryInput) awsec2.CreateNetworkAclEntryRequest {
+		sent = append(sent, fmt.Sprintf("create %d", aws.Int64Value(input.RuleNumber)))
+		return awsec2.CreateNetworkAclEntryRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateNetworkAclEntryOutput{}, Error: *err},
+		}
+	}
+	mockClient.MockReplaceNetworkAclEntryRequest = func(input *awsec2.ReplaceNetworkAclEntryInput) awsec2.ReplaceNetworkAclEntryRequest {
+		sent = append(sent, fmt.Sprintf("replace %d", aws.Int64Value(input.RuleNumber)))
+		return awsec2.ReplaceNetworkAclEntryRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ReplaceNetworkAclEntryOutput{}, Error: *err},
+		}
+	}
+	mockClient.MockDeleteNetworkAclEntryRequest = func(input *awsec2.DeleteNetworkAclEntryInput) awsec2.DeleteNetworkAclEntryRequest {
+		sent = append(sent, fmt.Sprintf("delete %d", aws.Int64Value(input.RuleNumber)))
+		return awsec2.DeleteNetworkAclEntryRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteNetworkAclEntryOutput{}, Error: *err},
+		}
+	}
+	mockClient.MockReplaceNetworkAclAssociationRequest = func(input *awsec2.ReplaceNetworkAclAssociationInput) awsec2.ReplaceNetworkAclAssociationRequest {
+		sent = append(sent, fmt.Sprintf("associate %s %s", aws.StringValue(input.AssociationId), aws.StringValue(input.NetworkAclId)))
+		return awsec2.ReplaceNetworkAclAssociationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ReplaceNetworkAclAssociationOutput{}, Error: *err},
+		}
+	}
+
+	return func() []string {
+		s := sent
+		sent = []string{}
+		return s
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.NetworkACL{
+		Spec: v1alpha2.NetworkACLSpec{
+			NetworkACLParameters: v1alpha2.NetworkACLParameters{
+				VPCID: "arbitrary vpcId",
+				IngressEntries: []v1alpha2.NetworkACLEntry{
+					{RuleNumber: 100, Protocol: "tcp", RuleAction: "deny", CIDRBlock: "0.0.0.0/0", PortRange: &v1alpha2.NetworkACLPortRange{From: 22, To: 22}},
+				},
+				EgressEntries: []v1alpha2.NetworkACLEntry{
+					{RuleNumber: 100, Protocol: "-1", RuleAction: "allow", CIDRBlock: "0.0.0.0/0"},
+				},
+				Associations: []v1alpha2.NetworkACLAssociation{{SubnetID: "subnet-1"}},
+			},
+		},
+	}
+	mockExternal := awsec2.NetworkAcl{
+		NetworkAclId: aws.String("some arbitrary id"),
+		Entries: []awsec2.NetworkAclEntry{
+			{RuleNumber: aws.Int64(32767), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+			{RuleNumber: aws.Int64(32767), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+		},
+	}
+
+	var mockClientErr error
+	var mockRequestErr error
+	sent := mockNetworkACLRequests(mockExternal, &mockRequestErr)
+	mockClient.MockCreateNetworkAclRequest = func(input *awsec2.CreateNetworkAclInput) awsec2.CreateNetworkAclRequest {
+		g.Expect(aws.StringValue(input.VpcId)).To(gomega.Equal(mockManaged.Spec.VPCID), "the passed parameters are not valid")
+		return awsec2.CreateNetworkAclRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.CreateNetworkAclOutput{
+					NetworkAcl: &mockExternal,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description      string
+		managedObj       resource.Managed
+		clientErr        error
+		requestErr       error
+		expectedErrNil   bool
+		expectedRequests []string
+	}{
+		{
+			"valid input should create the entries and associations",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+			[]string{"create 100", "create 100", "associate aclassoc-subnet-1 some arbitrary id"},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			[]string{},
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			nil,
+			false,
+			[]string{},
+		},
+		{
+			"if creating an entry fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			[]string{"create 100"},
+		},
+	} {
+		mockClientErr = tc.clientErr
+		mockRequestErr = tc.requestErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(sent()).To(gomega.Equal(tc.expectedRequests), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.NetworkACL)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.NetworkACLID).To(gomega.Equal(aws.StringValue(mockExternal.NetworkAclId)), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.NetworkACL{
+		Spec: v1alpha2.NetworkACLSpec{
+			NetworkACLParameters: v1alpha2.NetworkACLParameters{
+				VPCID: "arbitrary vpcId",
+				IngressEntries: []v1alpha2.NetworkACLEntry{
+					{RuleNumber: 100, Protocol: "-1", RuleAction: "deny", CIDRBlock: "10.0.0.0/8"},
+					{RuleNumber: 200, Protocol: "-1", RuleAction: "allow", CIDRBlock: "0.0.0.0/0"},
+				},
+				Associations: []v1alpha2.NetworkACLAssociation{{SubnetID: "subnet-1"}, {SubnetID: "subnet-2"}},
+			},
+		},
+		Status: v1alpha2.NetworkACLStatus{
+			NetworkACLExternalStatus: v1alpha2.NetworkACLExternalStatus{
+				NetworkACLID: "acl-1",
+			},
+		},
+	}
+	observed := awsec2.NetworkAcl{
+		NetworkAclId: aws.String("acl-1"),
+		Entries: []awsec2.NetworkAclEntry{
+			{RuleNumber: aws.Int64(100), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionAllow, CidrBlock: aws.String("10.0.0.0/8")},
+			{RuleNumber: aws.Int64(150), Egress: aws.Bool(false), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionAllow, CidrBlock: aws.String("0.0.0.0/0")},
+			{RuleNumber: aws.Int64(100), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionAllow, CidrBlock: aws.String("0.0.0.0/0")},
+			{RuleNumber: aws.Int64(32767), Egress: aws.Bool(true), Protocol: aws.String("-1"), RuleAction: awsec2.RuleActionDeny, CidrBlock: aws.String("0.0.0.0/0")},
+		},
+		Associations: []awsec2.NetworkAclAssociation{
+			{NetworkAclAssociationId: aws.String("aclassoc-a"), SubnetId: aws.String("subnet-1")},
+			{NetworkAclAssociationId: aws.String("aclassoc-b"), SubnetId: aws.String("subnet-3")},
+		},
+	}
+
+	var mockRequestErr error
+	sent := mockNetworkACLRequests(observed, &mockRequestErr)
+
+	for _, tc := range []struct {
+		description      string
+		managedObj       resource.Managed
+		requestErr       error
+		expectedErrNil   bool
+		expectedRequests []string
+	}{
+		{
+			"drifted entries and associations should be reconciled",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+			[]string{
+				"delete 150",
+				"replace 100",
+				"create 200",
+				"delete 100",
+				"associate aclassoc-subnet-2 acl-1",
+				"associate aclassoc-b acl-default",
+			},
+		},
+		{
+			"if deleting an entry fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+			[]string{"delete 150"},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+			[]string{},
+		},
+	} {
+		mockRequestErr = tc.requestErr
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(sent()).To(gomega.Equal(tc.expectedRequests), tc.description)
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.NetworkACL{
+		Spec: v1alpha2.NetworkACLSpec{
+			NetworkACLParameters: v1alpha2.NetworkACLParameters{
+				VPCID: "arbitrary vpcId",
+			},
+		},
+		Status: v1alpha2.NetworkACLStatus{
+			NetworkACLExternalStatus: v1alpha2.NetworkACLExternalStatus{
+				NetworkACLID: "acl-1",
+				Associations: []v1alpha2.NetworkACLAssociationState{
+					{AssociationID: "aclassoc-a", NetworkACLAssociation: v1alpha2.NetworkACLAssociation{SubnetID: "subnet-1"}},
+				},
+			},
+		},
+	}
+
+	var mockRequestErr error
+	sent := mockNetworkACLRequests(awsec2.NetworkAcl{}, &mockRequestErr)
+
+	var mockClientErr error
+	mockClient.MockDeleteNetworkAclRequest = func(input *awsec2.DeleteNetworkAclInput) awsec2.DeleteNetworkAclRequest {
+		g.Expect(aws.StringValue(input.NetworkAclId)).To(gomega.Equal(mockManaged.Status.NetworkACLID), "the passed parameters are not valid")
+		return awsec2.DeleteNetworkAclRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteNetworkAclOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description      string
+		managedObj       resource.Managed
+		requestErr       error
+		clientErr        error
+		expectedErrNil   bool
+		expectedRequests []string
+	}{
+		{
+			"valid input should move subnets to the default network ACL and return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+			[]string{"associate aclassoc-a acl-default"},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			[]string{},
+		},
+		{
+			"if the network ACL id is not present, it should return error",
+			&v1alpha2.NetworkACL{},
+			nil,
+			nil,
+			false,
+			[]string{},
+		},
+		{
+			"if moving a subnet fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			nil,
+			false,
+			[]string{"associate aclassoc-a acl-default"},
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.NetworkACLIDNotFound, "", nil),
+			true,
+			[]string{"associate aclassoc-a acl-default"},
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			[]string{"associate aclassoc-a acl-default"},
+		},
+	} {
+		mockRequestErr = tc.requestErr
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(sent()).To(gomega.Equal(tc.expectedRequests), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.NetworkACL)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
 
 	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	awsclients "github.com/crossplaneio/stack-aws/pkg/clients"
 	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
 	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
 )
@@ -42,12 +43,16 @@ const (
 	errDescribe           = "failed to describe RouteTable with id: %v"
 	errMultipleItems      = "retrieved multiple RouteTables for the given routeTableId: %v"
 	errCreate             = "failed to create the RouteTable resource"
+	errAdopt              = "cannot adopt RouteTable %v named by the external name annotation, since it does not exist"
+	errCreateTags         = "failed to create tags for the RouteTable resource"
 	errDeleteNotPresent   = "cannot delete the RouteTable, since the RouteTableID is not present"
 	errDelete             = "failed to delete the RouteTable resource"
 	errCreateRoute        = "failed to create a route in the RouteTable resource"
+	errReplaceRoute       = "failed to replace a route in the RouteTable resource"
 	errDeleteRoute        = "failed to delete a route in the RouteTable resource"
 	errAssociateSubnet    = "failed to associate subnet %v to the RouteTable resource"
 	errDisassociateSubnet = "failed to disassociate subnet %v from the RouteTable resource"
+	errDescribeSubnet     = "failed to describe the RouteTable association of subnet %v"
 )
 
 // Controller is the controller for RouteTable objects
@@ -58,7 +63,7 @@ type Controller struct{}
 func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
 	r := resource.NewManagedReconciler(mgr,
 		resource.ManagedKind(v1alpha2.RouteTableGroupVersionKind),
-		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewRouteTableClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
 		resource.WithManagedConnectionPublishers())
 	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.RouteTableKindAPIVersion, v1alpha2.Group))
 	return ctrl.NewControllerManagedBy(mgr).
@@ -70,7 +75,7 @@ func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
 type connector struct {
 	client      client.Client
 	newClientFn func(*aws.Config) (ec2.RouteTableClient, error)
-	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
 }
 
 func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
@@ -79,7 +84,7 @@ func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resou
 		return nil, errors.New(errUnexpectedObject)
 	}
 
-	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, cr.Spec.Region)
 	if err != nil {
 		return nil, err
 	}
@@ -96,23 +101,27 @@ type external struct {
 	client ec2.RouteTableClient
 }
 
-func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) {
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) { // nolint:gocyclo
 	cr, ok := mgd.(*v1alpha2.RouteTable)
 	if !ok {
 		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
 	// To find out whether a RouteTable exist:
-	// - the object's ExternalState should have routeTableId populated
+	// - the ID of the RouteTable should be known, see identify
 	// - a RouteTable with the given routeTableId should exist
-	if cr.Status.RouteTableID == "" {
+	id, err := e.identify(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if id == "" {
 		return resource.ExternalObservation{
 			ResourceExists: false,
 		}, nil
 	}
 
 	req := e.client.DescribeRouteTablesRequest(&awsec2.DescribeRouteTablesInput{
-		RouteTableIds: []string{cr.Status.RouteTableID},
+		RouteTableIds: []string{id},
 	})
 	req.SetContext(ctx)
 
@@ -125,12 +134,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.
 	}
 
 	if err != nil {
-		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, cr.Status.RouteTableID)
+		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, id)
 	}
 
 	// in a successful response, there should be one and only one object
 	if len(response.RouteTables) != 1 {
-		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, cr.Status.RouteTableID)
+		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, id)
 	}
 
 	observed := response.RouteTables[0]
@@ -148,18 +157,54 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.
 
 	cr.UpdateExternalStatus(observed)
 
+	transitGateways, err := e.transitGatewayTargets(ctx, id, cr.Spec.Routes)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+
 	return resource.ExternalObservation{
 		ResourceExists:    true,
+		ResourceUpToDate:  ec2.IsRouteTableUpToDate(cr.Spec.RouteTableParameters, observed, transitGateways) && ec2.AreTagsUpToDate(cr, cr.Spec.Tags, observed.Tags),
 		ConnectionDetails: resource.ConnectionDetails{},
 	}, nil
 }
 
+// identify returns the ID of the RouteTable that belongs to the supplied
+// object, see ec2.Identify.
+func (e *external) identify(ctx context.Context, cr *v1alpha2.RouteTable) (string, error) {
+	return ec2.Identify(ctx, cr, cr.Status.RouteTableID, ec2.RouteTableIDPrefix, e.describeIDs)
+}
+
+// describeIDs returns the IDs of the RouteTables that match the supplied
+// filters.
+func (e *external) describeIDs(ctx context.Context, filters []awsec2.Filter) ([]string, error) {
+	req := e.client.DescribeRouteTablesRequest(&awsec2.DescribeRouteTablesInput{Filters: filters})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(response.RouteTables))
+	for i, r := range response.RouteTables {
+		ids[i] = aws.StringValue(r.RouteTableId)
+	}
+	return ids, nil
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) { // nolint:gocyclo
 	cr, ok := mgd.(*v1alpha2.RouteTable)
 	if !ok {
 		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	// an external name that refers to an existing RouteTable is adopted by Observe,
+	// so getting here means the RouteTable doesn't exist (anymore)
+	if id := ec2.ExternalNameID(cr, ec2.RouteTableIDPrefix); id != "" {
+		return resource.ExternalCreation{}, errors.Errorf(errAdopt, id)
+	}
+
 	cr.Status.SetConditions(runtimev1alpha1.Creating())
 
 	req := e.client.CreateRouteTableRequest(&awsec2.CreateRouteTableInput{
@@ -173,6 +218,18 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.E
 
 	cr.UpdateExternalStatus(*result.RouteTable)
 
+	if tags := ec2.GenerateTags(cr, cr.Spec.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.RouteTableID},
+			Tags:      v1alpha2.BuildEC2Tags(tags),
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalCreation{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
 	// Create Routes
 	if err := e.createRoutes(ctx, cr.Status.RouteTableID, cr.Spec.Routes, cr.Status.Routes); err != nil {
 		return resource.ExternalCreation{}, err
@@ -186,9 +243,90 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.E
 	return resource.ExternalCreation{}, nil
 }
 
-func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
-	// TODO(soorena776): add more sophisticated Update logic, once we
-	// categorize immutable vs mutable fields (see #727)
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha2.RouteTable)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	req := e.client.DescribeRouteTablesRequest(&awsec2.DescribeRouteTablesInput{
+		RouteTableIds: []string{cr.Status.RouteTableID},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return resource.ExternalUpdate{}, errors.Wrapf(err, errDescribe, cr.Status.RouteTableID)
+	}
+
+	if len(response.RouteTables) != 1 {
+		return resource.ExternalUpdate{}, errors.Errorf(errMultipleItems, cr.Status.RouteTableID)
+	}
+
+	observed := response.RouteTables[0]
+
+	if tags := ec2.TagsToCreate(ec2.GenerateTags(cr, cr.Spec.Tags), observed.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.RouteTableID},
+			Tags:      tags,
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	transitGateways, err := e.transitGatewayTargets(ctx, cr.Status.RouteTableID, cr.Spec.Routes)
+	if err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+
+	create, replace, remove := ec2.DiffRoutes(cr.Spec.Routes, observed.Routes, transitGateways)
+	for _, rt := range create {
+		req := e.client.CreateRouteRequest(ec2.GenerateCreateRouteInput(cr.Status.RouteTableID, rt))
+		req.SetContext(ctx)
+
+		if _, err := req.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errCreateRoute)
+		}
+	}
+
+	for _, rt := range replace {
+		req := e.client.ReplaceRouteRequest(ec2.GenerateReplaceRouteInput(cr.Status.RouteTableID, rt))
+		req.SetContext(ctx)
+
+		if _, err := req.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errReplaceRoute)
+		}
+	}
+
+	for _, rt := range remove {
+		req := e.client.DeleteRouteRequest(ec2.GenerateDeleteRouteInput(cr.Status.RouteTableID, rt))
+		req.SetContext(ctx)
+
+		if _, err := req.Send(); err != nil && !ec2.IsRouteNotFoundErr(err) {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errDeleteRoute)
+		}
+	}
+
+	associate, disassociate := ec2.DiffAssociations(cr.Spec.Associations, observed.Associations)
+	for _, asc := range associate {
+		if err := e.associate(ctx, cr.Status.RouteTableID, asc.SubnetID); err != nil {
+			return resource.ExternalUpdate{}, err
+		}
+	}
+
+	for _, asc := range disassociate {
+		req := e.client.DisassociateRouteTableRequest(&awsec2.DisassociateRouteTableInput{
+			AssociationId: asc.RouteTableAssociationId,
+		})
+		req.SetContext(ctx)
+
+		if _, err := req.Send(); err != nil && !ec2.IsAssociationIDNotFoundErr(err) {
+			return resource.ExternalUpdate{}, errors.Wrapf(err, errDisassociateSubnet, aws.StringValue(asc.SubnetId))
+		}
+	}
 
 	return resource.ExternalUpdate{}, nil
 }
@@ -232,6 +370,27 @@ func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	return errors.Wrap(err, errDelete)
 }
 
+// transitGatewayTargets returns the transit gateway targets of the routes of
+// the supplied route table by destination. They are only described when one of
+// the supplied desired routes targets a transit gateway.
+func (e *external) transitGatewayTargets(ctx context.Context, id string, desired []v1alpha2.Route) (map[string]string, error) {
+	if !ec2.HasTransitGatewayRoute(desired) {
+		return nil, nil
+	}
+
+	req := e.client.DescribeRouteTransitGatewaysRequest(&awsec2.DescribeRouteTablesInput{
+		RouteTableIds: []string{id},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, errors.Wrapf(err, errDescribe, id)
+	}
+
+	return ec2.TransitGatewayTargets(response), nil
+}
+
 func (e *external) createRoutes(ctx context.Context, tableID string, desired []v1alpha2.Route, observed []v1alpha2.RouteState) error {
 	for _, rt := range desired {
 		isObserved := false
@@ -243,11 +402,7 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 		}
 		// if the route is already created (e.g. is observed), skip it
 		if !isObserved {
-			req := e.client.CreateRouteRequest(&awsec2.CreateRouteInput{
-				RouteTableId:         aws.String(tableID),
-				DestinationCidrBlock: aws.String(rt.DestinationCIDRBlock),
-				GatewayId:            aws.String(rt.GatewayID),
-			})
+			req := e.client.CreateRouteRequest(ec2.GenerateCreateRouteInput(tableID, rt))
 			req.SetContext(ctx)
 
 			if _, err := req.Send(); err != nil {
@@ -261,15 +416,16 @@ func (e *external) createRoutes(ctx context.Context, tableID string, desired []v
 
 func (e *external) deleteRoutes(ctx context.Context, tableID string, observed []v1alpha2.RouteState) error {
 	for _, rt := range observed {
-		// "local" routes cannot be deleted
-		// https://docs.aws.amazon.com/vpc/latest/userguide/VPC_Route_Tables.html
-		if rt.GatewayID == ec2.LocalGatewayID {
+		// like Update, only routes created by a CreateRoute call are deleted;
+		// the local route, propagated routes and the routes of gateway VPC
+		// endpoints are left to their owners
+		if rt.Origin != string(awsec2.RouteOriginCreateRoute) || rt.DestinationPrefixListID != "" {
 			continue
 		}
-		req := e.client.DeleteRouteRequest(&awsec2.DeleteRouteInput{
-			RouteTableId:         aws.String(tableID),
-			DestinationCidrBlock: aws.String(rt.DestinationCIDRBlock),
-		})
+		req := e.client.DeleteRouteRequest(ec2.GenerateDeleteRouteInput(tableID, awsec2.Route{
+			DestinationCidrBlock:     awsclients.String(rt.DestinationCIDRBlock),
+			DestinationIpv6CidrBlock: awsclients.String(rt.DestinationIPv6CIDRBlock),
+		}))
 		req.SetContext(ctx)
 
 		if _, err := req.Send(); err != nil {
@@ -294,14 +450,8 @@ func (e *external) createAssociations(ctx context.Context, tableID string, desir
 		}
 		// if the association is already created (e.g. is observed), skip it
 		if !isObserved {
-			req := e.client.AssociateRouteTableRequest(&awsec2.AssociateRouteTableInput{
-				RouteTableId: aws.String(tableID),
-				SubnetId:     aws.String(asc.SubnetID),
-			})
-			req.SetContext(ctx)
-
-			if _, err := req.Send(); err != nil {
-				return errors.Wrapf(err, errAssociateSubnet, asc.SubnetID)
+			if err := e.associate(ctx, tableID, asc.SubnetID); err != nil {
+				return err
 			}
 		}
 	}
@@ -309,6 +459,61 @@ func (e *external) createAssociations(ctx context.Context, tableID string, desir
 	return nil
 }
 
+// associate associates the supplied subnet with the supplied route table. A
+// subnet can be explicitly associated with only one route table, so a subnet
+// that is associated with another route table is moved to this one.
+func (e *external) associate(ctx context.Context, tableID, subnetID string) error {
+	associationID, err := e.associationID(ctx, subnetID)
+	if err != nil {
+		return err
+	}
+
+	if associationID != "" {
+		req := e.client.ReplaceRouteTableAssociationRequest(&awsec2.ReplaceRouteTableAssociationInput{
+			AssociationId: aws.String(associationID),
+			RouteTableId:  aws.String(tableID),
+		})
+		req.SetContext(ctx)
+
+		_, err := req.Send()
+		return errors.Wrapf(err, errAssociateSubnet, subnetID)
+	}
+
+	req := e.client.AssociateRouteTableRequest(&awsec2.AssociateRouteTableInput{
+		RouteTableId: aws.String(tableID),
+		SubnetId:     aws.String(subnetID),
+	})
+	req.SetContext(ctx)
+
+	_, err = req.Send()
+	return errors.Wrapf(err, errAssociateSubnet, subnetID)
+}
+
+// associationID returns the ID of the explicit association between the
+// supplied subnet and a route table, or an empty string if the subnet is only
+// implicitly associated with the main route table of its VPC.
+func (e *external) associationID(ctx context.Context, subnetID string) (string, error) {
+	req := e.client.DescribeRouteTablesRequest(&awsec2.DescribeRouteTablesInput{
+		Filters: []awsec2.Filter{{Name: aws.String("association.subnet-id"), Values: []string{subnetID}}},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return "", errors.Wrapf(err, errDescribeSubnet, subnetID)
+	}
+
+	for _, rt := range response.RouteTables {
+		for _, asc := range rt.Associations {
+			if aws.StringValue(asc.SubnetId) == subnetID {
+				return aws.StringValue(asc.RouteTableAssociationId), nil
+			}
+		}
+	}
+
+	return "", nil
+}
+
 func (e *external) deleteAssociations(ctx context.Context, observed []v1alpha2.AssociationState) error {
 	for _, asc := range observed {
 		req := e.client.DisassociateRouteTableRequest(&awsec2.DisassociateRouteTableInput{
@@ -66,7 +66,7 @@ func Test_Connect(t *testing.T) {
 		newClientFn: func(conf *aws.Config) (ec2.RouteTableClient, error) {
 			return &mockClient, clientErr
 		},
-		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
 			return &aws.Config{}, configErr
 		},
 	}
@@ -238,6 +238,7 @@ func Test_Observe(t *testing.T) {
 
 		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
 		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedResourceExist), tc.description)
 		if tc.expectedResourceExist {
 
 			mgd := tc.managedObj.(*v1alpha2.RouteTable)
@@ -296,9 +297,9 @@ func Test_Create(t *testing.T) {
 
 	var mockClientCreateRouteErr error
 	var createRouteCalled bool
-	mockClient.MockCreateRouteRequest = func(input *awsec2.CreateRouteInput) awsec2.CreateRouteRequest {
+	mockClient.MockCreateRouteRequest = func(input *ec2.CreateRouteInput) awsec2.CreateRouteRequest {
 		createRouteCalled = true
-		g.Expect(aws.StringValue(input.RouteTableId)).To(gomega.Equal(aws.StringValue(mockExternal.RouteTableId)), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.RouteTableID)).To(gomega.Equal(aws.StringValue(mockExternal.RouteTableId)), "the passed parameters are not valid")
 		return awsec2.CreateRouteRequest{
 			Request: &aws.Request{
 				HTTPRequest: &http.Request{},
@@ -308,6 +309,12 @@ func Test_Create(t *testing.T) {
 		}
 	}
 
+	mockClient.MockDescribeRouteTablesRequest = func(input *awsec2.DescribeRouteTablesInput) awsec2.DescribeRouteTablesRequest {
+		return awsec2.DescribeRouteTablesRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DescribeRouteTablesOutput{}},
+		}
+	}
+
 	var mockClientAssociateRouteErr error
 	var associateCalled bool
 	mockClient.MockAssociateRouteTableRequest = func(input *awsec2.AssociateRouteTableInput) awsec2.AssociateRouteTableRequest {
@@ -453,11 +460,202 @@ func Test_Create(t *testing.T) {
 func Test_Update(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
-	mockManaged := v1alpha2.RouteTable{}
+	mockManaged := v1alpha2.RouteTable{
+		Spec: v1alpha2.RouteTableSpec{
+			RouteTableParameters: v1alpha2.RouteTableParameters{
+				Routes: []v1alpha2.Route{
+					{DestinationCIDRBlock: "0.0.0.0/0", GatewayID: "igw-new"},
+					{DestinationCIDRBlock: "10.1.0.0/16", GatewayID: "igw-other"},
+				},
+				Associations: []v1alpha2.Association{
+					{SubnetID: "subnet-kept"},
+					{SubnetID: "subnet-new"},
+				},
+			},
+		},
+		Status: v1alpha2.RouteTableStatus{
+			RouteTableExternalStatus: v1alpha2.RouteTableExternalStatus{
+				RouteTableID: "some arbitrary id",
+			},
+		},
+	}
+	mockExternal := awsec2.RouteTable{
+		RouteTableId: aws.String("some arbitrary id"),
+		Routes: []awsec2.Route{
+			{DestinationCidrBlock: aws.String("10.0.0.0/16"), GatewayId: aws.String(ec2.LocalGatewayID), Origin: awsec2.RouteOriginCreateRouteTable},
+			{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-old"), Origin: awsec2.RouteOriginCreateRoute},
+			{DestinationCidrBlock: aws.String("10.2.0.0/16"), GatewayId: aws.String("igw-stale"), Origin: awsec2.RouteOriginCreateRoute},
+		},
+		Associations: []awsec2.RouteTableAssociation{
+			{Main: aws.Bool(true), RouteTableAssociationId: aws.String("main")},
+			{SubnetId: aws.String("subnet-kept"), RouteTableAssociationId: aws.String("kept")},
+			{SubnetId: aws.String("subnet-stale"), RouteTableAssociationId: aws.String("stale")},
+		},
+	}
+
+	var mockDescribeErr error
+	var subnetAssociationID string
+	mockClient.MockDescribeRouteTablesRequest = func(input *awsec2.DescribeRouteTablesInput) awsec2.DescribeRouteTablesRequest {
+		tables := []awsec2.RouteTable{mockExternal}
+		if len(input.Filters) > 0 {
+			tables = nil
+			if subnetAssociationID != "" {
+				tables = []awsec2.RouteTable{{
+					RouteTableId: aws.String("some other id"),
+					Associations: []awsec2.RouteTableAssociation{
+						{SubnetId: aws.String(input.Filters[0].Values[0]), RouteTableAssociationId: aws.String(subnetAssociationID)},
+					},
+				}}
+			}
+		}
+		return awsec2.DescribeRouteTablesRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeRouteTablesOutput{RouteTables: tables},
+				Error:       mockDescribeErr,
+			},
+		}
+	}
+
+	var mockCreateRouteErr error
+	var created, replaced, deleted, associated, reassociated, disassociated []string
+	mockClient.MockCreateRouteRequest = func(input *ec2.CreateRouteInput) awsec2.CreateRouteRequest {
+		created = append(created, aws.StringValue(input.DestinationCIDRBlock))
+		return awsec2.CreateRouteRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateRouteOutput{}, Error: mockCreateRouteErr},
+		}
+	}
+	mockClient.MockReplaceRouteRequest = func(input *ec2.ReplaceRouteInput) awsec2.ReplaceRouteRequest {
+		replaced = append(replaced, aws.StringValue(input.GatewayID))
+		return awsec2.ReplaceRouteRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ReplaceRouteOutput{}},
+		}
+	}
+	mockClient.MockDeleteRouteRequest = func(input *awsec2.DeleteRouteInput) awsec2.DeleteRouteRequest {
+		deleted = append(deleted, aws.StringValue(input.DestinationCidrBlock))
+		return awsec2.DeleteRouteRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteRouteOutput{}},
+		}
+	}
+	mockClient.MockAssociateRouteTableRequest = func(input *awsec2.AssociateRouteTableInput) awsec2.AssociateRouteTableRequest {
+		associated = append(associated, aws.StringValue(input.SubnetId))
+		return awsec2.AssociateRouteTableRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.AssociateRouteTableOutput{}},
+		}
+	}
+	mockClient.MockReplaceRouteTableAssociationRequest = func(input *awsec2.ReplaceRouteTableAssociationInput) awsec2.ReplaceRouteTableAssociationRequest {
+		reassociated = append(reassociated, aws.StringValue(input.AssociationId))
+		return awsec2.ReplaceRouteTableAssociationRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ReplaceRouteTableAssociationOutput{}},
+		}
+	}
+	mockClient.MockDisassociateRouteTableRequest = func(input *awsec2.DisassociateRouteTableInput) awsec2.DisassociateRouteTableRequest {
+		disassociated = append(disassociated, aws.StringValue(input.AssociationId))
+		return awsec2.DisassociateRouteTableRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DisassociateRouteTableOutput{}},
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		describeErr           error
+		createRouteErr        error
+		expectedErrNil        bool
+		expectedCreated       []string
+		expectedReplaced      []string
+		expectedDeleted       []string
+		expectedAssociated    []string
+		expectedDisassociated []string
+		subnetAssociationID   string
+		expectedReassociated  []string
+	}{
+		{
+			"valid input should update routes and associations incrementally",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+			[]string{"10.1.0.0/16"},
+			[]string{"igw-new"},
+			[]string{"10.2.0.0/16"},
+			[]string{"subnet-new"},
+			[]string{"stale"},
+			"",
+			nil,
+		},
+		{
+			"a subnet associated with another route table should be moved to this one",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+			[]string{"10.1.0.0/16"},
+			[]string{"igw-new"},
+			[]string{"10.2.0.0/16"},
+			nil,
+			[]string{"stale"},
+			"other",
+			[]string{"other"},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			"",
+			nil,
+		},
+		{
+			"if describing the resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			nil,
+			false,
+			nil,
+			nil,
+			nil,
+			nil,
+			nil,
+			"",
+			nil,
+		},
+		{
+			"if creating a route fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			[]string{"10.1.0.0/16"},
+			nil,
+			nil,
+			nil,
+			nil,
+			"",
+			nil,
+		},
+	} {
+		created, replaced, deleted, associated, reassociated, disassociated = nil, nil, nil, nil, nil, nil
+		mockDescribeErr = tc.describeErr
+		subnetAssociationID = tc.subnetAssociationID
+		mockCreateRouteErr = tc.createRouteErr
 
-	_, err := mockExternalClient.Update(context.Background(), &mockManaged)
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
 
-	g.Expect(err).To(gomega.BeNil())
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(created).To(gomega.Equal(tc.expectedCreated), tc.description)
+		g.Expect(replaced).To(gomega.Equal(tc.expectedReplaced), tc.description)
+		g.Expect(deleted).To(gomega.Equal(tc.expectedDeleted), tc.description)
+		g.Expect(associated).To(gomega.Equal(tc.expectedAssociated), tc.description)
+		g.Expect(disassociated).To(gomega.Equal(tc.expectedDisassociated), tc.description)
+		g.Expect(reassociated).To(gomega.Equal(tc.expectedReassociated), tc.description)
+	}
 }
 
 func Test_Delete(t *testing.T) {
@@ -469,6 +667,7 @@ func Test_Delete(t *testing.T) {
 				RouteTableID: "an arbitrary id",
 				Routes: []v1alpha2.RouteState{
 					{
+						Origin: string(awsec2.RouteOriginCreateRoute),
 						Route: v1alpha2.Route{
 							DestinationCIDRBlock: "arbitrary dcb 0",
 							GatewayID:            "arbitrary gatewayid 0",
@@ -600,7 +799,7 @@ func Test_Delete(t *testing.T) {
 					RouteTableExternalStatus: v1alpha2.RouteTableExternalStatus{
 						RouteTableID: "an arbitrary id",
 						Routes: []v1alpha2.RouteState{
-							{Route: v1alpha2.Route{
+							{Origin: string(awsec2.RouteOriginCreateRouteTable), Route: v1alpha2.Route{
 								DestinationCIDRBlock: "arbitrary dcb 0",
 								GatewayID:            ec2.LocalGatewayID,
 							}},
@@ -648,3 +847,42 @@ func Test_Delete(t *testing.T) {
 		g.Expect(disassociateCalled).To(gomega.Equal(tc.expectedDisassociateCall), tc.description)
 	}
 }
+
+func Test_DeleteRoutes(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha2.RouteTable{
+		Status: v1alpha2.RouteTableStatus{
+			RouteTableExternalStatus: v1alpha2.RouteTableExternalStatus{
+				RouteTableID: "rtb-1",
+				Routes: []v1alpha2.RouteState{
+					{Origin: string(awsec2.RouteOriginCreateRouteTable), Route: v1alpha2.Route{DestinationCIDRBlock: "10.0.0.0/16", GatewayID: ec2.LocalGatewayID}},
+					{Origin: string(awsec2.RouteOriginCreateRoute), Route: v1alpha2.Route{DestinationIPv6CIDRBlock: "::/0", EgressOnlyInternetGatewayID: "eigw-1"}},
+					{Origin: string(awsec2.RouteOriginCreateRoute), DestinationPrefixListID: "pl-1", Route: v1alpha2.Route{GatewayID: "vpce-1"}},
+					{Origin: string(awsec2.RouteOriginEnableVgwRoutePropagation), Route: v1alpha2.Route{DestinationCIDRBlock: "172.16.0.0/12", GatewayID: "vgw-1"}},
+				},
+			},
+		},
+	}
+
+	var deleted []*awsec2.DeleteRouteInput
+	mockClient.MockDeleteRouteRequest = func(input *awsec2.DeleteRouteInput) awsec2.DeleteRouteRequest {
+		deleted = append(deleted, input)
+		return awsec2.DeleteRouteRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteRouteOutput{}},
+		}
+	}
+	mockClient.MockDeleteRouteTableRequest = func(input *awsec2.DeleteRouteTableInput) awsec2.DeleteRouteTableRequest {
+		return awsec2.DeleteRouteTableRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DeleteRouteTableOutput{}},
+		}
+	}
+
+	err := mockExternalClient.Delete(context.Background(), mockManaged)
+
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(deleted).To(gomega.HaveLen(1), "only the route created by CreateRoute should be deleted")
+	g.Expect(deleted[0].DestinationCidrBlock).To(gomega.BeNil(), "an IPv6 route should not be deleted by an IPv4 destination")
+	g.Expect(aws.StringValue(deleted[0].DestinationIpv6CidrBlock)).To(gomega.Equal("::/0"))
+	g.Expect(aws.StringValue(deleted[0].RouteTableId)).To(gomega.Equal("rtb-1"))
+}
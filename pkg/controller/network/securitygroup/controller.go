@@ -42,8 +42,12 @@ const (
 	errDescribe         = "failed to describe SecurityGroup with id: %v"
 	errMultipleItems    = "retrieved multiple SecurityGroups for the given securityGroupId: %v"
 	errCreate           = "failed to create the SecurityGroup resource"
+	errAdopt            = "cannot adopt SecurityGroup %v named by the external name annotation, since it does not exist"
+	errCreateTags       = "failed to create tags for the SecurityGroup resource"
 	errAuthorizeIngress = "failed to authorize ingress rules"
 	errAuthorizeEgress  = "failed to authorize egress rules"
+	errRevokeIngress    = "failed to revoke ingress rules"
+	errRevokeEgress     = "failed to revoke egress rules"
 	errDeleteNotPresent = "cannot delete the SecurityGroup, since the SecurityGroupID is not present"
 	errDelete           = "failed to delete the SecurityGroup resource"
 )
@@ -56,7 +60,7 @@ type Controller struct{}
 func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
 	r := resource.NewManagedReconciler(mgr,
 		resource.ManagedKind(v1alpha2.SecurityGroupGroupVersionKind),
-		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewSecurityGroupClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
 		resource.WithManagedConnectionPublishers())
 	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.SecurityGroupKindAPIVersion, v1alpha2.Group))
 	return ctrl.NewControllerManagedBy(mgr).
@@ -68,7 +72,7 @@ func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
 type connector struct {
 	client      client.Client
 	newClientFn func(*aws.Config) (ec2.SecurityGroupClient, error)
-	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
 }
 
 func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
@@ -77,7 +81,7 @@ func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resou
 		return nil, errors.New(errUnexpectedObject)
 	}
 
-	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, cr.Spec.Region)
 	if err != nil {
 		return nil, err
 	}
@@ -101,16 +105,20 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.
 	}
 
 	// To find out whether a SecurityGroup exist:
-	// - the object's ExternalState should have securityGroupId populated
+	// - the ID of the SecurityGroup should be known, see identify
 	// - a SecurityGroup with the given securityGroupId should exist
-	if cr.Status.SecurityGroupID == "" {
+	id, err := e.identify(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if id == "" {
 		return resource.ExternalObservation{
 			ResourceExists: false,
 		}, nil
 	}
 
 	req := e.client.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{
-		GroupIds: []string{cr.Status.SecurityGroupID},
+		GroupIds: []string{id},
 	})
 	req.SetContext(ctx)
 
@@ -123,12 +131,12 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.
 	}
 
 	if err != nil {
-		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, cr.Status.SecurityGroupID)
+		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, id)
 	}
 
 	// in a successful response, there should be one and only one object
 	if len(response.SecurityGroups) != 1 {
-		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, cr.Status.SecurityGroupID)
+		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, id)
 	}
 
 	observed := response.SecurityGroups[0]
@@ -138,19 +146,53 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.
 	cr.SetConditions(runtimev1alpha1.Available())
 
 	cr.UpdateExternalStatus(observed)
+	if len(cr.Spec.EgressPermissions) != 0 {
+		cr.Status.EgressManaged = true
+	}
 
 	return resource.ExternalObservation{
 		ResourceExists:    true,
+		ResourceUpToDate:  ec2.IsSecurityGroupUpToDate(cr.Spec.SecurityGroupParameters, observed, cr.Status.EgressManaged) && ec2.AreTagsUpToDate(cr, cr.Spec.Tags, observed.Tags),
 		ConnectionDetails: resource.ConnectionDetails{},
 	}, nil
 }
 
-func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
+// identify returns the ID of the SecurityGroup that belongs to the supplied
+// object, see ec2.Identify.
+func (e *external) identify(ctx context.Context, cr *v1alpha2.SecurityGroup) (string, error) {
+	return ec2.Identify(ctx, cr, cr.Status.SecurityGroupID, ec2.SecurityGroupIDPrefix, e.describeIDs)
+}
+
+// describeIDs returns the IDs of the SecurityGroups that match the supplied
+// filters.
+func (e *external) describeIDs(ctx context.Context, filters []awsec2.Filter) ([]string, error) {
+	req := e.client.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{Filters: filters})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(response.SecurityGroups))
+	for i, r := range response.SecurityGroups {
+		ids[i] = aws.StringValue(r.GroupId)
+	}
+	return ids, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) { // nolint:gocyclo
 	cr, ok := mgd.(*v1alpha2.SecurityGroup)
 	if !ok {
 		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	// an external name that refers to an existing SecurityGroup is adopted by Observe,
+	// so getting here means the SecurityGroup doesn't exist (anymore)
+	if id := ec2.ExternalNameID(cr, ec2.SecurityGroupIDPrefix); id != "" {
+		return resource.ExternalCreation{}, errors.Errorf(errAdopt, id)
+	}
+
 	cr.Status.SetConditions(runtimev1alpha1.Creating())
 
 	// Creating the SecurityGroup itself
@@ -168,12 +210,24 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.E
 
 	cr.UpdateExternalStatus(awsec2.SecurityGroup{GroupId: result.GroupId})
 
+	if tags := ec2.GenerateTags(cr, cr.Spec.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.SecurityGroupID},
+			Tags:      v1alpha2.BuildEC2Tags(tags),
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalCreation{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
 	if err != nil {
 		return resource.ExternalCreation{}, errors.Wrap(err, errCreate)
 	}
 
 	// Authorizing Ingress permissions for the SecurityGroup
-	ingressPerms := v1alpha2.BuildEC2Permissions(cr.Spec.IngressPermissions)
+	ingressPerms := v1alpha2.BuildEC2Permissions(cr.Spec.IngressPermissions, cr.Status.SecurityGroupID)
 	if len(ingressPerms) > 0 {
 		air := e.client.AuthorizeSecurityGroupIngressRequest(&awsec2.AuthorizeSecurityGroupIngressInput{
 			GroupId:       aws.String(cr.Status.SecurityGroupID),
@@ -188,8 +242,9 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.E
 	}
 
 	// Authorizing Egress permissions for the SecurityGroup
-	egressPerms := v1alpha2.BuildEC2Permissions(cr.Spec.EgressPermissions)
+	egressPerms := v1alpha2.BuildEC2Permissions(cr.Spec.EgressPermissions, cr.Status.SecurityGroupID)
 	if len(egressPerms) > 0 {
+		cr.Status.EgressManaged = true
 		aer := e.client.AuthorizeSecurityGroupEgressRequest(&awsec2.AuthorizeSecurityGroupEgressInput{
 			GroupId:       aws.String(cr.Status.SecurityGroupID),
 			IpPermissions: egressPerms,
@@ -205,9 +260,94 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.E
 	return resource.ExternalCreation{}, nil
 }
 
-func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
-	// TODO(soorena776): add more sophisticated Update logic, once we
-	// categorize immutable vs mutable fields (see #727)
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha2.SecurityGroup)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	req := e.client.DescribeSecurityGroupsRequest(&awsec2.DescribeSecurityGroupsInput{
+		GroupIds: []string{cr.Status.SecurityGroupID},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return resource.ExternalUpdate{}, errors.Wrapf(err, errDescribe, cr.Status.SecurityGroupID)
+	}
+
+	if len(response.SecurityGroups) != 1 {
+		return resource.ExternalUpdate{}, errors.Errorf(errMultipleItems, cr.Status.SecurityGroupID)
+	}
+
+	observed := response.SecurityGroups[0]
+
+	if tags := ec2.TagsToCreate(ec2.GenerateTags(cr, cr.Spec.Tags), observed.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.SecurityGroupID},
+			Tags:      tags,
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	// New rules are authorized before stale ones are revoked, so that traffic
+	// that is allowed by both is never interrupted.
+	authorize, revoke := ec2.DiffPermissions(v1alpha2.BuildEC2Permissions(cr.Spec.IngressPermissions, cr.Status.SecurityGroupID), observed.IpPermissions)
+	if len(authorize) > 0 {
+		air := e.client.AuthorizeSecurityGroupIngressRequest(&awsec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(cr.Status.SecurityGroupID),
+			IpPermissions: authorize,
+		})
+		air.SetContext(ctx)
+
+		if _, err := air.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errAuthorizeIngress)
+		}
+	}
+	if len(revoke) > 0 {
+		rir := e.client.RevokeSecurityGroupIngressRequest(&awsec2.RevokeSecurityGroupIngressInput{
+			GroupId:       aws.String(cr.Status.SecurityGroupID),
+			IpPermissions: revoke,
+		})
+		rir.SetContext(ctx)
+
+		if _, err := rir.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errRevokeIngress)
+		}
+	}
+
+	egress, managed := ec2.DesiredEgressPermissions(cr.Spec.SecurityGroupParameters, cr.Status.SecurityGroupID, cr.Status.EgressManaged)
+	if !managed {
+		return resource.ExternalUpdate{}, nil
+	}
+
+	authorize, revoke = ec2.DiffPermissions(egress, observed.IpPermissionsEgress)
+	if len(authorize) > 0 {
+		aer := e.client.AuthorizeSecurityGroupEgressRequest(&awsec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(cr.Status.SecurityGroupID),
+			IpPermissions: authorize,
+		})
+		aer.SetContext(ctx)
+
+		if _, err := aer.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errAuthorizeEgress)
+		}
+	}
+	if len(revoke) > 0 {
+		rer := e.client.RevokeSecurityGroupEgressRequest(&awsec2.RevokeSecurityGroupEgressInput{
+			GroupId:       aws.String(cr.Status.SecurityGroupID),
+			IpPermissions: revoke,
+		})
+		rer.SetContext(ctx)
+
+		if _, err := rer.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errRevokeEgress)
+		}
+	}
 
 	return resource.ExternalUpdate{}, nil
 }
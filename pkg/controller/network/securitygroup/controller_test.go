@@ -66,7 +66,7 @@ func Test_Connect(t *testing.T) {
 		newClientFn: func(conf *aws.Config) (ec2.SecurityGroupClient, error) {
 			return &mockClient, clientErr
 		},
-		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
 			return &aws.Config{}, configErr
 		},
 	}
@@ -223,6 +223,7 @@ func Test_Observe(t *testing.T) {
 			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionTrue), tc.description)
 			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonAvailable), tc.description)
 			g.Expect(len(mgd.Status.SecurityGroupExternalStatus.Tags)).To(gomega.Equal(len(mockExternal.Tags)), tc.description)
+			g.Expect(result.ResourceUpToDate).To(gomega.BeTrue(), tc.description)
 		}
 	}
 }
@@ -443,11 +444,167 @@ func Test_Create(t *testing.T) {
 func Test_Update(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
-	mockManaged := v1alpha2.SecurityGroup{}
+	mockManaged := v1alpha2.SecurityGroup{
+		Spec: v1alpha2.SecurityGroupSpec{
+			SecurityGroupParameters: v1alpha2.SecurityGroupParameters{
+				IngressPermissions: []v1alpha2.IPPermission{
+					{FromPort: 443, ToPort: 443, IPProtocol: "tcp", CIDRBlocks: []v1alpha2.IPRange{{CIDRIP: "10.0.0.0/8"}}},
+				},
+			},
+		},
+		Status: v1alpha2.SecurityGroupStatus{
+			SecurityGroupExternalStatus: v1alpha2.SecurityGroupExternalStatus{
+				SecurityGroupID: "some arbitrary id",
+			},
+		},
+	}
+	mockExternal := awsec2.SecurityGroup{
+		GroupId: aws.String("some arbitrary id"),
+		IpPermissions: []awsec2.IpPermission{
+			{FromPort: aws.Int64(22), ToPort: aws.Int64(22), IpProtocol: aws.String("tcp"), IpRanges: []awsec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}},
+		},
+		IpPermissionsEgress: []awsec2.IpPermission{
+			{IpProtocol: aws.String("-1"), IpRanges: []awsec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}},
+			{FromPort: aws.Int64(80), ToPort: aws.Int64(80), IpProtocol: aws.String("tcp"), IpRanges: []awsec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}}},
+		},
+	}
+
+	var mockDescribeErr error
+	mockClient.MockDescribeSecurityGroupsRequest = func(input *awsec2.DescribeSecurityGroupsInput) awsec2.DescribeSecurityGroupsRequest {
+		return awsec2.DescribeSecurityGroupsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeSecurityGroupsOutput{SecurityGroups: []awsec2.SecurityGroup{mockExternal}},
+				Error:       mockDescribeErr,
+			},
+		}
+	}
+
+	var mockAuthorizeErr error
+	var authorized, revoked, authorizedEgress, revokedEgress []awsec2.IpPermission
+	mockClient.MockAuthorizeSecurityGroupIngressRequest = func(input *awsec2.AuthorizeSecurityGroupIngressInput) awsec2.AuthorizeSecurityGroupIngressRequest {
+		authorized = append(authorized, input.IpPermissions...)
+		return awsec2.AuthorizeSecurityGroupIngressRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.AuthorizeSecurityGroupIngressOutput{}, Error: mockAuthorizeErr},
+		}
+	}
+	mockClient.MockRevokeSecurityGroupIngressRequest = func(input *awsec2.RevokeSecurityGroupIngressInput) awsec2.RevokeSecurityGroupIngressRequest {
+		revoked = append(revoked, input.IpPermissions...)
+		return awsec2.RevokeSecurityGroupIngressRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.RevokeSecurityGroupIngressOutput{}},
+		}
+	}
+	mockClient.MockAuthorizeSecurityGroupEgressRequest = func(input *awsec2.AuthorizeSecurityGroupEgressInput) awsec2.AuthorizeSecurityGroupEgressRequest {
+		authorizedEgress = append(authorizedEgress, input.IpPermissions...)
+		return awsec2.AuthorizeSecurityGroupEgressRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.AuthorizeSecurityGroupEgressOutput{}},
+		}
+	}
+	mockClient.MockRevokeSecurityGroupEgressRequest = func(input *awsec2.RevokeSecurityGroupEgressInput) awsec2.RevokeSecurityGroupEgressRequest {
+		revokedEgress = append(revokedEgress, input.IpPermissions...)
+		return awsec2.RevokeSecurityGroupEgressRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.RevokeSecurityGroupEgressOutput{}},
+		}
+	}
+
+	withEgress := mockManaged.DeepCopy()
+	withEgress.Spec.EgressPermissions = []v1alpha2.IPPermission{
+		{FromPort: 443, ToPort: 443, IPProtocol: "tcp", CIDRBlocks: []v1alpha2.IPRange{{CIDRIP: "0.0.0.0/0"}}},
+	}
+
+	egressManaged := mockManaged.DeepCopy()
+	egressManaged.Status.EgressManaged = true
 
-	_, err := mockExternalClient.Update(context.Background(), &mockManaged)
+	for _, tc := range []struct {
+		description              string
+		managedObj               resource.Managed
+		describeErr              error
+		authorizeErr             error
+		expectedErrNil           bool
+		expectedAuthorized       int
+		expectedRevoked          int
+		expectedAuthorizedEgress int
+		expectedRevokedEgress    int
+	}{
+		{
+			"valid input should authorize and revoke the ingress delta only",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+			1,
+			1,
+			0,
+			0,
+		},
+		{
+			"specified egress rules should be reconciled",
+			withEgress,
+			nil,
+			nil,
+			true,
+			1,
+			1,
+			1,
+			2,
+		},
+		{
+			"removing all managed egress rules should restore the default rule only",
+			egressManaged,
+			nil,
+			nil,
+			true,
+			1,
+			1,
+			0,
+			1,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			0,
+			0,
+			0,
+			0,
+		},
+		{
+			"if describing the resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			nil,
+			false,
+			0,
+			0,
+			0,
+			0,
+		},
+		{
+			"if authorizing fails, it should not revoke and return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			1,
+			0,
+			0,
+			0,
+		},
+	} {
+		authorized, revoked, authorizedEgress, revokedEgress = nil, nil, nil, nil
+		mockDescribeErr = tc.describeErr
+		mockAuthorizeErr = tc.authorizeErr
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
 
-	g.Expect(err).To(gomega.BeNil())
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(len(authorized)).To(gomega.Equal(tc.expectedAuthorized), tc.description)
+		g.Expect(len(revoked)).To(gomega.Equal(tc.expectedRevoked), tc.description)
+		g.Expect(len(authorizedEgress)).To(gomega.Equal(tc.expectedAuthorizedEgress), tc.description)
+		g.Expect(len(revokedEgress)).To(gomega.Equal(tc.expectedRevokedEgress), tc.description)
+	}
 }
 
 func Test_Delete(t *testing.T) {
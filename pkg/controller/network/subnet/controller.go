@@ -19,6 +19,7 @@ package subnet
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -32,6 +33,7 @@ import (
 	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
 
 	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	awsclients "github.com/crossplaneio/stack-aws/pkg/clients"
 	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
 	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
 )
@@ -42,6 +44,14 @@ const (
 	errDescribe         = "failed to describe Subnet with id: %v"
 	errMultipleItems    = "retrieved multiple Subnet for the given subnetId: %v"
 	errCreate           = "failed to create the Subnet resource"
+	errAdopt            = "cannot adopt Subnet %v named by the external name annotation, since it does not exist"
+	errUpdateManaged    = "cannot update the Subnet custom resource"
+	errDescribeAZ       = "failed to describe the Availability Zone with id: %v"
+	errUnknownAZ        = "cannot find the Availability Zone with id: %v"
+	errModifyAttributes = "failed to modify the Subnet resource attributes"
+	errAssociateCIDR    = "failed to associate a CIDR block with the Subnet resource"
+	errDisassociateCIDR = "failed to disassociate a CIDR block from the Subnet resource"
+	errCreateTags       = "failed to create tags for the Subnet resource"
 	errDeleteNotPresent = "cannot delete the Subnet, since the SubnetId is not present"
 	errDelete           = "failed to delete the Subnet resource"
 )
@@ -54,7 +64,7 @@ type Controller struct{}
 func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
 	r := resource.NewManagedReconciler(mgr,
 		resource.ManagedKind(v1alpha2.SubnetGroupVersionKind),
-		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewSubnetClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewSubnetClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
 		resource.WithManagedConnectionPublishers())
 	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.SubnetKindAPIVersion, v1alpha2.Group))
 	return ctrl.NewControllerManagedBy(mgr).
@@ -66,7 +76,7 @@ func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
 type connector struct {
 	client      client.Client
 	newClientFn func(*aws.Config) (ec2.SubnetClient, error)
-	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
 }
 
 func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
@@ -75,7 +85,7 @@ func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resou
 		return nil, errors.New(errUnexpectedObject)
 	}
 
-	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, cr.Spec.Region)
 	if err != nil {
 		return nil, err
 	}
@@ -85,30 +95,35 @@ func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resou
 		return nil, errors.Wrap(err, errClient)
 	}
 
-	return &external{c}, nil
+	return &external{client: c, kube: conn.client}, nil
 }
 
 type external struct {
 	client ec2.SubnetClient
+	kube   client.Client
 }
 
-func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) {
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) { // nolint:gocyclo
 	cr, ok := mgd.(*v1alpha2.Subnet)
 	if !ok {
 		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
 	// To find out whether a Subnet exist:
-	// - the object's ExternalState should have subnetId populated
+	// - the ID of the Subnet should be known, see identify
 	// - a Subnet with the given subnetId should exist
-	if cr.Status.SubnetID == "" {
+	id, err := e.identify(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if id == "" {
 		return resource.ExternalObservation{
 			ResourceExists: false,
 		}, nil
 	}
 
 	req := e.client.DescribeSubnetsRequest(&awsec2.DescribeSubnetsInput{
-		SubnetIds: []string{cr.Status.SubnetID},
+		SubnetIds: []string{id},
 	})
 	req.SetContext(ctx)
 
@@ -121,16 +136,20 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.
 	}
 
 	if err != nil {
-		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, cr.Status.SubnetID)
+		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, id)
 	}
 
 	// in a successful response, there should be one and only one object
 	if len(response.Subnets) != 1 {
-		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, cr.Status.SubnetID)
+		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, id)
 	}
 
 	observed := response.Subnets[0]
 
+	if err := e.lateInitialize(ctx, cr, observed); err != nil {
+		return resource.ExternalObservation{}, errors.Wrap(err, errUpdateManaged)
+	}
+
 	if observed.State == awsec2.SubnetStateAvailable {
 		cr.SetConditions(runtimev1alpha1.Available())
 	} else if observed.State == awsec2.SubnetStatePending {
@@ -141,22 +160,69 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.
 
 	return resource.ExternalObservation{
 		ResourceExists:    true,
+		ResourceUpToDate:  ec2.IsSubnetUpToDate(cr.Spec.SubnetParameters, observed) && ec2.AreTagsUpToDate(cr, cr.Spec.Tags, observed.Tags),
 		ConnectionDetails: resource.ConnectionDetails{},
 	}, nil
 }
 
+// lateInitialize fills the unset parameters of the supplied Subnet with the
+// ones of the observed Subnet, and persists them if any of them changed.
+func (e *external) lateInitialize(ctx context.Context, cr *v1alpha2.Subnet, observed awsec2.Subnet) error {
+	current := cr.Spec.SubnetParameters.DeepCopy()
+	ec2.LateInitializeSubnet(&cr.Spec.SubnetParameters, observed)
+	if reflect.DeepEqual(current, &cr.Spec.SubnetParameters) {
+		return nil
+	}
+	return e.kube.Update(ctx, cr)
+}
+
+// identify returns the ID of the Subnet that belongs to the supplied object,
+// see ec2.Identify.
+func (e *external) identify(ctx context.Context, cr *v1alpha2.Subnet) (string, error) {
+	return ec2.Identify(ctx, cr, cr.Status.SubnetID, ec2.SubnetIDPrefix, e.describeIDs)
+}
+
+// describeIDs returns the IDs of the Subnets that match the supplied filters.
+func (e *external) describeIDs(ctx context.Context, filters []awsec2.Filter) ([]string, error) {
+	req := e.client.DescribeSubnetsRequest(&awsec2.DescribeSubnetsInput{Filters: filters})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(response.Subnets))
+	for i, r := range response.Subnets {
+		ids[i] = aws.StringValue(r.SubnetId)
+	}
+	return ids, nil
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
 	cr, ok := mgd.(*v1alpha2.Subnet)
 	if !ok {
 		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	// an external name that refers to an existing Subnet is adopted by Observe,
+	// so getting here means the Subnet doesn't exist (anymore)
+	if id := ec2.ExternalNameID(cr, ec2.SubnetIDPrefix); id != "" {
+		return resource.ExternalCreation{}, errors.Errorf(errAdopt, id)
+	}
+
 	cr.Status.SetConditions(runtimev1alpha1.Creating())
 
+	az, err := e.availabilityZone(ctx, cr)
+	if err != nil {
+		return resource.ExternalCreation{}, err
+	}
+
 	req := e.client.CreateSubnetRequest(&awsec2.CreateSubnetInput{
 		VpcId:            aws.String(cr.Spec.VPCID),
-		AvailabilityZone: aws.String(cr.Spec.AvailabilityZone),
+		AvailabilityZone: awsclients.String(az),
 		CidrBlock:        aws.String(cr.Spec.CIDRBlock),
+		Ipv6CidrBlock:    awsclients.String(aws.StringValue(cr.Spec.IPv6CIDRBlock)),
 	})
 	req.SetContext(ctx)
 
@@ -167,16 +233,142 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.E
 
 	cr.UpdateExternalStatus(*result.Subnet)
 
+	if tags := ec2.GenerateTags(cr, cr.Spec.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.SubnetID},
+			Tags:      v1alpha2.BuildEC2Tags(tags),
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalCreation{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	if err := e.modifyAttributes(ctx, ec2.GenerateModifySubnetAttributeInputs(cr.Spec.SubnetParameters, *result.Subnet)); err != nil {
+		return resource.ExternalCreation{}, err
+	}
+
 	return resource.ExternalCreation{ConnectionDetails: resource.ConnectionDetails{}}, nil
 }
 
+// availabilityZone returns the name of the Availability Zone the Subnet should
+// be created in. EC2 only accepts names, so it is looked up if only the ID of
+// the Availability Zone is known.
+func (e *external) availabilityZone(ctx context.Context, cr *v1alpha2.Subnet) (string, error) {
+	if cr.Spec.AvailabilityZone != "" || cr.Spec.AvailabilityZoneID == "" {
+		return cr.Spec.AvailabilityZone, nil
+	}
+
+	req := e.client.DescribeAvailabilityZonesRequest(&awsec2.DescribeAvailabilityZonesInput{
+		Filters: []awsec2.Filter{{
+			Name:   aws.String("zone-id"),
+			Values: []string{cr.Spec.AvailabilityZoneID},
+		}},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return "", errors.Wrapf(err, errDescribeAZ, cr.Spec.AvailabilityZoneID)
+	}
+
+	if len(response.AvailabilityZones) != 1 {
+		return "", errors.Errorf(errUnknownAZ, cr.Spec.AvailabilityZoneID)
+	}
+
+	return aws.StringValue(response.AvailabilityZones[0].ZoneName), nil
+}
+
 func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
-	// TODO(soorena776): add more sophisticated Update logic, once we
-	// categorize immutable vs mutable fields (see #727)
+	cr, ok := mgd.(*v1alpha2.Subnet)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	req := e.client.DescribeSubnetsRequest(&awsec2.DescribeSubnetsInput{
+		SubnetIds: []string{cr.Status.SubnetID},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return resource.ExternalUpdate{}, errors.Wrapf(err, errDescribe, cr.Status.SubnetID)
+	}
+
+	if len(response.Subnets) != 1 {
+		return resource.ExternalUpdate{}, errors.Errorf(errMultipleItems, cr.Status.SubnetID)
+	}
+
+	observed := response.Subnets[0]
+
+	// the IPv6 CIDR block is updated first, since IPv6 addresses can't be
+	// assigned on creation in a Subnet without one.
+	if ec2.SubnetIPv6CIDRBlockNeedsUpdate(cr.Spec.SubnetParameters, observed) {
+		if err := e.updateIPv6CIDRBlock(ctx, cr, observed); err != nil {
+			return resource.ExternalUpdate{}, err
+		}
+	}
+
+	if err := e.modifyAttributes(ctx, ec2.GenerateModifySubnetAttributeInputs(cr.Spec.SubnetParameters, observed)); err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+
+	if tags := ec2.TagsToCreate(ec2.GenerateTags(cr, cr.Spec.Tags), observed.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.SubnetID},
+			Tags:      tags,
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
 
 	return resource.ExternalUpdate{}, nil
 }
 
+func (e *external) modifyAttributes(ctx context.Context, inputs []*awsec2.ModifySubnetAttributeInput) error {
+	for _, input := range inputs {
+		req := e.client.ModifySubnetAttributeRequest(input)
+		req.SetContext(ctx)
+
+		if _, err := req.Send(); err != nil {
+			return errors.Wrap(err, errModifyAttributes)
+		}
+	}
+	return nil
+}
+
+// updateIPv6CIDRBlock replaces the IPv6 CIDR block that is associated with the
+// supplied Subnet, if any, by the desired one, if any.
+func (e *external) updateIPv6CIDRBlock(ctx context.Context, cr *v1alpha2.Subnet, observed awsec2.Subnet) error {
+	if a, ok := ec2.SubnetIPv6CIDRBlockAssociation(observed); ok {
+		req := e.client.DisassociateSubnetCidrBlockRequest(&awsec2.DisassociateSubnetCidrBlockInput{
+			AssociationId: a.AssociationId,
+		})
+		req.SetContext(ctx)
+
+		if _, err := req.Send(); err != nil {
+			return errors.Wrap(err, errDisassociateCIDR)
+		}
+	}
+
+	if aws.StringValue(cr.Spec.IPv6CIDRBlock) == "" {
+		return nil
+	}
+
+	req := e.client.AssociateSubnetCidrBlockRequest(&awsec2.AssociateSubnetCidrBlockInput{
+		SubnetId:      aws.String(cr.Status.SubnetID),
+		Ipv6CidrBlock: cr.Spec.IPv6CIDRBlock,
+	})
+	req.SetContext(ctx)
+
+	_, err := req.Send()
+	return errors.Wrap(err, errAssociateCIDR)
+}
+
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	cr, ok := mgd.(*v1alpha2.Subnet)
 	if !ok {
@@ -28,10 +28,14 @@ import (
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
 	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+	"github.com/crossplaneio/crossplane-runtime/pkg/test"
 
 	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
 	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
@@ -49,7 +53,7 @@ var (
 func TestMain(m *testing.M) {
 
 	mockClient = fake.MockSubnetClient{}
-	mockExternalClient = external{&mockClient}
+	mockExternalClient = external{client: &mockClient, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}}
 
 	os.Exit(m.Run())
 }
@@ -66,7 +70,7 @@ func Test_Connect(t *testing.T) {
 		newClientFn: func(conf *aws.Config) (ec2.SubnetClient, error) {
 			return &mockClient, clientErr
 		},
-		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
 			return &aws.Config{}, configErr
 		},
 	}
@@ -224,6 +228,95 @@ func Test_Observe(t *testing.T) {
 	}
 }
 
+func Test_ObserveLateInitialize(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockExternal := awsec2.Subnet{
+		SubnetId:                    aws.String("subnet-0123abcd"),
+		State:                       awsec2.SubnetStateAvailable,
+		MapPublicIpOnLaunch:         aws.Bool(true),
+		AssignIpv6AddressOnCreation: aws.Bool(false),
+		Ipv6CidrBlockAssociationSet: []awsec2.SubnetIpv6CidrBlockAssociation{{
+			AssociationId:      aws.String("associated"),
+			Ipv6CidrBlock:      aws.String("2600:1f14::/64"),
+			Ipv6CidrBlockState: &awsec2.SubnetCidrBlockState{State: awsec2.SubnetCidrBlockStateCodeAssociated},
+		}},
+	}
+	mockClient.MockDescribeSubnetsRequest = func(input *awsec2.DescribeSubnetsInput) awsec2.DescribeSubnetsRequest {
+		return awsec2.DescribeSubnetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeSubnetsOutput{Subnets: []awsec2.Subnet{mockExternal}},
+			},
+		}
+	}
+	adopted := func(p v1alpha2.SubnetParameters) *v1alpha2.Subnet {
+		return &v1alpha2.Subnet{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.ExternalNameAnnotationKey: "subnet-0123abcd"}},
+			Spec:       v1alpha2.SubnetSpec{SubnetParameters: p},
+		}
+	}
+	observed := v1alpha2.SubnetParameters{
+		IPv6CIDRBlock:               aws.String("2600:1f14::/64"),
+		MapPublicIPOnLaunch:         aws.Bool(true),
+		AssignIPv6AddressOnCreation: aws.Bool(false),
+	}
+
+	for _, tc := range []struct {
+		description        string
+		managedObj         *v1alpha2.Subnet
+		updateErr          error
+		expectedErrNil     bool
+		expectedUpdates    int
+		expectedParameters v1alpha2.SubnetParameters
+		expectedUpToDate   bool
+	}{
+		{
+			"unset parameters of an adopted subnet should be late initialized",
+			adopted(v1alpha2.SubnetParameters{}),
+			nil,
+			true,
+			1,
+			observed,
+			true,
+		},
+		{
+			"set parameters of an adopted subnet should be kept",
+			adopted(v1alpha2.SubnetParameters{IPv6CIDRBlock: aws.String(""), MapPublicIPOnLaunch: aws.Bool(false), AssignIPv6AddressOnCreation: aws.Bool(false)}),
+			nil,
+			true,
+			0,
+			v1alpha2.SubnetParameters{IPv6CIDRBlock: aws.String(""), MapPublicIPOnLaunch: aws.Bool(false), AssignIPv6AddressOnCreation: aws.Bool(false)},
+			false,
+		},
+		{
+			"if updating the late initialized object fails, it should return error",
+			adopted(v1alpha2.SubnetParameters{}),
+			errors.New("some error"),
+			false,
+			1,
+			observed,
+			false,
+		},
+	} {
+		var numUpdateCalled int
+		e := external{
+			client: &mockClient,
+			kube: &test.MockClient{MockUpdate: func(_ context.Context, _ runtime.Object, _ ...client.UpdateOption) error {
+				numUpdateCalled++
+				return tc.updateErr
+			}},
+		}
+
+		result, err := e.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(numUpdateCalled).To(gomega.Equal(tc.expectedUpdates), tc.description)
+		g.Expect(tc.managedObj.Spec.SubnetParameters).To(gomega.Equal(tc.expectedParameters), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+	}
+}
+
 func Test_Create(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
@@ -238,8 +331,10 @@ func Test_Create(t *testing.T) {
 		SubnetId: aws.String("some arbitrary arn"),
 	}
 	var mockClientErr error
+	var createdAZ *string
 	mockClient.MockCreateSubnetRequest = func(input *awsec2.CreateSubnetInput) awsec2.CreateSubnetRequest {
 		g.Expect(aws.StringValue(input.CidrBlock)).To(gomega.Equal(mockManaged.Spec.CIDRBlock), "the passed parameters are not valid")
+		createdAZ = input.AvailabilityZone
 		return awsec2.CreateSubnetRequest{
 			Request: &aws.Request{
 				HTTPRequest: &http.Request{},
@@ -251,36 +346,77 @@ func Test_Create(t *testing.T) {
 		}
 	}
 
+	var zonesList []awsec2.AvailabilityZone
+	mockClient.MockDescribeAvailabilityZonesRequest = func(input *awsec2.DescribeAvailabilityZonesInput) awsec2.DescribeAvailabilityZonesRequest {
+		g.Expect(input.Filters[0].Values).To(gomega.Equal([]string{"use1-az1"}), "the passed parameters are not valid")
+		return awsec2.DescribeAvailabilityZonesRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeAvailabilityZonesOutput{AvailabilityZones: zonesList},
+			},
+		}
+	}
+
+	withZoneID := mockManaged.DeepCopy()
+	withZoneID.Spec.AvailabilityZoneID = "use1-az1"
+
 	for _, tc := range []struct {
 		description    string
 		managedObj     resource.Managed
+		zonesReturned  []awsec2.AvailabilityZone
 		clientErr      error
 		expectedErrNil bool
+		expectedAZ     *string
 	}{
 		{
 			"valid input should return expected",
 			mockManaged.DeepCopy(),
 			nil,
+			nil,
+			true,
+			nil,
+		},
+		{
+			"an availability zone ID should be resolved to its name",
+			withZoneID.DeepCopy(),
+			[]awsec2.AvailabilityZone{{ZoneName: aws.String("us-east-1c")}},
+			nil,
 			true,
+			aws.String("us-east-1c"),
+		},
+		{
+			"if the availability zone ID is unknown, it should return error",
+			withZoneID.DeepCopy(),
+			nil,
+			nil,
+			false,
+			nil,
 		},
 		{
 			"unexpected managed resource should return error",
 			unexpecedItem,
 			nil,
+			nil,
 			false,
+			nil,
 		},
 		{
 			"if creating resource fails, it should return error",
 			mockManaged.DeepCopy(),
+			nil,
 			errors.New("some error"),
 			false,
+			nil,
 		},
 	} {
 		mockClientErr = tc.clientErr
+		zonesList = tc.zonesReturned
+		createdAZ = nil
 
 		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
 
 		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(createdAZ).To(gomega.Equal(tc.expectedAZ), tc.description)
 		if tc.expectedErrNil {
 			mgd := tc.managedObj.(*v1alpha2.Subnet)
 			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
@@ -294,11 +430,150 @@ func Test_Create(t *testing.T) {
 func Test_Update(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
-	mockManaged := v1alpha2.Subnet{}
+	mockManaged := v1alpha2.Subnet{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-name"},
+		Spec: v1alpha2.SubnetSpec{
+			SubnetParameters: v1alpha2.SubnetParameters{
+				IPv6CIDRBlock:               aws.String("2600:1f14::/64"),
+				MapPublicIPOnLaunch:         aws.Bool(true),
+				AssignIPv6AddressOnCreation: aws.Bool(false),
+				Tags:                        []v1alpha2.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha2.SubnetStatus{
+			SubnetExternalStatus: v1alpha2.SubnetExternalStatus{
+				SubnetID: "some arbitrary id",
+			},
+		},
+	}
+	mockExternal := awsec2.Subnet{
+		SubnetId:                    aws.String("some arbitrary id"),
+		MapPublicIpOnLaunch:         aws.Bool(false),
+		AssignIpv6AddressOnCreation: aws.Bool(true),
+		Ipv6CidrBlockAssociationSet: []awsec2.SubnetIpv6CidrBlockAssociation{
+			{
+				AssociationId:      aws.String("stale"),
+				Ipv6CidrBlock:      aws.String("2600:1f14:0:1::/64"),
+				Ipv6CidrBlockState: &awsec2.SubnetCidrBlockState{State: awsec2.SubnetCidrBlockStateCodeAssociated},
+			},
+		},
+		Tags: []awsec2.Tag{{Key: aws.String(ec2.TagKeyName), Value: aws.String("some-name")}},
+	}
+
+	var mockDescribeErr error
+	mockClient.MockDescribeSubnetsRequest = func(input *awsec2.DescribeSubnetsInput) awsec2.DescribeSubnetsRequest {
+		g.Expect(input.SubnetIds).To(gomega.Equal([]string{mockManaged.Status.SubnetID}), "the passed parameters are not valid")
+		return awsec2.DescribeSubnetsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeSubnetsOutput{Subnets: []awsec2.Subnet{mockExternal}},
+				Error:       mockDescribeErr,
+			},
+		}
+	}
+
+	var mockModifyErr error
+	var modified []awsec2.ModifySubnetAttributeInput
+	mockClient.MockModifySubnetAttributeRequest = func(input *awsec2.ModifySubnetAttributeInput) awsec2.ModifySubnetAttributeRequest {
+		modified = append(modified, *input)
+		return awsec2.ModifySubnetAttributeRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ModifySubnetAttributeOutput{}, Error: mockModifyErr},
+		}
+	}
+
+	var associated []string
+	mockClient.MockAssociateSubnetCidrBlockRequest = func(input *awsec2.AssociateSubnetCidrBlockInput) awsec2.AssociateSubnetCidrBlockRequest {
+		associated = append(associated, aws.StringValue(input.Ipv6CidrBlock))
+		return awsec2.AssociateSubnetCidrBlockRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.AssociateSubnetCidrBlockOutput{}},
+		}
+	}
+
+	var disassociated []string
+	mockClient.MockDisassociateSubnetCidrBlockRequest = func(input *awsec2.DisassociateSubnetCidrBlockInput) awsec2.DisassociateSubnetCidrBlockRequest {
+		disassociated = append(disassociated, aws.StringValue(input.AssociationId))
+		return awsec2.DisassociateSubnetCidrBlockRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DisassociateSubnetCidrBlockOutput{}},
+		}
+	}
+
+	var createdTags []awsec2.Tag
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		g.Expect(input.Resources).To(gomega.Equal([]string{mockManaged.Status.SubnetID}), "the passed parameters are not valid")
+		createdTags = append(createdTags, input.Tags...)
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateTagsOutput{}},
+		}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		describeErr           error
+		modifyErr             error
+		expectedErrNil        bool
+		expectedModifyCalls   int
+		expectedAssociated    []string
+		expectedDisassociated []string
+		expectedCreatedTags   []awsec2.Tag
+	}{
+		{
+			"valid input should reconcile every difference",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+			2,
+			[]string{"2600:1f14::/64"},
+			[]string{"stale"},
+			[]awsec2.Tag{{Key: aws.String("k"), Value: aws.String("v")}},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			0,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"if describing the resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			nil,
+			false,
+			0,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"if modifying an attribute fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			1,
+			[]string{"2600:1f14::/64"},
+			[]string{"stale"},
+			nil,
+		},
+	} {
+		modified, associated, disassociated, createdTags = nil, nil, nil, nil
+		mockDescribeErr = tc.describeErr
+		mockModifyErr = tc.modifyErr
 
-	_, err := mockExternalClient.Update(context.Background(), &mockManaged)
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
 
-	g.Expect(err).To(gomega.BeNil())
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(len(modified)).To(gomega.Equal(tc.expectedModifyCalls), tc.description)
+		g.Expect(associated).To(gomega.Equal(tc.expectedAssociated), tc.description)
+		g.Expect(disassociated).To(gomega.Equal(tc.expectedDisassociated), tc.description)
+		g.Expect(createdTags).To(gomega.Equal(tc.expectedCreatedTags), tc.description)
+	}
 }
 
 func Test_Delete(t *testing.T) {
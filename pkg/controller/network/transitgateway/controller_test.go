@@ -0,0 +1,479 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgateway
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockTransitGatewayClient
+
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockTransitGatewayClient{}
+	mockExternalClient = external{&mockClient}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha2.TransitGateway{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.TransitGatewayClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.TransitGateway{
+		Status: v1alpha2.TransitGatewayStatus{
+			TransitGatewayExternalStatus: v1alpha2.TransitGatewayExternalStatus{
+				TransitGatewayID: "some arbitrary id",
+			},
+		},
+	}
+
+	var mockClientErr error
+	var itemsList []ec2.TransitGateway
+	mockClient.MockDescribeTransitGatewaysRequest = func(input *ec2.DescribeTransitGatewaysInput) ec2.DescribeTransitGatewaysRequest {
+		if input.Filters == nil {
+			g.Expect(input.TransitGatewayIDs).To(gomega.Equal([]string{mockManaged.Status.TransitGatewayID}), "the passed parameters are not valid")
+		}
+		return ec2.DescribeTransitGatewaysRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &ec2.DescribeTransitGatewaysOutput{
+					TransitGateways: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	withState := func(s string) []ec2.TransitGateway {
+		return []ec2.TransitGateway{{TransitGatewayID: aws.String("some arbitrary id"), State: aws.String(s)}}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []ec2.TransitGateway
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedReason        corev1alpha1.ConditionReason
+	}{
+		{
+			"available transit gateway should be available",
+			mockManaged.DeepCopy(),
+			withState(ec2.TransitGatewayStateAvailable),
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"modifying transit gateway should be available",
+			mockManaged.DeepCopy(),
+			withState(ec2.TransitGatewayStateModifying),
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"pending transit gateway should be creating",
+			mockManaged.DeepCopy(),
+			withState(ec2.TransitGatewayStatePending),
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"deleting transit gateway should be deleting",
+			mockManaged.DeepCopy(),
+			withState(ec2.TransitGatewayStateDeleting),
+			nil,
+			true,
+			true,
+			corev1alpha1.ReasonDeleting,
+		},
+		{
+			"deleted transit gateway should not exist",
+			mockManaged.DeepCopy(),
+			withState(ec2.TransitGatewayStateDeleted),
+			nil,
+			true,
+			false,
+			"",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			false,
+			"",
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha2.TransitGateway{},
+			nil,
+			nil,
+			true,
+			false,
+			"",
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.TransitGatewayIDNotFound, "", nil),
+			true,
+			false,
+			"",
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]ec2.TransitGateway{},
+			nil,
+			false,
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha2.TransitGateway)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(tc.expectedReason), tc.description)
+			g.Expect(mgd.Status.TransitGatewayID).To(gomega.Equal("some arbitrary id"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.TransitGateway{
+		Spec: v1alpha2.TransitGatewaySpec{
+			TransitGatewayParameters: v1alpha2.TransitGatewayParameters{
+				Description:   "arbitrary description",
+				AmazonSideASN: aws.Int64(64513),
+			},
+		},
+	}
+	mockExternal := &ec2.TransitGateway{
+		TransitGatewayID: aws.String("some arbitrary id"),
+		State:            aws.String(ec2.TransitGatewayStatePending),
+	}
+	var mockClientErr error
+	mockClient.MockCreateTransitGatewayRequest = func(input *ec2.CreateTransitGatewayInput) ec2.CreateTransitGatewayRequest {
+		g.Expect(aws.StringValue(input.Description)).To(gomega.Equal(mockManaged.Spec.Description), "the passed parameters are not valid")
+		g.Expect(input.Options.AmazonSideASN).To(gomega.Equal(mockManaged.Spec.AmazonSideASN), "the passed parameters are not valid")
+		return ec2.CreateTransitGatewayRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &ec2.CreateTransitGatewayOutput{
+					TransitGateway: mockExternal,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.TransitGateway)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.TransitGatewayID).To(gomega.Equal(aws.StringValue(mockExternal.TransitGatewayID)), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.TransitGateway{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-name"},
+		Spec: v1alpha2.TransitGatewaySpec{
+			TransitGatewayParameters: v1alpha2.TransitGatewayParameters{
+				Tags: []v1alpha2.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha2.TransitGatewayStatus{
+			TransitGatewayExternalStatus: v1alpha2.TransitGatewayExternalStatus{
+				TransitGatewayID: "some arbitrary id",
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		g.Expect(input.Resources).To(gomega.Equal([]string{mockManaged.Status.TransitGatewayID}), "the passed parameters are not valid")
+		g.Expect(input.Tags).To(gomega.Equal([]awsec2.Tag{
+			{Key: aws.String("k"), Value: aws.String("v")},
+			{Key: aws.String(ec2.TagKeyName), Value: aws.String("some-name")},
+		}), "the passed parameters are not valid")
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTagsOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating the tags fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.TransitGateway{
+		Status: v1alpha2.TransitGatewayStatus{
+			TransitGatewayExternalStatus: v1alpha2.TransitGatewayExternalStatus{
+				TransitGatewayID: "some arbitrary id",
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockDeleteTransitGatewayRequest = func(input *ec2.DeleteTransitGatewayInput) ec2.DeleteTransitGatewayRequest {
+		g.Expect(aws.StringValue(input.TransitGatewayID)).To(gomega.Equal(mockManaged.Status.TransitGatewayID), "the passed parameters are not valid")
+		return ec2.DeleteTransitGatewayRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &ec2.DeleteTransitGatewayOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"if status doesn't have the resource ID, it should return an error",
+			&v1alpha2.TransitGateway{},
+			nil,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.TransitGatewayIDNotFound, "", nil),
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.TransitGateway)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
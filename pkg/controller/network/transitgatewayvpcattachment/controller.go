@@ -0,0 +1,300 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayvpcattachment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a TransitGatewayVPCAttachment resource"
+	errClient           = "cannot create a new TransitGatewayVPCAttachmentClient"
+	errDescribe         = "failed to describe TransitGatewayVPCAttachment with id: %v"
+	errMultipleItems    = "retrieved multiple TransitGatewayVPCAttachments for the given transitGatewayAttachmentId: %v"
+	errCreate           = "failed to create the TransitGatewayVPCAttachment resource"
+	errCreateTags       = "failed to create tags for the TransitGatewayVPCAttachment resource"
+	errModify           = "failed to modify the TransitGatewayVPCAttachment resource"
+	errDeleteNotPresent = "cannot delete the TransitGatewayVPCAttachment, since the transitGatewayAttachmentID is not present"
+	errDelete           = "failed to delete the TransitGatewayVPCAttachment resource"
+
+	msgPendingAcceptance = "waiting for the owner of the transit gateway to accept the attachment"
+)
+
+// Controller is the controller for TransitGatewayVPCAttachment objects
+type Controller struct{}
+
+// SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(v1alpha2.TransitGatewayVPCAttachmentGroupVersionKind),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewTransitGatewayVPCAttachmentClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
+		resource.WithManagedConnectionPublishers())
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.TransitGatewayVPCAttachmentKindAPIVersion, v1alpha2.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.TransitGatewayVPCAttachment{}).
+		Complete(r)
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.TransitGatewayVPCAttachmentClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha2.TransitGatewayVPCAttachment)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, cr.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{c}, nil
+}
+
+type external struct {
+	client ec2.TransitGatewayVPCAttachmentClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha2.TransitGatewayVPCAttachment)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// To find out whether a TransitGatewayVPCAttachment exist:
+	// - the ID of the TransitGatewayVPCAttachment should be known, see identify
+	// - an attachment with the given transitGatewayAttachmentID should exist,
+	//   and not be deleted
+	id, err := e.identify(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if id == "" {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, id)
+	if ec2.IsTransitGatewayAttachmentNotFoundErr(err) {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+
+	state := aws.StringValue(observed.State)
+	if state == ec2.TransitGatewayAttachmentStateDeleted {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	switch state {
+	case ec2.TransitGatewayAttachmentStateAvailable,
+		ec2.TransitGatewayAttachmentStateModifying:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case ec2.TransitGatewayAttachmentStateInitiating,
+		ec2.TransitGatewayAttachmentStatePending:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case ec2.TransitGatewayAttachmentStatePendingAcceptance:
+		cr.SetConditions(runtimev1alpha1.Creating().WithMessage(msgPendingAcceptance))
+	case ec2.TransitGatewayAttachmentStateDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		// rolled back, rejected and failed attachments can't be used until
+		// an operator acts on them.
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	cr.Status.TransitGatewayVPCAttachmentExternalStatus = ec2.GenerateTransitGatewayVPCAttachmentObservation(*observed)
+
+	// an attachment can only be modified once it is available
+	upToDate := state != ec2.TransitGatewayAttachmentStateAvailable ||
+		ec2.IsTransitGatewayVPCAttachmentUpToDate(cr.Spec.TransitGatewayVPCAttachmentParameters, *observed)
+	upToDate = upToDate && ec2.AreTagsUpToDate(cr, cr.Spec.Tags, observed.Tags)
+
+	return resource.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: resource.ConnectionDetails{},
+	}, nil
+}
+
+// identify returns the ID of the TransitGatewayVPCAttachment that belongs to
+// the supplied object, see ec2.Identify.
+func (e *external) identify(ctx context.Context, cr *v1alpha2.TransitGatewayVPCAttachment) (string, error) {
+	// deleted, rejected and failed attachments remain visible for a while
+	return ec2.Identify(ctx, cr, cr.Status.TransitGatewayAttachmentID, "", e.describeIDs, awsec2.Filter{
+		Name:   aws.String("state"),
+		Values: []string{ec2.TransitGatewayAttachmentStateInitiating, ec2.TransitGatewayAttachmentStatePendingAcceptance, ec2.TransitGatewayAttachmentStatePending, ec2.TransitGatewayAttachmentStateAvailable, ec2.TransitGatewayAttachmentStateModifying},
+	})
+}
+
+// describeIDs returns the IDs of the TransitGatewayVPCAttachments that match
+// the supplied filters.
+func (e *external) describeIDs(ctx context.Context, filters []awsec2.Filter) ([]string, error) {
+	req := e.client.DescribeTransitGatewayVPCAttachmentsRequest(&ec2.DescribeTransitGatewayVPCAttachmentsInput{Filters: filters})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(response.TransitGatewayVPCAttachments))
+	for i, r := range response.TransitGatewayVPCAttachments {
+		ids[i] = aws.StringValue(r.TransitGatewayAttachmentID)
+	}
+	return ids, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha2.TransitGatewayVPCAttachment)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+
+	req := e.client.CreateTransitGatewayVPCAttachmentRequest(ec2.GenerateCreateTransitGatewayVPCAttachmentInput(cr.Spec.TransitGatewayVPCAttachmentParameters, ec2.GenerateTags(cr, cr.Spec.Tags)))
+	req.SetContext(ctx)
+
+	rsp, err := req.Send()
+	if err != nil {
+		return resource.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	cr.Status.TransitGatewayVPCAttachmentExternalStatus = ec2.GenerateTransitGatewayVPCAttachmentObservation(*rsp.TransitGatewayVPCAttachment)
+
+	return resource.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha2.TransitGatewayVPCAttachment)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr.Status.TransitGatewayAttachmentID)
+	if err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+
+	if tags := ec2.TagsToCreate(ec2.GenerateTags(cr, cr.Spec.Tags), observed.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.TransitGatewayAttachmentID},
+			Tags:      tags,
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	// an attachment can only be modified once it is available
+	if aws.StringValue(observed.State) != ec2.TransitGatewayAttachmentStateAvailable {
+		return resource.ExternalUpdate{}, nil
+	}
+
+	input := ec2.GenerateModifyTransitGatewayVPCAttachmentInput(cr.Status.TransitGatewayAttachmentID, cr.Spec.TransitGatewayVPCAttachmentParameters, *observed)
+	if input == nil {
+		return resource.ExternalUpdate{}, nil
+	}
+
+	req := e.client.ModifyTransitGatewayVPCAttachmentRequest(input)
+	req.SetContext(ctx)
+
+	_, err = req.Send()
+	return resource.ExternalUpdate{}, errors.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha2.TransitGatewayVPCAttachment)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	if cr.Status.TransitGatewayAttachmentID == "" {
+		return errors.New(errDeleteNotPresent)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	req := e.client.DeleteTransitGatewayVPCAttachmentRequest(&ec2.DeleteTransitGatewayVPCAttachmentInput{
+		TransitGatewayAttachmentID: aws.String(cr.Status.TransitGatewayAttachmentID),
+	})
+	req.SetContext(ctx)
+
+	_, err := req.Send()
+	if ec2.IsTransitGatewayAttachmentNotFoundErr(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDelete)
+}
+
+func (e *external) describe(ctx context.Context, id string) (*ec2.TransitGatewayVPCAttachment, error) {
+	req := e.client.DescribeTransitGatewayVPCAttachmentsRequest(&ec2.DescribeTransitGatewayVPCAttachmentsInput{
+		TransitGatewayAttachmentIDs: []string{id},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if ec2.IsTransitGatewayAttachmentNotFoundErr(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, errDescribe, id)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.TransitGatewayVPCAttachments) != 1 {
+		return nil, errors.Errorf(errMultipleItems, id)
+	}
+
+	return &response.TransitGatewayVPCAttachments[0], nil
+}
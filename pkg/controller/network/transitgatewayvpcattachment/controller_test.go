@@ -0,0 +1,552 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package transitgatewayvpcattachment
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockTransitGatewayVPCAttachmentClient
+
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockTransitGatewayVPCAttachmentClient{}
+	mockExternalClient = external{&mockClient}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha2.TransitGatewayVPCAttachment{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.TransitGatewayVPCAttachmentClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.TransitGatewayVPCAttachment{
+		Spec: v1alpha2.TransitGatewayVPCAttachmentSpec{
+			TransitGatewayVPCAttachmentParameters: v1alpha2.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs: []string{"subnet-1"},
+			},
+		},
+		Status: v1alpha2.TransitGatewayVPCAttachmentStatus{
+			TransitGatewayVPCAttachmentExternalStatus: v1alpha2.TransitGatewayVPCAttachmentExternalStatus{
+				TransitGatewayAttachmentID: "some arbitrary id",
+			},
+		},
+	}
+
+	var mockClientErr error
+	var itemsList []ec2.TransitGatewayVPCAttachment
+	mockClient.MockDescribeTransitGatewayVPCAttachmentsRequest = func(input *ec2.DescribeTransitGatewayVPCAttachmentsInput) ec2.DescribeTransitGatewayVPCAttachmentsRequest {
+		return ec2.DescribeTransitGatewayVPCAttachmentsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &ec2.DescribeTransitGatewayVPCAttachmentsOutput{
+					TransitGatewayVPCAttachments: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	attachment := func(state string, subnets ...string) []ec2.TransitGatewayVPCAttachment {
+		return []ec2.TransitGatewayVPCAttachment{{
+			TransitGatewayAttachmentID: aws.String("some arbitrary id"),
+			State:                      aws.String(state),
+			SubnetIDs:                  subnets,
+		}}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []ec2.TransitGatewayVPCAttachment
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+		expectedReason        corev1alpha1.ConditionReason
+	}{
+		{
+			"available attachment should be available and up to date",
+			mockManaged.DeepCopy(),
+			attachment(ec2.TransitGatewayAttachmentStateAvailable, "subnet-1"),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"pending attachment should be creating",
+			mockManaged.DeepCopy(),
+			attachment(ec2.TransitGatewayAttachmentStatePending, "subnet-1"),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"attachment pending acceptance should be creating",
+			mockManaged.DeepCopy(),
+			attachment(ec2.TransitGatewayAttachmentStatePendingAcceptance, "subnet-1"),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"rejected attachment should be unavailable",
+			mockManaged.DeepCopy(),
+			attachment(ec2.TransitGatewayAttachmentStateRejected, "subnet-1"),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonUnavailable,
+		},
+		{
+			"available attachment with other subnets should not be up to date",
+			mockManaged.DeepCopy(),
+			attachment(ec2.TransitGatewayAttachmentStateAvailable, "subnet-2"),
+			nil,
+			true,
+			true,
+			false,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"modifying attachment with other subnets should be up to date until it is available",
+			mockManaged.DeepCopy(),
+			attachment(ec2.TransitGatewayAttachmentStateModifying, "subnet-2"),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"deleted attachment should not exist",
+			mockManaged.DeepCopy(),
+			attachment(ec2.TransitGatewayAttachmentStateDeleted),
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha2.TransitGatewayVPCAttachment{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.TransitGatewayAttachmentIDNotFound, "", nil),
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]ec2.TransitGatewayVPCAttachment{},
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha2.TransitGatewayVPCAttachment)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(tc.expectedReason), tc.description)
+			g.Expect(mgd.Status.TransitGatewayAttachmentID).To(gomega.Equal("some arbitrary id"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.TransitGatewayVPCAttachment{
+		Spec: v1alpha2.TransitGatewayVPCAttachmentSpec{
+			TransitGatewayVPCAttachmentParameters: v1alpha2.TransitGatewayVPCAttachmentParameters{
+				TransitGatewayID: "arbitrary transitGatewayId",
+				VPCID:            "arbitrary vpcId",
+				SubnetIDs:        []string{"subnet-1"},
+			},
+		},
+	}
+	mockExternal := &ec2.TransitGatewayVPCAttachment{
+		TransitGatewayAttachmentID: aws.String("some arbitrary id"),
+		State:                      aws.String(ec2.TransitGatewayAttachmentStatePending),
+	}
+	var mockClientErr error
+	mockClient.MockCreateTransitGatewayVPCAttachmentRequest = func(input *ec2.CreateTransitGatewayVPCAttachmentInput) ec2.CreateTransitGatewayVPCAttachmentRequest {
+		g.Expect(aws.StringValue(input.TransitGatewayID)).To(gomega.Equal(mockManaged.Spec.TransitGatewayID), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.VPCID)).To(gomega.Equal(mockManaged.Spec.VPCID), "the passed parameters are not valid")
+		g.Expect(input.SubnetIDs).To(gomega.Equal(mockManaged.Spec.SubnetIDs), "the passed parameters are not valid")
+		return ec2.CreateTransitGatewayVPCAttachmentRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &ec2.CreateTransitGatewayVPCAttachmentOutput{
+					TransitGatewayVPCAttachment: mockExternal,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.TransitGatewayVPCAttachment)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.TransitGatewayAttachmentID).To(gomega.Equal(aws.StringValue(mockExternal.TransitGatewayAttachmentID)), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.TransitGatewayVPCAttachment{
+		Spec: v1alpha2.TransitGatewayVPCAttachmentSpec{
+			TransitGatewayVPCAttachmentParameters: v1alpha2.TransitGatewayVPCAttachmentParameters{
+				SubnetIDs: []string{"subnet-1", "subnet-2"},
+			},
+		},
+		Status: v1alpha2.TransitGatewayVPCAttachmentStatus{
+			TransitGatewayVPCAttachmentExternalStatus: v1alpha2.TransitGatewayVPCAttachmentExternalStatus{
+				TransitGatewayAttachmentID: "some arbitrary id",
+			},
+		},
+	}
+
+	var observedSubnets []string
+	mockClient.MockDescribeTransitGatewayVPCAttachmentsRequest = func(input *ec2.DescribeTransitGatewayVPCAttachmentsInput) ec2.DescribeTransitGatewayVPCAttachmentsRequest {
+		return ec2.DescribeTransitGatewayVPCAttachmentsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &ec2.DescribeTransitGatewayVPCAttachmentsOutput{
+					TransitGatewayVPCAttachments: []ec2.TransitGatewayVPCAttachment{{State: aws.String(ec2.TransitGatewayAttachmentStateAvailable), SubnetIDs: observedSubnets}},
+				},
+			},
+		}
+	}
+
+	var mockModifyErr error
+	var modifyInput *ec2.ModifyTransitGatewayVPCAttachmentInput
+	mockClient.MockModifyTransitGatewayVPCAttachmentRequest = func(input *ec2.ModifyTransitGatewayVPCAttachmentInput) ec2.ModifyTransitGatewayVPCAttachmentRequest {
+		modifyInput = input
+		return ec2.ModifyTransitGatewayVPCAttachmentRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &ec2.ModifyTransitGatewayVPCAttachmentOutput{},
+				Error:       mockModifyErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description     string
+		managedObj      resource.Managed
+		observedSubnets []string
+		modifyErr       error
+		expectedErrNil  bool
+		expectedModify  *ec2.ModifyTransitGatewayVPCAttachmentInput
+	}{
+		{
+			"up to date attachment should not be modified",
+			mockManaged.DeepCopy(),
+			[]string{"subnet-2", "subnet-1"},
+			nil,
+			true,
+			nil,
+		},
+		{
+			"subnet changes should be modified",
+			mockManaged.DeepCopy(),
+			[]string{"subnet-1", "subnet-3"},
+			nil,
+			true,
+			&ec2.ModifyTransitGatewayVPCAttachmentInput{
+				TransitGatewayAttachmentID: aws.String("some arbitrary id"),
+				AddSubnetIDs:               []string{"subnet-2"},
+				RemoveSubnetIDs:            []string{"subnet-3"},
+			},
+		},
+		{
+			"if modifying the attachment fails, it should return error",
+			mockManaged.DeepCopy(),
+			[]string{"subnet-1"},
+			errors.New("some error"),
+			false,
+			&ec2.ModifyTransitGatewayVPCAttachmentInput{
+				TransitGatewayAttachmentID: aws.String("some arbitrary id"),
+				AddSubnetIDs:               []string{"subnet-2"},
+			},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			nil,
+		},
+	} {
+		observedSubnets = tc.observedSubnets
+		mockModifyErr = tc.modifyErr
+		modifyInput = nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(modifyInput).To(gomega.Equal(tc.expectedModify), tc.description)
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.TransitGatewayVPCAttachment{
+		Status: v1alpha2.TransitGatewayVPCAttachmentStatus{
+			TransitGatewayVPCAttachmentExternalStatus: v1alpha2.TransitGatewayVPCAttachmentExternalStatus{
+				TransitGatewayAttachmentID: "some arbitrary id",
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockDeleteTransitGatewayVPCAttachmentRequest = func(input *ec2.DeleteTransitGatewayVPCAttachmentInput) ec2.DeleteTransitGatewayVPCAttachmentRequest {
+		g.Expect(aws.StringValue(input.TransitGatewayAttachmentID)).To(gomega.Equal(mockManaged.Status.TransitGatewayAttachmentID), "the passed parameters are not valid")
+		return ec2.DeleteTransitGatewayVPCAttachmentRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &ec2.DeleteTransitGatewayVPCAttachmentOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"if status doesn't have the resource ID, it should return an error",
+			&v1alpha2.TransitGatewayVPCAttachment{},
+			nil,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.TransitGatewayAttachmentIDNotFound, "", nil),
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.TransitGatewayVPCAttachment)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
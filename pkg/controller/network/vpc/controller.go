@@ -19,6 +19,7 @@ package vpc
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -42,7 +43,14 @@ const (
 	errDescribe            = "failed to describe VPC with id: %v"
 	errMultipleItems       = "retrieved multiple VPCs for the given vpcId: %v"
 	errCreate              = "failed to create the VPC resource"
+	errAdopt               = "cannot adopt VPC %v named by the external name annotation, since it does not exist"
 	errModifyVPCAttributes = "failed to modify the VPC resource attributes"
+	errDescribeAttributes  = "failed to describe the VPC resource attributes"
+	errUpdateManaged       = "cannot update the VPC custom resource"
+	errAssociateCIDR       = "failed to associate a CIDR block with the VPC resource"
+	errDisassociateCIDR    = "failed to disassociate a CIDR block from the VPC resource"
+	errModifyTenancy       = "failed to modify the VPC resource instance tenancy"
+	errCreateTags          = "failed to create tags for the VPC resource"
 	errDeleteNotPresent    = "cannot delete the VPC, since the VPCID is not present"
 	errDelete              = "failed to delete the VPC resource"
 )
@@ -55,7 +63,7 @@ type Controller struct{}
 func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
 	r := resource.NewManagedReconciler(mgr,
 		resource.ManagedKind(v1alpha2.VPCGroupVersionKind),
-		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewVPCClient, awsConfigFn: utils.RetrieveAwsConfigFromProvider}),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewVPCClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
 		resource.WithManagedConnectionPublishers())
 	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.VPCKindAPIVersion, v1alpha2.Group))
 	return ctrl.NewControllerManagedBy(mgr).
@@ -67,7 +75,7 @@ func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
 type connector struct {
 	client      client.Client
 	newClientFn func(*aws.Config) (ec2.VPCClient, error)
-	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
 }
 
 func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
@@ -76,7 +84,7 @@ func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resou
 		return nil, errors.New(errUnexpectedObject)
 	}
 
-	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference)
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, cr.Spec.Region)
 	if err != nil {
 		return nil, err
 	}
@@ -85,30 +93,35 @@ func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resou
 	if err != nil {
 		return nil, errors.Wrap(err, errClient)
 	}
-	return &external{c}, nil
+	return &external{client: c, kube: conn.client}, nil
 }
 
 type external struct {
 	client ec2.VPCClient
+	kube   client.Client
 }
 
-func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) {
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) { // nolint:gocyclo
 	cr, ok := mgd.(*v1alpha2.VPC)
 	if !ok {
 		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
 	}
 
 	// To find out whether a VPC exist:
-	// - the object's ExternalState should have vpcId populated
+	// - the ID of the VPC should be known, see identify
 	// - a VPC with the given vpcId should exist
-	if cr.Status.VPCID == "" {
+	id, err := e.identify(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if id == "" {
 		return resource.ExternalObservation{
 			ResourceExists: false,
 		}, nil
 	}
 
 	req := e.client.DescribeVpcsRequest(&awsec2.DescribeVpcsInput{
-		VpcIds: []string{cr.Status.VPCID},
+		VpcIds: []string{id},
 	})
 	req.SetContext(ctx)
 
@@ -121,16 +134,25 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.
 	}
 
 	if err != nil {
-		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, cr.Status.VPCID)
+		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, id)
 	}
 
 	// in a successful response, there should be one and only one object
 	if len(response.Vpcs) != 1 {
-		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, cr.Status.VPCID)
+		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, id)
 	}
 
 	observed := response.Vpcs[0]
 
+	attrs, err := e.describeAttributes(ctx, id)
+	if err != nil {
+		return resource.ExternalObservation{}, errors.Wrap(err, errDescribeAttributes)
+	}
+
+	if err := e.lateInitialize(ctx, cr, observed, attrs); err != nil {
+		return resource.ExternalObservation{}, errors.Wrap(err, errUpdateManaged)
+	}
+
 	if observed.State == awsec2.VpcStateAvailable {
 		cr.SetConditions(runtimev1alpha1.Available())
 	}
@@ -139,16 +161,109 @@ func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.
 
 	return resource.ExternalObservation{
 		ResourceExists:    true,
+		ResourceUpToDate:  ec2.IsVPCUpToDate(cr.Spec.VPCParameters, observed, attrs) && ec2.AreTagsUpToDate(cr, cr.Spec.Tags, observed.Tags),
 		ConnectionDetails: resource.ConnectionDetails{},
 	}, nil
 }
 
+// identify returns the ID of the VPC that belongs to the supplied object, see
+// ec2.Identify.
+func (e *external) identify(ctx context.Context, cr *v1alpha2.VPC) (string, error) {
+	return ec2.Identify(ctx, cr, cr.Status.VPCID, ec2.VPCIDPrefix, e.describeIDs)
+}
+
+// describeIDs returns the IDs of the VPCs that match the supplied filters.
+func (e *external) describeIDs(ctx context.Context, filters []awsec2.Filter) ([]string, error) {
+	req := e.client.DescribeVpcsRequest(&awsec2.DescribeVpcsInput{Filters: filters})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(response.Vpcs))
+	for i, r := range response.Vpcs {
+		ids[i] = aws.StringValue(r.VpcId)
+	}
+	return ids, nil
+}
+
+// lateInitialize fills the unset parameters of the supplied VPC with the
+// observed VPC and its attributes, and persists them if any of them changed.
+func (e *external) lateInitialize(ctx context.Context, cr *v1alpha2.VPC, observed awsec2.Vpc, attrs ec2.VPCAttributes) error {
+	current := cr.Spec.VPCParameters.DeepCopy()
+	ec2.LateInitializeVPC(&cr.Spec.VPCParameters, observed, attrs)
+	if reflect.DeepEqual(current, &cr.Spec.VPCParameters) {
+		return nil
+	}
+	return e.kube.Update(ctx, cr)
+}
+
+func (e *external) describeAttributes(ctx context.Context, id string) (ec2.VPCAttributes, error) {
+	attrs := ec2.VPCAttributes{}
+	for _, name := range []awsec2.VpcAttributeName{awsec2.VpcAttributeNameEnableDnsSupport, awsec2.VpcAttributeNameEnableDnsHostnames} {
+		req := e.client.DescribeVpcAttributeRequest(&awsec2.DescribeVpcAttributeInput{
+			VpcId:     aws.String(id),
+			Attribute: name,
+		})
+		req.SetContext(ctx)
+
+		rsp, err := req.Send()
+		if err != nil {
+			return ec2.VPCAttributes{}, err
+		}
+
+		if rsp.EnableDnsSupport != nil {
+			attrs.EnableDNSSupport = aws.BoolValue(rsp.EnableDnsSupport.Value)
+		}
+		if rsp.EnableDnsHostnames != nil {
+			attrs.EnableDNSHostNames = aws.BoolValue(rsp.EnableDnsHostnames.Value)
+		}
+	}
+	return attrs, nil
+}
+
+// modifyAttributes sets the DNS support and DNS hostnames of the supplied VPC
+// to the desired ones, as requested. Each attribute must be modified in a
+// separate request.
+func (e *external) modifyAttributes(ctx context.Context, cr *v1alpha2.VPC, dnsSupport, dnsHostNames bool) error {
+	var inputs []*awsec2.ModifyVpcAttributeInput
+	if dnsSupport {
+		inputs = append(inputs, &awsec2.ModifyVpcAttributeInput{
+			VpcId:            aws.String(cr.Status.VPCID),
+			EnableDnsSupport: &awsec2.AttributeBooleanValue{Value: cr.Spec.EnableDNSSupport},
+		})
+	}
+	if dnsHostNames {
+		inputs = append(inputs, &awsec2.ModifyVpcAttributeInput{
+			VpcId:              aws.String(cr.Status.VPCID),
+			EnableDnsHostnames: &awsec2.AttributeBooleanValue{Value: cr.Spec.EnableDNSHostNames},
+		})
+	}
+	for _, input := range inputs {
+		req := e.client.ModifyVpcAttributeRequest(input)
+		req.SetContext(ctx)
+
+		if _, err := req.Send(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
 	cr, ok := mgd.(*v1alpha2.VPC)
 	if !ok {
 		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
 	}
 
+	// an external name that refers to an existing VPC is adopted by Observe,
+	// so getting here means the VPC doesn't exist (anymore)
+	if id := ec2.ExternalNameID(cr, ec2.VPCIDPrefix); id != "" {
+		return resource.ExternalCreation{}, errors.Errorf(errAdopt, id)
+	}
+
 	cr.Status.SetConditions(runtimev1alpha1.Creating())
 
 	// if VPCID already exists, skip creating the vpc
@@ -156,7 +271,9 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.E
 	if cr.Status.VPCID == "" {
 
 		req := e.client.CreateVpcRequest(&awsec2.CreateVpcInput{
-			CidrBlock: aws.String(cr.Spec.CIDRBlock),
+			CidrBlock:                   aws.String(cr.Spec.CIDRBlock),
+			AmazonProvidedIpv6CidrBlock: cr.Spec.AmazonProvidedIPv6CIDRBlock,
+			InstanceTenancy:             awsec2.Tenancy(cr.Spec.InstanceTenancy),
 		})
 		req.SetContext(ctx)
 
@@ -168,35 +285,131 @@ func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.E
 		cr.UpdateExternalStatus(*result.Vpc)
 	}
 
-	// modify vpc attributes
-	for _, input := range []*awsec2.ModifyVpcAttributeInput{
-		{
-			VpcId:            aws.String(cr.Status.VPCID),
-			EnableDnsSupport: &awsec2.AttributeBooleanValue{Value: aws.Bool(cr.Spec.EnableDNSSupport)},
-		},
-		{
-			VpcId:              aws.String(cr.Status.VPCID),
-			EnableDnsHostnames: &awsec2.AttributeBooleanValue{Value: aws.Bool(cr.Spec.EnableDNSHostNames)},
-		},
-	} {
-		attrReq := e.client.ModifyVpcAttributeRequest(input)
-		attrReq.SetContext(ctx)
-
-		if _, err := attrReq.Send(); err != nil {
-			return resource.ExternalCreation{}, errors.Wrap(err, errModifyVPCAttributes)
+	// modify the vpc attributes that are set; the others keep their defaults
+	// and are late initialized by Observe
+	if err := e.modifyAttributes(ctx, cr, cr.Spec.EnableDNSSupport != nil, cr.Spec.EnableDNSHostNames != nil); err != nil {
+		return resource.ExternalCreation{}, errors.Wrap(err, errModifyVPCAttributes)
+	}
+
+	if tags := ec2.GenerateTags(cr, cr.Spec.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.VPCID},
+			Tags:      v1alpha2.BuildEC2Tags(tags),
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalCreation{}, errors.Wrap(err, errCreateTags)
 		}
 	}
 
 	return resource.ExternalCreation{ConnectionDetails: resource.ConnectionDetails{}}, nil
 }
 
-func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
-	// TODO(soorena776): add more sophisticated Update logic, once we
-	// categorize immutable vs mutable fields (see #727)
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha2.VPC)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	req := e.client.DescribeVpcsRequest(&awsec2.DescribeVpcsInput{
+		VpcIds: []string{cr.Status.VPCID},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return resource.ExternalUpdate{}, errors.Wrapf(err, errDescribe, cr.Status.VPCID)
+	}
+
+	if len(response.Vpcs) != 1 {
+		return resource.ExternalUpdate{}, errors.Errorf(errMultipleItems, cr.Status.VPCID)
+	}
+
+	observed := response.Vpcs[0]
+
+	attrs, err := e.describeAttributes(ctx, cr.Status.VPCID)
+	if err != nil {
+		return resource.ExternalUpdate{}, errors.Wrap(err, errDescribeAttributes)
+	}
+
+	if err := e.modifyAttributes(ctx, cr, ec2.DNSSupportNeedsUpdate(cr.Spec.VPCParameters, attrs), ec2.DNSHostNamesNeedsUpdate(cr.Spec.VPCParameters, attrs)); err != nil {
+		return resource.ExternalUpdate{}, errors.Wrap(err, errModifyVPCAttributes)
+	}
+
+	for _, id := range ec2.CIDRBlockAssociationsToRemove(cr.Spec.VPCParameters, observed) {
+		if err := e.disassociateCIDRBlock(ctx, id); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errDisassociateCIDR)
+		}
+	}
+
+	for _, c := range ec2.CIDRBlocksToAssociate(cr.Spec.VPCParameters, observed) {
+		if err := e.associateCIDRBlock(ctx, &awsec2.AssociateVpcCidrBlockInput{
+			VpcId:     aws.String(cr.Status.VPCID),
+			CidrBlock: aws.String(c),
+		}); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errAssociateCIDR)
+		}
+	}
+
+	if ec2.IPv6CIDRBlockNeedsUpdate(cr.Spec.VPCParameters, observed) {
+		var err error
+		if id, ok := ec2.IPv6CIDRBlockAssociation(observed); ok {
+			err = errors.Wrap(e.disassociateCIDRBlock(ctx, id), errDisassociateCIDR)
+		} else {
+			err = errors.Wrap(e.associateCIDRBlock(ctx, &awsec2.AssociateVpcCidrBlockInput{
+				VpcId:                       aws.String(cr.Status.VPCID),
+				AmazonProvidedIpv6CidrBlock: aws.Bool(true),
+			}), errAssociateCIDR)
+		}
+		if err != nil {
+			return resource.ExternalUpdate{}, err
+		}
+	}
+
+	if ec2.VPCTenancyNeedsUpdate(cr.Spec.VPCParameters, observed) {
+		tenancyReq := e.client.ModifyVpcTenancyRequest(&awsec2.ModifyVpcTenancyInput{
+			VpcId:           aws.String(cr.Status.VPCID),
+			InstanceTenancy: awsec2.VpcTenancyDefault,
+		})
+		tenancyReq.SetContext(ctx)
+
+		if _, err := tenancyReq.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errModifyTenancy)
+		}
+	}
+
+	if tags := ec2.TagsToCreate(ec2.GenerateTags(cr, cr.Spec.Tags), observed.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.VPCID},
+			Tags:      tags,
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
 
 	return resource.ExternalUpdate{}, nil
 }
 
+func (e *external) associateCIDRBlock(ctx context.Context, input *awsec2.AssociateVpcCidrBlockInput) error {
+	req := e.client.AssociateVpcCidrBlockRequest(input)
+	req.SetContext(ctx)
+	_, err := req.Send()
+	return err
+}
+
+func (e *external) disassociateCIDRBlock(ctx context.Context, id string) error {
+	req := e.client.DisassociateVpcCidrBlockRequest(&awsec2.DisassociateVpcCidrBlockInput{
+		AssociationId: aws.String(id),
+	})
+	req.SetContext(ctx)
+	_, err := req.Send()
+	return err
+}
+
 func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
 	cr, ok := mgd.(*v1alpha2.VPC)
 	if !ok {
@@ -28,10 +28,14 @@ import (
 	"github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
 	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+	"github.com/crossplaneio/crossplane-runtime/pkg/test"
 
 	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
 	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
@@ -49,7 +53,7 @@ var (
 func TestMain(m *testing.M) {
 
 	mockClient = fake.MockVPCClient{}
-	mockExternalClient = external{&mockClient}
+	mockExternalClient = external{client: &mockClient, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}}
 
 	os.Exit(m.Run())
 }
@@ -66,7 +70,7 @@ func Test_Connect(t *testing.T) {
 		newClientFn: func(conf *aws.Config) (ec2.VPCClient, error) {
 			return &mockClient, clientErr
 		},
-		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
 			return &aws.Config{}, configErr
 		},
 	}
@@ -136,6 +140,8 @@ func Test_Observe(t *testing.T) {
 		VpcId: aws.String("some arbitrary Id"),
 		State: awsec2.VpcStateAvailable,
 	}
+	ownedExternal := *mockExternal
+	ownedExternal.Tags = []awsec2.Tag{{Key: aws.String(ec2.TagKeyUID), Value: aws.String("some-uid")}}
 	var mockClientErr error
 	var itemsList []awsec2.Vpc
 	mockClient.MockDescribeVpcsRequest = func(input *awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
@@ -150,6 +156,15 @@ func Test_Observe(t *testing.T) {
 		}
 	}
 
+	mockClient.MockDescribeVpcAttributeRequest = func(input *awsec2.DescribeVpcAttributeInput) awsec2.DescribeVpcAttributeRequest {
+		return awsec2.DescribeVpcAttributeRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        describeVpcAttributeOutput(input.Attribute),
+			},
+		}
+	}
+
 	for _, tc := range []struct {
 		description           string
 		managedObj            resource.Managed
@@ -182,6 +197,38 @@ func Test_Observe(t *testing.T) {
 			true,
 			false,
 		},
+		{
+			"if item's external name is a VPC ID, it should be adopted",
+			&v1alpha2.VPC{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.ExternalNameAnnotationKey: "vpc-0123abcd"}}},
+			[]awsec2.Vpc{*mockExternal},
+			nil,
+			true,
+			true,
+		},
+		{
+			"if item's external name is not a VPC ID, it should be ignored",
+			&v1alpha2.VPC{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.ExternalNameAnnotationKey: "some-name"}}},
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if item's identifier was lost, it should be found by its ownership tag",
+			&v1alpha2.VPC{ObjectMeta: metav1.ObjectMeta{UID: "some-uid"}},
+			[]awsec2.Vpc{ownedExternal},
+			nil,
+			true,
+			true,
+		},
+		{
+			"if multiple items are tagged with the item's UID, it should return error",
+			&v1alpha2.VPC{ObjectMeta: metav1.ObjectMeta{UID: "some-uid"}},
+			[]awsec2.Vpc{*mockExternal, *mockExternal},
+			nil,
+			false,
+			false,
+		},
 		{
 			"if external resource doesn't exist, it should return expected",
 			mockManaged.DeepCopy(),
@@ -220,17 +267,190 @@ func Test_Observe(t *testing.T) {
 			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionTrue), tc.description)
 			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonAvailable), tc.description)
 			g.Expect(mgd.Status.VPCExternalStatus.VPCState).To(gomega.Equal(string(mockExternal.State)), tc.description)
+			g.Expect(mgd.Status.VPCID).To(gomega.Equal(aws.StringValue(mockExternal.VpcId)), tc.description)
+			g.Expect(result.ResourceUpToDate).To(gomega.BeTrue(), tc.description)
 		}
 	}
 }
 
+// describeVpcAttributeOutput returns the default attributes of a VPC: DNS
+// support enabled and DNS hostnames disabled.
+func describeVpcAttributeOutput(name awsec2.VpcAttributeName) *awsec2.DescribeVpcAttributeOutput {
+	if name == awsec2.VpcAttributeNameEnableDnsSupport {
+		return &awsec2.DescribeVpcAttributeOutput{EnableDnsSupport: &awsec2.AttributeBooleanValue{Value: aws.Bool(true)}}
+	}
+	return &awsec2.DescribeVpcAttributeOutput{EnableDnsHostnames: &awsec2.AttributeBooleanValue{Value: aws.Bool(false)}}
+}
+
+func Test_ObserveLateInitialize(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockAdoptedVPC()
+	lateInitialized := v1alpha2.VPCParameters{
+		EnableDNSSupport:            aws.Bool(true),
+		EnableDNSHostNames:          aws.Bool(false),
+		AdditionalCIDRBlocks:        []string{"10.1.0.0/16"},
+		AmazonProvidedIPv6CIDRBlock: aws.Bool(true),
+		InstanceTenancy:             "default",
+	}
+	adopted := func(p v1alpha2.VPCParameters) *v1alpha2.VPC {
+		return &v1alpha2.VPC{
+			ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.ExternalNameAnnotationKey: "vpc-0123abcd"}},
+			Spec:       v1alpha2.VPCSpec{VPCParameters: p},
+		}
+	}
+
+	for _, tc := range []struct {
+		description        string
+		managedObj         *v1alpha2.VPC
+		updateErr          error
+		expectedErrNil     bool
+		expectedUpdates    int
+		expectedParameters v1alpha2.VPCParameters
+		expectedUpToDate   bool
+	}{
+		{
+			"unset parameters of an adopted VPC should be late initialized",
+			adopted(v1alpha2.VPCParameters{}),
+			nil,
+			true,
+			1,
+			lateInitialized,
+			true,
+		},
+		{
+			"set parameters of an adopted VPC should be kept",
+			adopted(v1alpha2.VPCParameters{
+				EnableDNSSupport:            aws.Bool(false),
+				EnableDNSHostNames:          aws.Bool(true),
+				AdditionalCIDRBlocks:        []string{"10.2.0.0/16"},
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(false),
+				InstanceTenancy:             "default",
+			}),
+			nil,
+			true,
+			0,
+			v1alpha2.VPCParameters{
+				EnableDNSSupport:            aws.Bool(false),
+				EnableDNSHostNames:          aws.Bool(true),
+				AdditionalCIDRBlocks:        []string{"10.2.0.0/16"},
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(false),
+				InstanceTenancy:             "default",
+			},
+			false,
+		},
+		{
+			"if updating the late initialized object fails, it should return error",
+			adopted(v1alpha2.VPCParameters{}),
+			errors.New("some error"),
+			false,
+			1,
+			lateInitialized,
+			false,
+		},
+	} {
+		var numUpdateCalled int
+		e := external{
+			client: &mockClient,
+			kube: &test.MockClient{MockUpdate: func(_ context.Context, _ runtime.Object, _ ...client.UpdateOption) error {
+				numUpdateCalled++
+				return tc.updateErr
+			}},
+		}
+
+		result, err := e.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(numUpdateCalled).To(gomega.Equal(tc.expectedUpdates), tc.description)
+		g.Expect(tc.managedObj.Spec.VPCParameters).To(gomega.Equal(tc.expectedParameters), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+	}
+}
+
+// mockAdoptedVPC mocks a VPC with the default attributes, a secondary IPv4
+// CIDR block and an IPv6 CIDR block, as it might exist before it is adopted.
+func mockAdoptedVPC() {
+	associated := &awsec2.VpcCidrBlockState{State: awsec2.VpcCidrBlockStateCodeAssociated}
+	vpc := awsec2.Vpc{
+		VpcId:     aws.String("vpc-0123abcd"),
+		State:     awsec2.VpcStateAvailable,
+		CidrBlock: aws.String("10.0.0.0/16"),
+		CidrBlockAssociationSet: []awsec2.VpcCidrBlockAssociation{
+			{AssociationId: aws.String("primary"), CidrBlock: aws.String("10.0.0.0/16"), CidrBlockState: associated},
+			{AssociationId: aws.String("secondary"), CidrBlock: aws.String("10.1.0.0/16"), CidrBlockState: associated},
+		},
+		Ipv6CidrBlockAssociationSet: []awsec2.VpcIpv6CidrBlockAssociation{
+			{AssociationId: aws.String("ipv6"), Ipv6CidrBlock: aws.String("2600:1f14::/56"), Ipv6CidrBlockState: associated},
+		},
+		InstanceTenancy: awsec2.TenancyDefault,
+	}
+	mockClient.MockDescribeVpcsRequest = func(input *awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+		return awsec2.DescribeVpcsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeVpcsOutput{Vpcs: []awsec2.Vpc{vpc}},
+			},
+		}
+	}
+	mockClient.MockDescribeVpcAttributeRequest = func(input *awsec2.DescribeVpcAttributeInput) awsec2.DescribeVpcAttributeRequest {
+		return awsec2.DescribeVpcAttributeRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        describeVpcAttributeOutput(input.Attribute),
+			},
+		}
+	}
+}
+
+func Test_AdoptUpdate(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockAdoptedVPC()
+	var changes []string
+	mockClient.MockModifyVpcAttributeRequest = func(input *awsec2.ModifyVpcAttributeInput) awsec2.ModifyVpcAttributeRequest {
+		changes = append(changes, "modify attribute")
+		return awsec2.ModifyVpcAttributeRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ModifyVpcAttributeOutput{}}}
+	}
+	mockClient.MockAssociateVpcCidrBlockRequest = func(input *awsec2.AssociateVpcCidrBlockInput) awsec2.AssociateVpcCidrBlockRequest {
+		changes = append(changes, "associate")
+		return awsec2.AssociateVpcCidrBlockRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.AssociateVpcCidrBlockOutput{}}}
+	}
+	mockClient.MockDisassociateVpcCidrBlockRequest = func(input *awsec2.DisassociateVpcCidrBlockInput) awsec2.DisassociateVpcCidrBlockRequest {
+		changes = append(changes, "disassociate "+aws.StringValue(input.AssociationId))
+		return awsec2.DisassociateVpcCidrBlockRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DisassociateVpcCidrBlockOutput{}}}
+	}
+	mockClient.MockModifyVpcTenancyRequest = func(input *awsec2.ModifyVpcTenancyInput) awsec2.ModifyVpcTenancyRequest {
+		changes = append(changes, "modify tenancy")
+		return awsec2.ModifyVpcTenancyRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ModifyVpcTenancyOutput{}}}
+	}
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		return awsec2.CreateTagsRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateTagsOutput{}}}
+	}
+
+	cr := &v1alpha2.VPC{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.ExternalNameAnnotationKey: "vpc-0123abcd"}},
+		Spec:       v1alpha2.VPCSpec{VPCParameters: v1alpha2.VPCParameters{CIDRBlock: "10.0.0.0/16"}},
+	}
+	e := external{client: &mockClient, kube: &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)}}
+
+	_, err := e.Observe(context.Background(), cr)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+
+	// the tags that record the owner of the VPC are the only change
+	_, err = e.Update(context.Background(), cr)
+	g.Expect(err).NotTo(gomega.HaveOccurred())
+	g.Expect(changes).To(gomega.BeEmpty(), "updating an adopted VPC should not change it")
+}
+
 func Test_Create(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
 	mockManaged := v1alpha2.VPC{
 		Spec: v1alpha2.VPCSpec{
 			VPCParameters: v1alpha2.VPCParameters{
-				CIDRBlock: "arbitrary cidr block string",
+				CIDRBlock:          "arbitrary cidr block string",
+				EnableDNSSupport:   aws.Bool(true),
+				EnableDNSHostNames: aws.Bool(true),
 			},
 		},
 	}
@@ -308,7 +528,9 @@ func Test_Create(t *testing.T) {
 			&v1alpha2.VPC{
 				Spec: v1alpha2.VPCSpec{
 					VPCParameters: v1alpha2.VPCParameters{
-						CIDRBlock: "arbitrary cidr block string",
+						CIDRBlock:          "arbitrary cidr block string",
+						EnableDNSSupport:   aws.Bool(true),
+						EnableDNSHostNames: aws.Bool(true),
 					},
 				},
 				Status: v1alpha2.VPCStatus{
@@ -323,6 +545,30 @@ func Test_Create(t *testing.T) {
 			0,
 			2,
 		},
+		{
+			"unset attributes should keep their defaults",
+			&v1alpha2.VPC{
+				Spec: v1alpha2.VPCSpec{
+					VPCParameters: v1alpha2.VPCParameters{
+						CIDRBlock: "arbitrary cidr block string",
+					},
+				},
+			},
+			nil,
+			nil,
+			true,
+			1,
+			0,
+		},
+		{
+			"if item's external name is a VPC ID, it should not create a new vpc",
+			&v1alpha2.VPC{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{meta.ExternalNameAnnotationKey: "vpc-0123abcd"}}},
+			nil,
+			nil,
+			false,
+			0,
+			0,
+		},
 		{
 			"if modifying attributes fails, it should return error",
 			mockManaged.DeepCopy(),
@@ -357,11 +603,185 @@ func Test_Create(t *testing.T) {
 func Test_Update(t *testing.T) {
 	g := gomega.NewGomegaWithT(t)
 
-	mockManaged := v1alpha2.VPC{}
+	mockManaged := v1alpha2.VPC{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-name"},
+		Spec: v1alpha2.VPCSpec{
+			VPCParameters: v1alpha2.VPCParameters{
+				CIDRBlock:                   "10.0.0.0/16",
+				EnableDNSSupport:            aws.Bool(true),
+				AdditionalCIDRBlocks:        []string{"10.1.0.0/16"},
+				AmazonProvidedIPv6CIDRBlock: aws.Bool(true),
+				InstanceTenancy:             "default",
+				Tags:                        []v1alpha2.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha2.VPCStatus{
+			VPCExternalStatus: v1alpha2.VPCExternalStatus{
+				VPCID: "some arbitrary id",
+			},
+		},
+	}
+	associated := &awsec2.VpcCidrBlockState{State: awsec2.VpcCidrBlockStateCodeAssociated}
+	mockExternal := awsec2.Vpc{
+		VpcId:     aws.String("some arbitrary id"),
+		CidrBlock: aws.String("10.0.0.0/16"),
+		CidrBlockAssociationSet: []awsec2.VpcCidrBlockAssociation{
+			{AssociationId: aws.String("primary"), CidrBlock: aws.String("10.0.0.0/16"), CidrBlockState: associated},
+			{AssociationId: aws.String("stale"), CidrBlock: aws.String("10.2.0.0/16"), CidrBlockState: associated},
+		},
+		InstanceTenancy: awsec2.TenancyDedicated,
+	}
 
-	_, err := mockExternalClient.Update(context.Background(), &mockManaged)
+	var mockDescribeErr error
+	mockClient.MockDescribeVpcsRequest = func(input *awsec2.DescribeVpcsInput) awsec2.DescribeVpcsRequest {
+		return awsec2.DescribeVpcsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DescribeVpcsOutput{Vpcs: []awsec2.Vpc{mockExternal}},
+				Error:       mockDescribeErr,
+			},
+		}
+	}
+	mockClient.MockDescribeVpcAttributeRequest = func(input *awsec2.DescribeVpcAttributeInput) awsec2.DescribeVpcAttributeRequest {
+		return awsec2.DescribeVpcAttributeRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeVpcAttributeOutput{
+					EnableDnsSupport:   &awsec2.AttributeBooleanValue{Value: aws.Bool(false)},
+					EnableDnsHostnames: &awsec2.AttributeBooleanValue{Value: aws.Bool(false)},
+				},
+			},
+		}
+	}
 
-	g.Expect(err).To(gomega.BeNil())
+	var numModifyCalled int
+	mockClient.MockModifyVpcAttributeRequest = func(input *awsec2.ModifyVpcAttributeInput) awsec2.ModifyVpcAttributeRequest {
+		numModifyCalled++
+		g.Expect(input.EnableDnsSupport).NotTo(gomega.BeNil(), "only the changed attribute should be modified")
+		return awsec2.ModifyVpcAttributeRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ModifyVpcAttributeOutput{}},
+		}
+	}
+
+	var mockAssociateErr error
+	var associated4, associated6 []string
+	mockClient.MockAssociateVpcCidrBlockRequest = func(input *awsec2.AssociateVpcCidrBlockInput) awsec2.AssociateVpcCidrBlockRequest {
+		if aws.BoolValue(input.AmazonProvidedIpv6CidrBlock) {
+			associated6 = append(associated6, aws.StringValue(input.VpcId))
+		} else {
+			associated4 = append(associated4, aws.StringValue(input.CidrBlock))
+		}
+		return awsec2.AssociateVpcCidrBlockRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.AssociateVpcCidrBlockOutput{}, Error: mockAssociateErr},
+		}
+	}
+
+	var disassociated []string
+	mockClient.MockDisassociateVpcCidrBlockRequest = func(input *awsec2.DisassociateVpcCidrBlockInput) awsec2.DisassociateVpcCidrBlockRequest {
+		disassociated = append(disassociated, aws.StringValue(input.AssociationId))
+		return awsec2.DisassociateVpcCidrBlockRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.DisassociateVpcCidrBlockOutput{}},
+		}
+	}
+
+	var numTenancyCalled int
+	mockClient.MockModifyVpcTenancyRequest = func(input *awsec2.ModifyVpcTenancyInput) awsec2.ModifyVpcTenancyRequest {
+		numTenancyCalled++
+		g.Expect(input.InstanceTenancy).To(gomega.Equal(awsec2.VpcTenancyDefault))
+		return awsec2.ModifyVpcTenancyRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.ModifyVpcTenancyOutput{}},
+		}
+	}
+
+	var createdTags []awsec2.Tag
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		createdTags = append(createdTags, input.Tags...)
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateTagsOutput{}},
+		}
+	}
+
+	for _, tc := range []struct {
+		description            string
+		managedObj             resource.Managed
+		describeErr            error
+		associateErr           error
+		expectedErrNil         bool
+		expectedModifyCalls    int
+		expectedAssociated     []string
+		expectedAssociated6    int
+		expectedDisassociated  []string
+		expectedTenancyCalls   int
+		expectedCreatedTagsNum int
+	}{
+		{
+			"valid input should reconcile every difference",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+			1,
+			[]string{"10.1.0.0/16"},
+			1,
+			[]string{"stale"},
+			1,
+			2,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			0,
+			nil,
+			0,
+			nil,
+			0,
+			0,
+		},
+		{
+			"if describing the resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			nil,
+			false,
+			0,
+			nil,
+			0,
+			nil,
+			0,
+			0,
+		},
+		{
+			"if associating a CIDR block fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			1,
+			[]string{"10.1.0.0/16"},
+			0,
+			[]string{"stale"},
+			0,
+			0,
+		},
+	} {
+		numModifyCalled, numTenancyCalled = 0, 0
+		associated4, associated6, disassociated, createdTags = nil, nil, nil, nil
+		mockDescribeErr = tc.describeErr
+		mockAssociateErr = tc.associateErr
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(numModifyCalled).To(gomega.Equal(tc.expectedModifyCalls), tc.description)
+		g.Expect(associated4).To(gomega.Equal(tc.expectedAssociated), tc.description)
+		g.Expect(len(associated6)).To(gomega.Equal(tc.expectedAssociated6), tc.description)
+		g.Expect(disassociated).To(gomega.Equal(tc.expectedDisassociated), tc.description)
+		g.Expect(numTenancyCalled).To(gomega.Equal(tc.expectedTenancyCalls), tc.description)
+		g.Expect(len(createdTags)).To(gomega.Equal(tc.expectedCreatedTagsNum), tc.description)
+	}
 }
 
 func Test_Delete(t *testing.T) {
@@ -0,0 +1,341 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpoint
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPCEndpoint resource"
+	errClient           = "cannot create a new VPCEndpointClient"
+	errDescribe         = "failed to describe VPCEndpoint with id: %v"
+	errMultipleItems    = "retrieved multiple VPCEndpoints for the given vpcEndpointId: %v"
+	errCreate           = "failed to create the VPCEndpoint resource"
+	errDescribeTags     = "failed to describe the tags of VPCEndpoint with id: %v"
+	errCreateTags       = "failed to create tags for the VPCEndpoint resource"
+	errModify           = "failed to modify the VPCEndpoint resource"
+	errDeleteNotPresent = "cannot delete the VPCEndpoint, since the vpcEndpointID is not present"
+	errDelete           = "failed to delete the VPCEndpoint resource"
+)
+
+// Controller is the controller for VPCEndpoint objects
+type Controller struct{}
+
+// SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(v1alpha2.VPCEndpointGroupVersionKind),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewVPCEndpointClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
+		resource.WithManagedConnectionPublishers())
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.VPCEndpointKindAPIVersion, v1alpha2.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.VPCEndpoint{}).
+		Complete(r)
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.VPCEndpointClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha2.VPCEndpoint)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, cr.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{c}, nil
+}
+
+type external struct {
+	client ec2.VPCEndpointClient
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha2.VPCEndpoint)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// To find out whether a VPCEndpoint exist:
+	// - the ID of the VPCEndpoint should be known, see identify
+	// - a VPCEndpoint with the given vpcEndpointID should exist, and not be
+	//   deleted
+	id, err := e.identify(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if id == "" {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	observed, err := e.describe(ctx, id)
+	if ec2.IsVPCEndpointNotFoundErr(err) {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+
+	// the API documents capitalized states, but returns them in lower case
+	state := strings.ToLower(string(observed.State))
+	if state == strings.ToLower(string(awsec2.StateDeleted)) {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	switch state {
+	case strings.ToLower(string(awsec2.StateAvailable)):
+		cr.SetConditions(runtimev1alpha1.Available())
+	case strings.ToLower(string(awsec2.StatePending)):
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case strings.ToLower(string(awsec2.StateDeleting)):
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	default:
+		// pendingAcceptance, rejected, failed and expired endpoints can't be
+		// used until the service provider, or an operator, acts on them.
+		cr.SetConditions(runtimev1alpha1.Unavailable())
+	}
+
+	// unlike other EC2 resources, endpoints are described without their tags
+	tags, err := e.describeTags(ctx, id)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+
+	cr.UpdateExternalStatus(*observed)
+	cr.Status.Tags = v1alpha2.BuildFromEC2Tags(tags)
+
+	return resource.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  ec2.IsVPCEndpointUpToDate(cr.Spec.VPCEndpointParameters, *observed) && ec2.AreTagsUpToDate(cr, cr.Spec.Tags, tags),
+		ConnectionDetails: resource.ConnectionDetails{},
+	}, nil
+}
+
+// identify returns the ID of the VPCEndpoint that belongs to the supplied
+// object, see ec2.Identify.
+func (e *external) identify(ctx context.Context, cr *v1alpha2.VPCEndpoint) (string, error) {
+	// deleted endpoints remain visible for a while
+	return ec2.Identify(ctx, cr, cr.Status.VPCEndpointID, "", e.describeIDs, awsec2.Filter{
+		Name:   aws.String("vpc-endpoint-state"),
+		Values: []string{"pending", "available", "pendingAcceptance"},
+	})
+}
+
+// describeIDs returns the IDs of the VPCEndpoints that match the supplied
+// filters.
+func (e *external) describeIDs(ctx context.Context, filters []awsec2.Filter) ([]string, error) {
+	req := e.client.DescribeVpcEndpointsRequest(&awsec2.DescribeVpcEndpointsInput{Filters: filters})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(response.VpcEndpoints))
+	for i, r := range response.VpcEndpoints {
+		ids[i] = aws.StringValue(r.VpcEndpointId)
+	}
+	return ids, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha2.VPCEndpoint)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+
+	// a deleted endpoint is returned again for the token it was created by, so
+	// the token is varied by the endpoint it replaces, if any
+	token := ec2.ReplacementClientToken(cr, cr.Status.VPCEndpointID)
+	req := e.client.CreateVpcEndpointRequest(ec2.GenerateCreateVPCEndpointInput(cr.Spec.VPCEndpointParameters, token))
+	req.SetContext(ctx)
+
+	rsp, err := req.Send()
+	if err != nil {
+		return resource.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	cr.UpdateExternalStatus(*rsp.VpcEndpoint)
+
+	if tags := ec2.GenerateTags(cr, cr.Spec.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.VPCEndpointID},
+			Tags:      v1alpha2.BuildEC2Tags(tags),
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalCreation{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	return resource.ExternalCreation{}, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha2.VPCEndpoint)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	observed, err := e.describe(ctx, cr.Status.VPCEndpointID)
+	if err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+
+	observedTags, err := e.describeTags(ctx, cr.Status.VPCEndpointID)
+	if err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+
+	if tags := ec2.TagsToCreate(ec2.GenerateTags(cr, cr.Spec.Tags), observedTags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.VPCEndpointID},
+			Tags:      tags,
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	input := ec2.GenerateModifyVPCEndpointInput(cr.Status.VPCEndpointID, cr.Spec.VPCEndpointParameters, *observed)
+	if input == nil {
+		return resource.ExternalUpdate{}, nil
+	}
+
+	req := e.client.ModifyVpcEndpointRequest(input)
+	req.SetContext(ctx)
+
+	_, err = req.Send()
+	return resource.ExternalUpdate{}, errors.Wrap(err, errModify)
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha2.VPCEndpoint)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	if cr.Status.VPCEndpointID == "" {
+		return errors.New(errDeleteNotPresent)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	req := e.client.DeleteVpcEndpointsRequest(&awsec2.DeleteVpcEndpointsInput{
+		VpcEndpointIds: []string{cr.Status.VPCEndpointID},
+	})
+	req.SetContext(ctx)
+
+	rsp, err := req.Send()
+	if err != nil {
+		return errors.Wrap(err, errDelete)
+	}
+
+	// failures to delete individual endpoints are reported in the response
+	// rather than as an error
+	for _, u := range rsp.Unsuccessful {
+		if u.Error == nil || aws.StringValue(u.Error.Code) == ec2.VPCEndpointIDNotFound {
+			continue
+		}
+		return errors.Wrap(errors.New(aws.StringValue(u.Error.Message)), errDelete)
+	}
+
+	return nil
+}
+
+func (e *external) describe(ctx context.Context, id string) (*awsec2.VpcEndpoint, error) {
+	req := e.client.DescribeVpcEndpointsRequest(&awsec2.DescribeVpcEndpointsInput{
+		VpcEndpointIds: []string{id},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if ec2.IsVPCEndpointNotFoundErr(err) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, errDescribe, id)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.VpcEndpoints) != 1 {
+		return nil, errors.Errorf(errMultipleItems, id)
+	}
+
+	return &response.VpcEndpoints[0], nil
+}
+
+func (e *external) describeTags(ctx context.Context, id string) ([]awsec2.Tag, error) {
+	req := e.client.DescribeTagsRequest(&awsec2.DescribeTagsInput{
+		Filters: []awsec2.Filter{{Name: aws.String("resource-id"), Values: []string{id}}},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, errors.Wrapf(err, errDescribeTags, id)
+	}
+
+	tags := make([]awsec2.Tag, len(response.Tags))
+	for i, t := range response.Tags {
+		tags[i] = awsec2.Tag{Key: t.Key, Value: t.Value}
+	}
+	return tags, nil
+}
@@ -0,0 +1,620 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcendpoint
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockVPCEndpointClient
+
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockVPCEndpointClient{}
+	mockExternalClient = external{&mockClient}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha2.VPCEndpoint{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.VPCEndpointClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.VPCEndpoint{
+		Spec: v1alpha2.VPCEndpointSpec{
+			VPCEndpointParameters: v1alpha2.VPCEndpointParameters{
+				RouteTableIDs: []string{"rtb-1"},
+				Tags:          []v1alpha2.Tag{{Key: "k", Value: "v"}},
+			},
+		},
+		Status: v1alpha2.VPCEndpointStatus{
+			VPCEndpointExternalStatus: v1alpha2.VPCEndpointExternalStatus{
+				VPCEndpointID: "some arbitrary id",
+			},
+		},
+	}
+
+	var mockClientErr error
+	var itemsList []awsec2.VpcEndpoint
+	mockClient.MockDescribeVpcEndpointsRequest = func(input *awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+		return awsec2.DescribeVpcEndpointsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeVpcEndpointsOutput{
+					VpcEndpoints: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	mockClient.MockDescribeTagsRequest = func(input *awsec2.DescribeTagsInput) awsec2.DescribeTagsRequest {
+		g.Expect(input.Filters[0].Values).To(gomega.Equal([]string{"some arbitrary id"}), "the passed parameters are not valid")
+		return awsec2.DescribeTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeTagsOutput{
+					Tags: []awsec2.TagDescription{{Key: aws.String("k"), Value: aws.String("v")}},
+				},
+			},
+		}
+	}
+
+	endpoint := func(state string, routeTables ...string) []awsec2.VpcEndpoint {
+		return []awsec2.VpcEndpoint{{
+			VpcEndpointId: aws.String("some arbitrary id"),
+			State:         awsec2.State(state),
+			RouteTableIds: routeTables,
+			DnsEntries:    []awsec2.DnsEntry{{DnsName: aws.String("some.dns.name")}},
+		}}
+	}
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		itemsReturned         []awsec2.VpcEndpoint
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+		expectedReason        corev1alpha1.ConditionReason
+	}{
+		{
+			"available endpoint should be available and up to date",
+			mockManaged.DeepCopy(),
+			endpoint("available", "rtb-1"),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"pending endpoint should be creating",
+			mockManaged.DeepCopy(),
+			endpoint("pending", "rtb-1"),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"endpoint pending acceptance should be unavailable",
+			mockManaged.DeepCopy(),
+			endpoint("pendingAcceptance", "rtb-1"),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonUnavailable,
+		},
+		{
+			"endpoint with other route tables should not be up to date",
+			mockManaged.DeepCopy(),
+			endpoint("available", "rtb-2"),
+			nil,
+			true,
+			true,
+			false,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"deleted endpoint should not exist",
+			mockManaged.DeepCopy(),
+			endpoint("deleted"),
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha2.VPCEndpoint{},
+			nil,
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			awserr.New(ec2.VPCEndpointIDNotFound, "", nil),
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			[]awsec2.VpcEndpoint{},
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		result, err := mockExternalClient.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha2.VPCEndpoint)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(tc.expectedReason), tc.description)
+			g.Expect(mgd.Status.DNSEntries).To(gomega.Equal([]v1alpha2.DNSEntry{{DNSName: "some.dns.name"}}), tc.description)
+			g.Expect(mgd.Status.Tags).To(gomega.Equal([]v1alpha2.Tag{{Key: "k", Value: "v"}}), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.VPCEndpoint{
+		ObjectMeta: metav1.ObjectMeta{UID: "some-uid"},
+		Spec: v1alpha2.VPCEndpointSpec{
+			VPCEndpointParameters: v1alpha2.VPCEndpointParameters{
+				VPCID:           "arbitrary vpcId",
+				ServiceName:     "com.amazonaws.us-east-1.sts",
+				VPCEndpointType: "Interface",
+				SubnetIDs:       []string{"subnet-1"},
+			},
+		},
+	}
+	mockExternal := &awsec2.VpcEndpoint{
+		VpcEndpointId: aws.String("some arbitrary id"),
+		State:         "pending",
+	}
+	replacing := mockManaged.DeepCopy()
+	replacing.Status.VPCEndpointID = "vpce-deleted"
+	var mockClientErr error
+	var mockClientToken string
+	mockClient.MockCreateVpcEndpointRequest = func(input *awsec2.CreateVpcEndpointInput) awsec2.CreateVpcEndpointRequest {
+		g.Expect(aws.StringValue(input.ServiceName)).To(gomega.Equal(mockManaged.Spec.ServiceName), "the passed parameters are not valid")
+		g.Expect(input.VpcEndpointType).To(gomega.Equal(awsec2.VpcEndpointTypeInterface), "the passed parameters are not valid")
+		g.Expect(input.SubnetIds).To(gomega.Equal(mockManaged.Spec.SubnetIDs), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.ClientToken)).To(gomega.Equal(mockClientToken), "the client token should derive from the UID")
+		return awsec2.CreateVpcEndpointRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.CreateVpcEndpointOutput{
+					VpcEndpoint: mockExternal,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		g.Expect(input.Tags).To(gomega.Equal([]awsec2.Tag{
+			{Key: aws.String(ec2.TagKeyUID), Value: aws.String(string(mockManaged.GetUID()))},
+		}), "the passed parameters are not valid")
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.CreateTagsOutput{},
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		clientToken    string
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			"some-uid",
+			true,
+		},
+		{
+			"replacing a deleted endpoint should vary the client token",
+			replacing,
+			nil,
+			"some-uid-vpce-deleted",
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			"some-uid",
+			false,
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			"some-uid",
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		mockClientToken = tc.clientToken
+
+		_, err := mockExternalClient.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.VPCEndpoint)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.VPCEndpointID).To(gomega.Equal(aws.StringValue(mockExternal.VpcEndpointId)), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.VPCEndpoint{
+		ObjectMeta: metav1.ObjectMeta{Name: "some-name"},
+		Spec: v1alpha2.VPCEndpointSpec{
+			VPCEndpointParameters: v1alpha2.VPCEndpointParameters{
+				RouteTableIDs: []string{"rtb-1", "rtb-2"},
+			},
+		},
+		Status: v1alpha2.VPCEndpointStatus{
+			VPCEndpointExternalStatus: v1alpha2.VPCEndpointExternalStatus{
+				VPCEndpointID: "some arbitrary id",
+			},
+		},
+	}
+
+	var observedRouteTables []string
+	mockClient.MockDescribeVpcEndpointsRequest = func(input *awsec2.DescribeVpcEndpointsInput) awsec2.DescribeVpcEndpointsRequest {
+		return awsec2.DescribeVpcEndpointsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeVpcEndpointsOutput{
+					VpcEndpoints: []awsec2.VpcEndpoint{{RouteTableIds: observedRouteTables}},
+				},
+			},
+		}
+	}
+
+	mockClient.MockDescribeTagsRequest = func(input *awsec2.DescribeTagsInput) awsec2.DescribeTagsRequest {
+		return awsec2.DescribeTagsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeTagsOutput{
+					Tags: []awsec2.TagDescription{{Key: aws.String(ec2.TagKeyName), Value: aws.String("other-name")}},
+				},
+			},
+		}
+	}
+
+	var createdTags []awsec2.Tag
+	mockClient.MockCreateTagsRequest = func(input *awsec2.CreateTagsInput) awsec2.CreateTagsRequest {
+		createdTags = input.Tags
+		return awsec2.CreateTagsRequest{
+			Request: &aws.Request{HTTPRequest: &http.Request{}, Data: &awsec2.CreateTagsOutput{}},
+		}
+	}
+
+	var mockModifyErr error
+	var modifyInput *awsec2.ModifyVpcEndpointInput
+	mockClient.MockModifyVpcEndpointRequest = func(input *awsec2.ModifyVpcEndpointInput) awsec2.ModifyVpcEndpointRequest {
+		modifyInput = input
+		return awsec2.ModifyVpcEndpointRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.ModifyVpcEndpointOutput{},
+				Error:       mockModifyErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description         string
+		managedObj          resource.Managed
+		observedRouteTables []string
+		modifyErr           error
+		expectedErrNil      bool
+		expectedModify      *awsec2.ModifyVpcEndpointInput
+	}{
+		{
+			"up to date endpoint should not be modified",
+			mockManaged.DeepCopy(),
+			[]string{"rtb-2", "rtb-1"},
+			nil,
+			true,
+			nil,
+		},
+		{
+			"route table changes should be modified",
+			mockManaged.DeepCopy(),
+			[]string{"rtb-1", "rtb-3"},
+			nil,
+			true,
+			&awsec2.ModifyVpcEndpointInput{
+				VpcEndpointId:       aws.String("some arbitrary id"),
+				AddRouteTableIds:    []string{"rtb-2"},
+				RemoveRouteTableIds: []string{"rtb-3"},
+			},
+		},
+		{
+			"if modifying the endpoint fails, it should return error",
+			mockManaged.DeepCopy(),
+			[]string{"rtb-1"},
+			errors.New("some error"),
+			false,
+			&awsec2.ModifyVpcEndpointInput{
+				VpcEndpointId:    aws.String("some arbitrary id"),
+				AddRouteTableIds: []string{"rtb-2"},
+			},
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			nil,
+		},
+	} {
+		observedRouteTables = tc.observedRouteTables
+		mockModifyErr = tc.modifyErr
+		modifyInput = nil
+
+		_, err := mockExternalClient.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(modifyInput).To(gomega.Equal(tc.expectedModify), tc.description)
+		if tc.expectedErrNil {
+			g.Expect(createdTags).To(gomega.Equal([]awsec2.Tag{{Key: aws.String(ec2.TagKeyName), Value: aws.String("some-name")}}), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.VPCEndpoint{
+		Status: v1alpha2.VPCEndpointStatus{
+			VPCEndpointExternalStatus: v1alpha2.VPCEndpointExternalStatus{
+				VPCEndpointID: "some arbitrary id",
+			},
+		},
+	}
+	var mockClientErr error
+	var unsuccessful []awsec2.UnsuccessfulItem
+	mockClient.MockDeleteVpcEndpointsRequest = func(input *awsec2.DeleteVpcEndpointsInput) awsec2.DeleteVpcEndpointsRequest {
+		g.Expect(input.VpcEndpointIds).To(gomega.Equal([]string{mockManaged.Status.VPCEndpointID}), "the passed parameters are not valid")
+		return awsec2.DeleteVpcEndpointsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteVpcEndpointsOutput{Unsuccessful: unsuccessful},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	failure := func(code string) []awsec2.UnsuccessfulItem {
+		return []awsec2.UnsuccessfulItem{{Error: &awsec2.UnsuccessfulItemError{Code: aws.String(code), Message: aws.String("some message")}}}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		unsuccessful   []awsec2.UnsuccessfulItem
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+		},
+		{
+			"if status doesn't have the resource ID, it should return an error",
+			&v1alpha2.VPCEndpoint{},
+			nil,
+			nil,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			nil,
+			failure(ec2.VPCEndpointIDNotFound),
+			true,
+		},
+		{
+			"if deleting the endpoint is unsuccessful, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			failure("SomeFailure"),
+			false,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			nil,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		unsuccessful = tc.unsuccessful
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.VPCEndpoint)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
@@ -0,0 +1,380 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnection
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	awsv1alpha2 "github.com/crossplaneio/stack-aws/apis/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
+)
+
+const (
+	errUnexpectedObject = "The managed resource is not a VPCPeeringConnection resource"
+	errClient           = "cannot create a new VPCPeeringConnectionClient"
+	errAccepterClient   = "cannot create a VPCPeeringConnectionClient for the accepter"
+	errDescribe         = "failed to describe VPCPeeringConnection with id: %v"
+	errMultipleItems    = "retrieved multiple VPCPeeringConnections for the given vpcPeeringConnectionId: %v"
+	errCreate           = "failed to create the VPCPeeringConnection resource"
+	errCreateTags       = "failed to create tags for the VPCPeeringConnection resource"
+	errAccept           = "failed to accept the VPCPeeringConnection resource"
+	errDeleteNotPresent = "cannot delete the VPCPeeringConnection, since the vpcPeeringConnectionID is not present"
+	errDelete           = "failed to delete the VPCPeeringConnection resource"
+	errGetAccepter      = "cannot get the accepter provider"
+	errAccepterAccount  = "cannot determine the peer owner ID, since the account of the accepter provider %s is not known yet"
+)
+
+// Controller is the controller for VPCPeeringConnection objects
+type Controller struct{}
+
+// SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
+// and Start it when the Manager is Started.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(v1alpha2.VPCPeeringConnectionGroupVersionKind),
+		resource.WithExternalConnecter(&connector{client: mgr.GetClient(), newClientFn: ec2.NewVPCPeeringConnectionClient, awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion}),
+		resource.WithManagedConnectionPublishers())
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.VPCPeeringConnectionKindAPIVersion, v1alpha2.Group))
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.VPCPeeringConnection{}).
+		Complete(r)
+}
+
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (ec2.VPCPeeringConnectionClient, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
+}
+
+func (conn *connector) Connect(ctx context.Context, mgd resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mgd.(*v1alpha2.VPCPeeringConnection)
+	if !ok {
+		return nil, errors.New(errUnexpectedObject)
+	}
+
+	awsconfig, err := conn.awsConfigFn(ctx, conn.client, cr.Spec.ProviderReference, cr.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := conn.newClientFn(awsconfig)
+	if err != nil {
+		return nil, errors.Wrap(err, errClient)
+	}
+
+	return &external{client: c, kube: conn.client, accepterFn: conn.accepter(cr, awsconfig.Region)}, nil
+}
+
+// accepter returns a function that builds a client authorized to accept the
+// peering connection request, or nil if the accepter isn't known. The client
+// is built lazily so that an unavailable accepter provider doesn't prevent
+// the requester side from being observed. The accepter VPC is in the supplied
+// region of the requester unless a peer region is specified.
+func (conn *connector) accepter(cr *v1alpha2.VPCPeeringConnection, region string) func(context.Context) (ec2.VPCPeeringConnectionClient, error) {
+	ref := cr.Spec.AccepterProviderReference
+	if ref == nil {
+		// without an explicit accepter provider we can only accept requests
+		// to VPCs owned by the requester account
+		if cr.Spec.PeerOwnerID != "" {
+			return nil
+		}
+		ref = cr.Spec.ProviderReference
+	}
+
+	if cr.Spec.PeerRegion != "" {
+		region = cr.Spec.PeerRegion
+	}
+
+	return func(ctx context.Context) (ec2.VPCPeeringConnectionClient, error) {
+		awsconfig, err := conn.awsConfigFn(ctx, conn.client, ref, region)
+		if err != nil {
+			return nil, errors.Wrap(err, errAccepterClient)
+		}
+
+		c, err := conn.newClientFn(awsconfig)
+		return c, errors.Wrap(err, errAccepterClient)
+	}
+}
+
+type external struct {
+	client     ec2.VPCPeeringConnectionClient
+	kube       client.Reader
+	accepterFn func(context.Context) (ec2.VPCPeeringConnectionClient, error)
+}
+
+func (e *external) Observe(ctx context.Context, mgd resource.Managed) (resource.ExternalObservation, error) { // nolint:gocyclo
+	cr, ok := mgd.(*v1alpha2.VPCPeeringConnection)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errUnexpectedObject)
+	}
+
+	// To find out whether a VPCPeeringConnection exist:
+	// - the ID of the VPCPeeringConnection should be known, see identify
+	// - a VPCPeeringConnection with the given vpcPeeringConnectionID should
+	//   exist, and not be deleted
+	id, err := e.identify(ctx, cr)
+	if err != nil {
+		return resource.ExternalObservation{}, err
+	}
+	if id == "" {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	req := e.client.DescribeVpcPeeringConnectionsRequest(&awsec2.DescribeVpcPeeringConnectionsInput{
+		VpcPeeringConnectionIds: []string{id},
+	})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+
+	if ec2.IsVPCPeeringConnectionNotFoundErr(err) {
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	}
+
+	if err != nil {
+		return resource.ExternalObservation{}, errors.Wrapf(err, errDescribe, id)
+	}
+
+	// in a successful response, there should be one and only one object
+	if len(response.VpcPeeringConnections) != 1 {
+		return resource.ExternalObservation{}, errors.Errorf(errMultipleItems, id)
+	}
+
+	observed := response.VpcPeeringConnections[0]
+	cr.UpdateExternalStatus(observed)
+
+	upToDate := ec2.AreTagsUpToDate(cr, cr.Spec.Tags, observed.Tags)
+	switch awsec2.VpcPeeringConnectionStateReasonCode(cr.Status.StatusCode) {
+	case awsec2.VpcPeeringConnectionStateReasonCodeDeleted:
+		// deleted peering connections remain visible for a while, but can't
+		// be used anymore
+		return resource.ExternalObservation{
+			ResourceExists: false,
+		}, nil
+	case awsec2.VpcPeeringConnectionStateReasonCodeActive:
+		cr.SetConditions(runtimev1alpha1.Available())
+	case awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance:
+		cr.SetConditions(runtimev1alpha1.Creating().WithMessage(cr.Status.StatusMessage))
+		// the request needs to be accepted, if we know who can accept it
+		upToDate = upToDate && e.accepterFn == nil
+	case awsec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
+		awsec2.VpcPeeringConnectionStateReasonCodeProvisioning:
+		cr.SetConditions(runtimev1alpha1.Creating())
+	case awsec2.VpcPeeringConnectionStateReasonCodeDeleting:
+		cr.SetConditions(runtimev1alpha1.Deleting())
+	case awsec2.VpcPeeringConnectionStateReasonCodeRejected,
+		awsec2.VpcPeeringConnectionStateReasonCodeFailed,
+		awsec2.VpcPeeringConnectionStateReasonCodeExpired:
+		cr.SetConditions(runtimev1alpha1.Unavailable().WithMessage(cr.Status.StatusMessage))
+	}
+
+	return resource.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  upToDate,
+		ConnectionDetails: resource.ConnectionDetails{},
+	}, nil
+}
+
+// identify returns the ID of the VPCPeeringConnection that belongs to the
+// supplied object, see ec2.Identify.
+func (e *external) identify(ctx context.Context, cr *v1alpha2.VPCPeeringConnection) (string, error) {
+	// deleted, rejected and failed peering connections remain visible for a while
+	return ec2.Identify(ctx, cr, cr.Status.VPCPeeringConnectionID, "", e.describeIDs, awsec2.Filter{
+		Name:   aws.String("status-code"),
+		Values: []string{string(awsec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest), string(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance), string(awsec2.VpcPeeringConnectionStateReasonCodeProvisioning), string(awsec2.VpcPeeringConnectionStateReasonCodeActive)},
+	})
+}
+
+// describeIDs returns the IDs of the VPCPeeringConnections that match the
+// supplied filters.
+func (e *external) describeIDs(ctx context.Context, filters []awsec2.Filter) ([]string, error) {
+	req := e.client.DescribeVpcPeeringConnectionsRequest(&awsec2.DescribeVpcPeeringConnectionsInput{Filters: filters})
+	req.SetContext(ctx)
+
+	response, err := req.Send()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, len(response.VpcPeeringConnections))
+	for i, r := range response.VpcPeeringConnections {
+		ids[i] = aws.StringValue(r.VpcPeeringConnectionId)
+	}
+	return ids, nil
+}
+
+func (e *external) Create(ctx context.Context, mgd resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mgd.(*v1alpha2.VPCPeeringConnection)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errUnexpectedObject)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+
+	owner, err := e.peerOwnerID(ctx, cr)
+	if err != nil {
+		return resource.ExternalCreation{}, err
+	}
+
+	input := &awsec2.CreateVpcPeeringConnectionInput{
+		VpcId:     aws.String(cr.Spec.VPCID),
+		PeerVpcId: aws.String(cr.Spec.PeerVPCID),
+	}
+	if owner != "" {
+		input.PeerOwnerId = aws.String(owner)
+	}
+	if cr.Spec.PeerRegion != "" {
+		input.PeerRegion = aws.String(cr.Spec.PeerRegion)
+	}
+
+	req := e.client.CreateVpcPeeringConnectionRequest(input)
+	req.SetContext(ctx)
+
+	rsp, err := req.Send()
+	if err != nil {
+		return resource.ExternalCreation{}, errors.Wrap(err, errCreate)
+	}
+
+	cr.UpdateExternalStatus(*rsp.VpcPeeringConnection)
+
+	if tags := ec2.GenerateTags(cr, cr.Spec.Tags); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.VPCPeeringConnectionID},
+			Tags:      v1alpha2.BuildEC2Tags(tags),
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalCreation{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	return resource.ExternalCreation{}, nil
+}
+
+// peerOwnerID returns the AWS account ID of the owner of the accepter VPC: the
+// specified one, or else the account of the accepter provider, if any. An empty
+// ID means the accepter VPC is owned by the account of the requester.
+func (e *external) peerOwnerID(ctx context.Context, cr *v1alpha2.VPCPeeringConnection) (string, error) {
+	ref := cr.Spec.AccepterProviderReference
+	if cr.Spec.PeerOwnerID != "" || ref == nil {
+		return cr.Spec.PeerOwnerID, nil
+	}
+
+	p := &awsv1alpha2.Provider{}
+	if err := e.kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, p); err != nil {
+		return "", errors.Wrap(err, errGetAccepter)
+	}
+	if p.Status.AccountID == "" {
+		return "", errors.Errorf(errAccepterAccount, ref.Name)
+	}
+
+	return p.Status.AccountID, nil
+}
+
+func (e *external) Update(ctx context.Context, mgd resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mgd.(*v1alpha2.VPCPeeringConnection)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errUnexpectedObject)
+	}
+
+	// the status was refreshed by Observe right before Update is called
+	if tags := ec2.TagsToCreate(ec2.GenerateTags(cr, cr.Spec.Tags), v1alpha2.BuildEC2Tags(cr.Status.Tags)); len(tags) > 0 {
+		tagReq := e.client.CreateTagsRequest(&awsec2.CreateTagsInput{
+			Resources: []string{cr.Status.VPCPeeringConnectionID},
+			Tags:      tags,
+		})
+		tagReq.SetContext(ctx)
+
+		if _, err := tagReq.Send(); err != nil {
+			return resource.ExternalUpdate{}, errors.Wrap(err, errCreateTags)
+		}
+	}
+
+	// the VPCs of a peering connection can't be changed after creation, so
+	// the only thing left to do is accepting a pending request
+	if e.accepterFn == nil || cr.Status.StatusCode != string(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance) {
+		return resource.ExternalUpdate{}, nil
+	}
+
+	// an error here most likely means the accepter provider isn't available
+	// yet, in which case acceptance will be retried on the next sync
+	accepter, err := e.accepterFn(ctx)
+	if err != nil {
+		return resource.ExternalUpdate{}, err
+	}
+
+	req := accepter.AcceptVpcPeeringConnectionRequest(&awsec2.AcceptVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(cr.Status.VPCPeeringConnectionID),
+	})
+	req.SetContext(ctx)
+
+	rsp, err := req.Send()
+	if err != nil {
+		return resource.ExternalUpdate{}, errors.Wrap(err, errAccept)
+	}
+
+	if rsp.VpcPeeringConnection != nil {
+		cr.UpdateExternalStatus(*rsp.VpcPeeringConnection)
+	}
+
+	return resource.ExternalUpdate{}, nil
+}
+
+func (e *external) Delete(ctx context.Context, mgd resource.Managed) error {
+	cr, ok := mgd.(*v1alpha2.VPCPeeringConnection)
+	if !ok {
+		return errors.New(errUnexpectedObject)
+	}
+
+	if cr.Status.VPCPeeringConnectionID == "" {
+		return errors.New(errDeleteNotPresent)
+	}
+
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+
+	req := e.client.DeleteVpcPeeringConnectionRequest(&awsec2.DeleteVpcPeeringConnectionInput{
+		VpcPeeringConnectionId: aws.String(cr.Status.VPCPeeringConnectionID),
+	})
+	req.SetContext(ctx)
+
+	_, err := req.Send()
+	if ec2.IsVPCPeeringConnectionNotFoundErr(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDelete)
+}
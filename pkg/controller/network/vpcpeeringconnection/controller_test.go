@@ -0,0 +1,672 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vpcpeeringconnection
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	corev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+	"github.com/crossplaneio/crossplane-runtime/pkg/test"
+
+	v1alpha2 "github.com/crossplaneio/stack-aws/apis/network/v1alpha2"
+	awsv1alpha2 "github.com/crossplaneio/stack-aws/apis/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/ec2/fake"
+)
+
+var (
+	mockExternalClient external
+	mockClient         fake.MockVPCPeeringConnectionClient
+
+	// an arbitrary managed resource
+	unexpecedItem resource.Managed
+)
+
+func TestMain(m *testing.M) {
+
+	mockClient = fake.MockVPCPeeringConnectionClient{}
+	mockExternalClient = external{client: &mockClient}
+
+	os.Exit(m.Run())
+}
+
+func Test_Connect(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := &v1alpha2.VPCPeeringConnection{}
+	var clientErr error
+	var configErr error
+
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.VPCPeeringConnectionClient, error) {
+			return &mockClient, clientErr
+		},
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+			return &aws.Config{}, configErr
+		},
+	}
+
+	for _, tc := range []struct {
+		description       string
+		managedObj        resource.Managed
+		configErr         error
+		clientErr         error
+		expectedClientNil bool
+		expectedErrNil    bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged,
+			nil,
+			nil,
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws config provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			errors.New("some error"),
+			nil,
+			true,
+			false,
+		},
+		{
+			"if aws client provider fails, should return error",
+			mockManaged, // an arbitrary managed resource which is not expected
+			nil,
+			errors.New("some error"),
+			true,
+			false,
+		},
+	} {
+		clientErr = tc.clientErr
+		configErr = tc.configErr
+
+		res, err := conn.Connect(context.Background(), tc.managedObj)
+		g.Expect(res == nil).To(gomega.Equal(tc.expectedClientNil), tc.description)
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+	}
+}
+
+func Test_Connect_Accepter(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	requester := &corev1.ObjectReference{Name: "requester"}
+	accepter := &corev1.ObjectReference{Name: "accepter"}
+
+	var usedRef *corev1.ObjectReference
+	var usedRegion string
+	conn := connector{
+		client: nil,
+		newClientFn: func(conf *aws.Config) (ec2.VPCPeeringConnectionClient, error) {
+			return &mockClient, nil
+		},
+		awsConfigFn: func(_ context.Context, _ client.Reader, ref *corev1.ObjectReference, region string) (*aws.Config, error) {
+			usedRef = ref
+			usedRegion = region
+			if region == "" {
+				// the region of the provider
+				region = "us-west-2"
+			}
+			return &aws.Config{Region: region}, nil
+		},
+	}
+
+	for _, tc := range []struct {
+		description      string
+		params           v1alpha2.VPCPeeringConnectionParameters
+		expectedAccepter *corev1.ObjectReference
+		expectedRegion   string
+	}{
+		{
+			"same account peering should be accepted by the requester provider",
+			v1alpha2.VPCPeeringConnectionParameters{Region: "us-east-1"},
+			requester,
+			"us-east-1",
+		},
+		{
+			"without a region the peering should be accepted in the region of the requester provider",
+			v1alpha2.VPCPeeringConnectionParameters{AccepterProviderReference: accepter},
+			accepter,
+			"us-west-2",
+		},
+		{
+			"cross account peering without an accepter provider shouldn't be accepted",
+			v1alpha2.VPCPeeringConnectionParameters{PeerOwnerID: "123456789012"},
+			nil,
+			"",
+		},
+		{
+			"cross account peering should be accepted by the accepter provider in the peer region",
+			v1alpha2.VPCPeeringConnectionParameters{PeerOwnerID: "123456789012", PeerRegion: "eu-west-1", Region: "us-east-1", AccepterProviderReference: accepter},
+			accepter,
+			"eu-west-1",
+		},
+	} {
+		cr := &v1alpha2.VPCPeeringConnection{}
+		cr.Spec.ProviderReference = requester
+		cr.Spec.VPCPeeringConnectionParameters = tc.params
+
+		res, err := conn.Connect(context.Background(), cr)
+		g.Expect(err).To(gomega.BeNil(), tc.description)
+
+		e := res.(*external)
+		g.Expect(e.accepterFn == nil).To(gomega.Equal(tc.expectedAccepter == nil), tc.description)
+		if tc.expectedAccepter != nil {
+			_, err := e.accepterFn(context.Background())
+			g.Expect(err).To(gomega.BeNil(), tc.description)
+			g.Expect(usedRef).To(gomega.Equal(tc.expectedAccepter), tc.description)
+			g.Expect(usedRegion).To(gomega.Equal(tc.expectedRegion), tc.description)
+		}
+	}
+}
+
+func Test_Observe(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.VPCPeeringConnection{
+		Status: v1alpha2.VPCPeeringConnectionStatus{
+			VPCPeeringConnectionExternalStatus: v1alpha2.VPCPeeringConnectionExternalStatus{
+				VPCPeeringConnectionID: "some arbitrary id",
+			},
+		},
+	}
+
+	var mockClientErr error
+	var itemsList []awsec2.VpcPeeringConnection
+	mockClient.MockDescribeVpcPeeringConnectionsRequest = func(input *awsec2.DescribeVpcPeeringConnectionsInput) awsec2.DescribeVpcPeeringConnectionsRequest {
+		return awsec2.DescribeVpcPeeringConnectionsRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.DescribeVpcPeeringConnectionsOutput{
+					VpcPeeringConnections: itemsList,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	withCode := func(c awsec2.VpcPeeringConnectionStateReasonCode) []awsec2.VpcPeeringConnection {
+		return []awsec2.VpcPeeringConnection{{
+			VpcPeeringConnectionId: aws.String("some arbitrary id"),
+			Status:                 &awsec2.VpcPeeringConnectionStateReason{Code: c},
+		}}
+	}
+
+	accepterFn := func(context.Context) (ec2.VPCPeeringConnectionClient, error) { return &mockClient, nil }
+
+	for _, tc := range []struct {
+		description           string
+		managedObj            resource.Managed
+		accepterFn            func(context.Context) (ec2.VPCPeeringConnectionClient, error)
+		itemsReturned         []awsec2.VpcPeeringConnection
+		clientErr             error
+		expectedErrNil        bool
+		expectedResourceExist bool
+		expectedUpToDate      bool
+		expectedReason        corev1alpha1.ConditionReason
+	}{
+		{
+			"active peering connection should be available",
+			mockManaged.DeepCopy(),
+			accepterFn,
+			withCode(awsec2.VpcPeeringConnectionStateReasonCodeActive),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonAvailable,
+		},
+		{
+			"pending peering connection with a known accepter should need an update",
+			mockManaged.DeepCopy(),
+			accepterFn,
+			withCode(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance),
+			nil,
+			true,
+			true,
+			false,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"pending peering connection without a known accepter should be up to date",
+			mockManaged.DeepCopy(),
+			nil,
+			withCode(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonCreating,
+		},
+		{
+			"rejected peering connection should be unavailable",
+			mockManaged.DeepCopy(),
+			accepterFn,
+			withCode(awsec2.VpcPeeringConnectionStateReasonCodeRejected),
+			nil,
+			true,
+			true,
+			true,
+			corev1alpha1.ReasonUnavailable,
+		},
+		{
+			"deleted peering connection should not exist",
+			mockManaged.DeepCopy(),
+			accepterFn,
+			withCode(awsec2.VpcPeeringConnectionStateReasonCodeDeleted),
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if item's identifier is not yet set, returns expected",
+			&v1alpha2.VPCPeeringConnection{},
+			nil,
+			nil,
+			nil,
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource doesn't exist, it should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			awserr.New(ec2.VPCPeeringConnectionIDNotFound, "", nil),
+			true,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			errors.New("some error"),
+			false,
+			false,
+			false,
+			"",
+		},
+		{
+			"if external resource returns a list with other than one item, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			[]awsec2.VpcPeeringConnection{},
+			nil,
+			false,
+			false,
+			false,
+			"",
+		},
+	} {
+		mockClientErr = tc.clientErr
+		itemsList = tc.itemsReturned
+
+		e := external{client: &mockClient, accepterFn: tc.accepterFn}
+		result, err := e.Observe(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(result.ResourceExists).To(gomega.Equal(tc.expectedResourceExist), tc.description)
+		g.Expect(result.ResourceUpToDate).To(gomega.Equal(tc.expectedUpToDate), tc.description)
+		if tc.expectedResourceExist {
+			mgd := tc.managedObj.(*v1alpha2.VPCPeeringConnection)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(tc.expectedReason), tc.description)
+			g.Expect(mgd.Status.VPCPeeringConnectionID).To(gomega.Equal("some arbitrary id"), tc.description)
+		}
+	}
+}
+
+func Test_Create(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.VPCPeeringConnection{
+		Spec: v1alpha2.VPCPeeringConnectionSpec{
+			VPCPeeringConnectionParameters: v1alpha2.VPCPeeringConnectionParameters{
+				VPCID:       "arbitrary vpcId",
+				PeerVPCID:   "arbitrary peerVpcId",
+				PeerOwnerID: "arbitrary peerOwnerId",
+			},
+		},
+	}
+	mockExternal := &awsec2.VpcPeeringConnection{
+		VpcPeeringConnectionId: aws.String("some arbitrary id"),
+		Status: &awsec2.VpcPeeringConnectionStateReason{
+			Code: awsec2.VpcPeeringConnectionStateReasonCodeInitiatingRequest,
+		},
+	}
+	var mockClientErr error
+	var peerOwnerID string
+	mockClient.MockCreateVpcPeeringConnectionRequest = func(input *awsec2.CreateVpcPeeringConnectionInput) awsec2.CreateVpcPeeringConnectionRequest {
+		g.Expect(aws.StringValue(input.VpcId)).To(gomega.Equal(mockManaged.Spec.VPCID), "the passed parameters are not valid")
+		g.Expect(aws.StringValue(input.PeerVpcId)).To(gomega.Equal(mockManaged.Spec.PeerVPCID), "the passed parameters are not valid")
+		g.Expect(input.PeerRegion).To(gomega.BeNil(), "the passed parameters are not valid")
+		peerOwnerID = aws.StringValue(input.PeerOwnerId)
+		return awsec2.CreateVpcPeeringConnectionRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data: &awsec2.CreateVpcPeeringConnectionOutput{
+					VpcPeeringConnection: mockExternal,
+				},
+				Error: mockClientErr,
+			},
+		}
+	}
+
+	withAccepter := mockManaged.DeepCopy()
+	withAccepter.Spec.PeerOwnerID = ""
+	withAccepter.Spec.AccepterProviderReference = &corev1.ObjectReference{Name: "accepter"}
+	accepterProvider := func(accountID string) client.Reader {
+		return &test.MockClient{MockGet: func(_ context.Context, key client.ObjectKey, obj runtime.Object) error {
+			g.Expect(key.Name).To(gomega.Equal("accepter"))
+			obj.(*awsv1alpha2.Provider).Status.AccountID = accountID
+			return nil
+		}}
+	}
+
+	for _, tc := range []struct {
+		description         string
+		managedObj          resource.Managed
+		kube                client.Reader
+		clientErr           error
+		expectedErrNil      bool
+		expectedPeerOwnerID string
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			nil,
+			true,
+			mockManaged.Spec.PeerOwnerID,
+		},
+		{
+			"the peer owner should default to the account of the accepter provider",
+			withAccepter.DeepCopy(),
+			accepterProvider("123456789012"),
+			nil,
+			true,
+			"123456789012",
+		},
+		{
+			"if the account of the accepter provider is not known, it should return error",
+			withAccepter.DeepCopy(),
+			accepterProvider(""),
+			nil,
+			false,
+			"",
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			nil,
+			false,
+			"",
+		},
+		{
+			"if creating resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			nil,
+			errors.New("some error"),
+			false,
+			mockManaged.Spec.PeerOwnerID,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		peerOwnerID = ""
+
+		e := external{client: &mockClient, kube: tc.kube}
+		_, err := e.Create(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(peerOwnerID).To(gomega.Equal(tc.expectedPeerOwnerID), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.VPCPeeringConnection)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonCreating), tc.description)
+			g.Expect(mgd.Status.VPCPeeringConnectionID).To(gomega.Equal(aws.StringValue(mockExternal.VpcPeeringConnectionId)), tc.description)
+		}
+	}
+}
+
+func Test_Update(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	pending := v1alpha2.VPCPeeringConnection{
+		Status: v1alpha2.VPCPeeringConnectionStatus{
+			VPCPeeringConnectionExternalStatus: v1alpha2.VPCPeeringConnectionExternalStatus{
+				VPCPeeringConnectionID: "some arbitrary id",
+				StatusCode:             string(awsec2.VpcPeeringConnectionStateReasonCodePendingAcceptance),
+			},
+		},
+	}
+	active := pending.DeepCopy()
+	active.Status.StatusCode = string(awsec2.VpcPeeringConnectionStateReasonCodeActive)
+
+	var mockClientErr error
+	accepted := false
+	mockAccepter := &fake.MockVPCPeeringConnectionClient{
+		MockAcceptVpcPeeringConnectionRequest: func(input *awsec2.AcceptVpcPeeringConnectionInput) awsec2.AcceptVpcPeeringConnectionRequest {
+			g.Expect(aws.StringValue(input.VpcPeeringConnectionId)).To(gomega.Equal(pending.Status.VPCPeeringConnectionID), "the passed parameters are not valid")
+			accepted = true
+			return awsec2.AcceptVpcPeeringConnectionRequest{
+				Request: &aws.Request{
+					HTTPRequest: &http.Request{},
+					Data: &awsec2.AcceptVpcPeeringConnectionOutput{
+						VpcPeeringConnection: &awsec2.VpcPeeringConnection{
+							VpcPeeringConnectionId: aws.String("some arbitrary id"),
+							Status: &awsec2.VpcPeeringConnectionStateReason{
+								Code: awsec2.VpcPeeringConnectionStateReasonCodeProvisioning,
+							},
+						},
+					},
+					Error: mockClientErr,
+				},
+			}
+		},
+	}
+	accepterFn := func(context.Context) (ec2.VPCPeeringConnectionClient, error) { return mockAccepter, nil }
+	failingAccepterFn := func(context.Context) (ec2.VPCPeeringConnectionClient, error) {
+		return nil, errors.New("provider not available")
+	}
+
+	for _, tc := range []struct {
+		description      string
+		managedObj       resource.Managed
+		accepterFn       func(context.Context) (ec2.VPCPeeringConnectionClient, error)
+		clientErr        error
+		expectedErrNil   bool
+		expectedAccepted bool
+	}{
+		{
+			"pending peering connection should be accepted",
+			pending.DeepCopy(),
+			accepterFn,
+			nil,
+			true,
+			true,
+		},
+		{
+			"pending peering connection without a known accepter shouldn't be accepted",
+			pending.DeepCopy(),
+			nil,
+			nil,
+			true,
+			false,
+		},
+		{
+			"active peering connection shouldn't be accepted",
+			active.DeepCopy(),
+			accepterFn,
+			nil,
+			true,
+			false,
+		},
+		{
+			"if the accepter is not available, it should return error",
+			pending.DeepCopy(),
+			failingAccepterFn,
+			nil,
+			false,
+			false,
+		},
+		{
+			"if accepting fails, it should return error",
+			pending.DeepCopy(),
+			accepterFn,
+			errors.New("some error"),
+			false,
+			true,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			accepterFn,
+			nil,
+			false,
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+		accepted = false
+
+		e := external{client: &mockClient, accepterFn: tc.accepterFn}
+		_, err := e.Update(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		g.Expect(accepted).To(gomega.Equal(tc.expectedAccepted), tc.description)
+		if tc.expectedErrNil && tc.expectedAccepted {
+			mgd := tc.managedObj.(*v1alpha2.VPCPeeringConnection)
+			g.Expect(mgd.Status.StatusCode).To(gomega.Equal(string(awsec2.VpcPeeringConnectionStateReasonCodeProvisioning)), tc.description)
+		}
+	}
+}
+
+func Test_Delete(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	mockManaged := v1alpha2.VPCPeeringConnection{
+		Status: v1alpha2.VPCPeeringConnectionStatus{
+			VPCPeeringConnectionExternalStatus: v1alpha2.VPCPeeringConnectionExternalStatus{
+				VPCPeeringConnectionID: "some arbitrary id",
+			},
+		},
+	}
+	var mockClientErr error
+	mockClient.MockDeleteVpcPeeringConnectionRequest = func(input *awsec2.DeleteVpcPeeringConnectionInput) awsec2.DeleteVpcPeeringConnectionRequest {
+		g.Expect(aws.StringValue(input.VpcPeeringConnectionId)).To(gomega.Equal(mockManaged.Status.VPCPeeringConnectionID), "the passed parameters are not valid")
+		return awsec2.DeleteVpcPeeringConnectionRequest{
+			Request: &aws.Request{
+				HTTPRequest: &http.Request{},
+				Data:        &awsec2.DeleteVpcPeeringConnectionOutput{},
+				Error:       mockClientErr,
+			},
+		}
+	}
+
+	for _, tc := range []struct {
+		description    string
+		managedObj     resource.Managed
+		clientErr      error
+		expectedErrNil bool
+	}{
+		{
+			"valid input should return expected",
+			mockManaged.DeepCopy(),
+			nil,
+			true,
+		},
+		{
+			"if status doesn't have the resource ID, it should return an error",
+			&v1alpha2.VPCPeeringConnection{},
+			nil,
+			false,
+		},
+		{
+			"unexpected managed resource should return error",
+			unexpecedItem,
+			nil,
+			false,
+		},
+		{
+			"if the resource doesn't exist deleting resource should not return an error",
+			mockManaged.DeepCopy(),
+			awserr.New(ec2.VPCPeeringConnectionIDNotFound, "", nil),
+			true,
+		},
+		{
+			"if deleting resource fails, it should return error",
+			mockManaged.DeepCopy(),
+			errors.New("some error"),
+			false,
+		},
+	} {
+		mockClientErr = tc.clientErr
+
+		err := mockExternalClient.Delete(context.Background(), tc.managedObj)
+
+		g.Expect(err == nil).To(gomega.Equal(tc.expectedErrNil), tc.description)
+		if tc.expectedErrNil {
+			mgd := tc.managedObj.(*v1alpha2.VPCPeeringConnection)
+			g.Expect(mgd.Status.Conditions[0].Type).To(gomega.Equal(corev1alpha1.TypeReady), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Status).To(gomega.Equal(corev1.ConditionFalse), tc.description)
+			g.Expect(mgd.Status.Conditions[0].Reason).To(gomega.Equal(corev1alpha1.ReasonDeleting), tc.description)
+		}
+	}
+}
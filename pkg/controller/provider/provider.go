@@ -0,0 +1,135 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssts "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/logging"
+	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplaneio/stack-aws/apis/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/sts"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
+)
+
+// Amounts of time we wait before requeuing a reconcile.
+const (
+	aShortWait = 30 * time.Second
+	aLongWait  = 5 * time.Minute
+
+	reconcileTimeout = 1 * time.Minute
+)
+
+// Error strings.
+const (
+	errGetProvider          = "cannot get provider"
+	errUpdateProviderStatus = "cannot update provider status"
+	errNewClient            = "cannot create new STS client"
+	errGetCallerIdentity    = "cannot get caller identity"
+)
+
+// Controller verifies the credentials of AWS Providers.
+type Controller struct{}
+
+// SetupWithManager creates a new Provider Controller and adds it to the
+// Manager with default RBAC. The Manager will set fields on the Controller and
+// start it when the Manager is Started.
+func (c *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	r := &Reconciler{
+		client:      mgr.GetClient(),
+		newClientFn: sts.NewClient,
+		awsConfigFn: utils.RetrieveAwsConfigFromProvider,
+	}
+
+	name := strings.ToLower(fmt.Sprintf("%s.%s", v1alpha2.ProviderKind, v1alpha2.Group))
+
+	// Provider status updates do not increment its generation, so filtering on
+	// generation changes prevents status updates from triggering a reconcile.
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&v1alpha2.Provider{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
+
+// A Reconciler periodically verifies the credentials of a Provider by calling
+// STS GetCallerIdentity, and reports the result in the Provider's status.
+type Reconciler struct {
+	client      client.Client
+	newClientFn func(*aws.Config) (sts.Client, error)
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+}
+
+// Reconcile a Provider by verifying its credentials.
+func (r *Reconciler) Reconcile(req reconcile.Request) (reconcile.Result, error) {
+	log := logging.Logger.WithName("controller.provider")
+	log.V(logging.Debug).Info("reconciling", "kind", v1alpha2.ProviderKindAPIVersion, "request", req)
+
+	ctx, cancel := context.WithTimeout(context.Background(), reconcileTimeout)
+	defer cancel()
+
+	p := &v1alpha2.Provider{}
+	if err := r.client.Get(ctx, req.NamespacedName, p); err != nil {
+		// There's no need to requeue if the provider no longer exists.
+		return reconcile.Result{}, errors.Wrap(resource.IgnoreNotFound(err), errGetProvider)
+	}
+
+	identity, err := r.verify(ctx, p)
+	if err != nil {
+		p.Status.SetConditions(runtimev1alpha1.Unavailable().WithMessage(err.Error()), runtimev1alpha1.ReconcileError(err))
+		return reconcile.Result{RequeueAfter: aShortWait}, errors.Wrap(r.client.Status().Update(ctx, p), errUpdateProviderStatus)
+	}
+
+	now := metav1.Now()
+	p.Status.AccountID = aws.StringValue(identity.Account)
+	p.Status.ARN = aws.StringValue(identity.Arn)
+	p.Status.LastVerifiedTime = &now
+	p.Status.SetConditions(runtimev1alpha1.Available(), runtimev1alpha1.ReconcileSuccess())
+	return reconcile.Result{RequeueAfter: aLongWait}, errors.Wrap(r.client.Status().Update(ctx, p), errUpdateProviderStatus)
+}
+
+func (r *Reconciler) verify(ctx context.Context, p *v1alpha2.Provider) (*awssts.GetCallerIdentityOutput, error) {
+	cfg, err := r.awsConfigFn(ctx, r.client, &corev1.ObjectReference{Namespace: p.GetNamespace(), Name: p.GetName()})
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := r.newClientFn(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	req := c.GetCallerIdentityRequest(&awssts.GetCallerIdentityInput{})
+	req.SetContext(ctx)
+	rsp, err := req.Send()
+	return rsp, errors.Wrap(err, errGetCallerIdentity)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2019 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awssts "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/test"
+
+	"github.com/crossplaneio/stack-aws/apis/v1alpha2"
+	"github.com/crossplaneio/stack-aws/pkg/clients/sts"
+	"github.com/crossplaneio/stack-aws/pkg/clients/sts/fake"
+)
+
+const (
+	namespace = "coolNamespace"
+	name      = "coolProvider"
+
+	accountID = "123456789012"
+	arn       = "arn:aws:iam::123456789012:user/cool"
+)
+
+var (
+	errBoom = errors.New("boom")
+	req     = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+)
+
+type providerModifier func(*v1alpha2.Provider)
+
+func withConditions(c ...runtimev1alpha1.Condition) providerModifier {
+	return func(p *v1alpha2.Provider) { p.Status.SetConditions(c...) }
+}
+
+func withIdentity(account, arn string) providerModifier {
+	return func(p *v1alpha2.Provider) {
+		p.Status.AccountID = account
+		p.Status.ARN = arn
+	}
+}
+
+func provider(m ...providerModifier) *v1alpha2.Provider {
+	p := &v1alpha2.Provider{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	for _, f := range m {
+		f(p)
+	}
+	return p
+}
+
+func TestReconcile(t *testing.T) {
+	type want struct {
+		result   reconcile.Result
+		err      error
+		provider *v1alpha2.Provider
+	}
+
+	cases := map[string]struct {
+		newClientFn func(*aws.Config) (sts.Client, error)
+		awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error)
+		getErr      error
+		updateErr   error
+		want        want
+	}{
+		"ProviderNotFound": {
+			getErr: kerrors.NewNotFound(schema.GroupResource{}, name),
+			want:   want{result: reconcile.Result{}},
+		},
+		"GetProviderFailed": {
+			getErr: errBoom,
+			want:   want{result: reconcile.Result{}, err: errors.Wrap(errBoom, errGetProvider)},
+		},
+		"LoadConfigFailed": {
+			awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+				return nil, errBoom
+			},
+			want: want{
+				result:   reconcile.Result{RequeueAfter: aShortWait},
+				provider: provider(withConditions(runtimev1alpha1.Unavailable().WithMessage(errBoom.Error()), runtimev1alpha1.ReconcileError(errBoom))),
+			},
+		},
+		"NewClientFailed": {
+			newClientFn: func(*aws.Config) (sts.Client, error) { return nil, errBoom },
+			want: want{
+				result: reconcile.Result{RequeueAfter: aShortWait},
+				provider: provider(withConditions(
+					runtimev1alpha1.Unavailable().WithMessage(errors.Wrap(errBoom, errNewClient).Error()),
+					runtimev1alpha1.ReconcileError(errors.Wrap(errBoom, errNewClient)),
+				)),
+			},
+		},
+		"GetCallerIdentityFailed": {
+			newClientFn: func(*aws.Config) (sts.Client, error) {
+				return &fake.MockClient{
+					MockGetCallerIdentityRequest: func(*awssts.GetCallerIdentityInput) awssts.GetCallerIdentityRequest {
+						return awssts.GetCallerIdentityRequest{Request: &aws.Request{HTTPRequest: &http.Request{}, Error: errBoom}}
+					},
+				}, nil
+			},
+			want: want{
+				result: reconcile.Result{RequeueAfter: aShortWait},
+				provider: provider(withConditions(
+					runtimev1alpha1.Unavailable().WithMessage(errors.Wrap(errBoom, errGetCallerIdentity).Error()),
+					runtimev1alpha1.ReconcileError(errors.Wrap(errBoom, errGetCallerIdentity)),
+				)),
+			},
+		},
+		"Verified": {
+			want: want{
+				result:   reconcile.Result{RequeueAfter: aLongWait},
+				provider: provider(withIdentity(accountID, arn), withConditions(runtimev1alpha1.Available(), runtimev1alpha1.ReconcileSuccess())),
+			},
+		},
+		"UpdateStatusFailed": {
+			updateErr: errBoom,
+			want: want{
+				result:   reconcile.Result{RequeueAfter: aLongWait},
+				err:      errors.Wrap(errBoom, errUpdateProviderStatus),
+				provider: provider(withIdentity(accountID, arn), withConditions(runtimev1alpha1.Available(), runtimev1alpha1.ReconcileSuccess())),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var updated *v1alpha2.Provider
+
+			r := &Reconciler{
+				client: &test.MockClient{
+					MockGet: func(_ context.Context, _ client.ObjectKey, obj runtime.Object) error {
+						if tc.getErr != nil {
+							return tc.getErr
+						}
+						*obj.(*v1alpha2.Provider) = *provider()
+						return nil
+					},
+					MockStatusUpdate: func(_ context.Context, obj runtime.Object, _ ...client.UpdateOption) error {
+						updated = obj.(*v1alpha2.Provider)
+						return tc.updateErr
+					},
+				},
+				newClientFn: func(*aws.Config) (sts.Client, error) {
+					return &fake.MockClient{
+						MockGetCallerIdentityRequest: func(*awssts.GetCallerIdentityInput) awssts.GetCallerIdentityRequest {
+							return awssts.GetCallerIdentityRequest{Request: &aws.Request{
+								HTTPRequest: &http.Request{},
+								Data:        &awssts.GetCallerIdentityOutput{Account: aws.String(accountID), Arn: aws.String(arn)},
+							}}
+						},
+					}, nil
+				},
+				awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+					return &aws.Config{}, nil
+				},
+			}
+			if tc.newClientFn != nil {
+				r.newClientFn = tc.newClientFn
+			}
+			if tc.awsConfigFn != nil {
+				r.awsConfigFn = tc.awsConfigFn
+			}
+
+			result, err := r.Reconcile(req)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("r.Reconcile(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.result, result); diff != "" {
+				t.Errorf("r.Reconcile(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.provider, updated, test.EquateConditions(), cmpopts.IgnoreFields(v1alpha2.ProviderStatus{}, "LastVerifiedTime")); diff != "" {
+				t.Errorf("r.Reconcile(...): -want provider, +got provider:\n%s", diff)
+			}
+			if updated != nil && updated.Status.AccountID != "" && updated.Status.LastVerifiedTime == nil {
+				t.Errorf("r.Reconcile(...): want LastVerifiedTime to be set")
+			}
+		})
+	}
+}
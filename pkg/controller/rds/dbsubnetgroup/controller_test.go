@@ -66,7 +66,7 @@ func Test_Connect(t *testing.T) {
 		newClientFn: func(conf *aws.Config) (rds.DBSubnetGroupClient, error) {
 			return &mockClient, clientErr
 		},
-		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference) (*aws.Config, error) {
+		awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
 			return &aws.Config{}, configErr
 		},
 	}
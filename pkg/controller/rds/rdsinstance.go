@@ -18,27 +18,19 @@ package rds
 
 import (
 	"context"
-	"fmt"
-	"time"
+	"reflect"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
-	kerrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/client-go/kubernetes"
-	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	databasev1alpha2 "github.com/crossplaneio/stack-aws/apis/database/v1alpha2"
-	awsv1alpha2 "github.com/crossplaneio/stack-aws/apis/v1alpha2"
-	aws "github.com/crossplaneio/stack-aws/pkg/clients"
 	"github.com/crossplaneio/stack-aws/pkg/clients/rds"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
 
 	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
-	"github.com/crossplaneio/crossplane-runtime/pkg/logging"
 	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
 	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
 	"github.com/crossplaneio/crossplane-runtime/pkg/util"
@@ -46,40 +38,26 @@ import (
 
 const (
 	controllerName = "rds.aws.crossplane.io"
-	finalizer      = "finalizer." + controllerName
-)
 
-// Amounts of time we wait before requeuing a reconcile.
-const (
-	aLongWait = 60 * time.Second
+	// legacyFinalizer was added to RDSInstances by the RDSInstance controller
+	// before it used the managed reconciler, which adds its own.
+	legacyFinalizer = "finalizer." + controllerName
 )
 
+// Length of the generated master password.
+const passwordLength = 20
+
 // Error strings
 const (
-	errUpdateManagedStatus = "cannot update managed resource status"
+	errNotRDSInstance   = "managed resource is not an RDSInstance"
+	errUpdateManaged    = "cannot update RDSInstance custom resource"
+	errGetInstance      = "cannot get RDS instance"
+	errGeneratePassword = "cannot generate a password for the RDS instance"
+	errCreateInstance   = "cannot create RDS instance"
+	errModifyInstance   = "cannot modify RDS instance"
+	errDeleteInstance   = "cannot delete RDS instance"
 )
 
-var (
-	log           = logging.Logger.WithName("controller." + controllerName)
-	ctx           = context.Background()
-	result        = reconcile.Result{}
-	resultRequeue = reconcile.Result{Requeue: true}
-)
-
-// Reconciler reconciles a Instance object
-type Reconciler struct {
-	client.Client
-	scheme     *runtime.Scheme
-	kubeclient kubernetes.Interface
-	recorder   record.EventRecorder
-	resource.ManagedReferenceResolver
-
-	connect func(*databasev1alpha2.RDSInstance) (rds.Client, error)
-	create  func(*databasev1alpha2.RDSInstance, rds.Client) (reconcile.Result, error)
-	sync    func(*databasev1alpha2.RDSInstance, rds.Client) (reconcile.Result, error)
-	delete  func(*databasev1alpha2.RDSInstance, rds.Client) (reconcile.Result, error)
-}
-
 // InstanceController is responsible for adding the RDSInstance
 // controller and its corresponding reconciler to the manager with any runtime configuration.
 type InstanceController struct{}
@@ -87,195 +65,181 @@ type InstanceController struct{}
 // SetupWithManager creates a new Controller and adds it to the Manager with default RBAC. The Manager will set fields on the Controller
 // and Start it when the Manager is Started.
 func (c *InstanceController) SetupWithManager(mgr ctrl.Manager) error {
-	r := &Reconciler{
-		Client:                   mgr.GetClient(),
-		scheme:                   mgr.GetScheme(),
-		kubeclient:               kubernetes.NewForConfigOrDie(mgr.GetConfig()),
-		recorder:                 mgr.GetEventRecorderFor(controllerName),
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(mgr.GetClient()),
-	}
-	r.connect = r._connect
-	r.create = r._create
-	r.sync = r._sync
-	r.delete = r._delete
+	r := resource.NewManagedReconciler(mgr,
+		resource.ManagedKind(databasev1alpha2.RDSInstanceGroupVersionKind),
+		resource.WithExternalConnecter(&connector{
+			client:      mgr.GetClient(),
+			newClientFn: rds.NewClient,
+			awsConfigFn: utils.RetrieveAwsConfigFromProviderInRegion,
+		}),
+		resource.WithManagedInitializers(
+			&legacyInstanceNameAdopter{client: mgr.GetClient()},
+			resource.NewManagedNameAsExternalName(mgr.GetClient()),
+			resource.NewAPIManagedFinalizerAdder(mgr.GetClient()),
+			&legacyFinalizerRemover{client: mgr.GetClient()},
+		))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("instance-controller").
 		For(&databasev1alpha2.RDSInstance{}).
-		Owns(&corev1.Secret{}).
 		Complete(r)
 }
 
-// fail - helper function to set fail condition with reason and message
-func (r *Reconciler) fail(instance *databasev1alpha2.RDSInstance, err error) (reconcile.Result, error) {
-	instance.Status.SetConditions(runtimev1alpha1.ReconcileError(err))
-	return reconcile.Result{Requeue: true}, r.Update(context.TODO(), instance)
+// A legacyInstanceNameAdopter uses the name of an RDS instance that was
+// created before the RDSInstance controller used the managed reconciler as the
+// external name of its RDSInstance. Such instances are named after the engine
+// and the UID of their RDSInstance rather than after its name.
+type legacyInstanceNameAdopter struct{ client client.Client }
+
+// Initialize the external name of the supplied RDSInstance.
+func (a *legacyInstanceNameAdopter) Initialize(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*databasev1alpha2.RDSInstance)
+	if !ok {
+		return errors.New(errNotRDSInstance)
+	}
+	if meta.GetExternalName(cr) != "" || cr.Status.InstanceName == "" {
+		return nil
+	}
+	meta.SetExternalName(cr, cr.Status.InstanceName)
+	return errors.Wrap(a.client.Update(ctx, cr), errUpdateManaged)
 }
 
-// connectionSecret return secret object for this resource
-func connectionSecret(instance *databasev1alpha2.RDSInstance, password string) *corev1.Secret {
-	s := resource.ConnectionSecretFor(instance, databasev1alpha2.RDSInstanceGroupVersionKind)
-	s.Data = map[string][]byte{
-		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(instance.Spec.MasterUsername),
-		runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(password),
+// A legacyFinalizerRemover removes the finalizer that the RDSInstance
+// controller added before it used the managed reconciler, which would
+// otherwise block the deletion of the RDSInstance forever.
+type legacyFinalizerRemover struct{ client client.Client }
+
+// Initialize the finalizers of the supplied RDSInstance.
+func (r *legacyFinalizerRemover) Initialize(ctx context.Context, mg resource.Managed) error {
+	if !meta.FinalizerExists(mg, legacyFinalizer) {
+		return nil
 	}
-	return s
+	meta.RemoveFinalizer(mg, legacyFinalizer)
+	return errors.Wrap(r.client.Update(ctx, mg), errUpdateManaged)
 }
 
-func (r *Reconciler) _connect(instance *databasev1alpha2.RDSInstance) (rds.Client, error) {
-	// Fetch AWS Provider
-	p := &awsv1alpha2.Provider{}
-	err := r.Get(ctx, meta.NamespacedNameOf(instance.Spec.ProviderReference), p)
-	if err != nil {
-		return nil, err
+type connector struct {
+	client      client.Client
+	newClientFn func(*aws.Config) rds.Client
+	awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (resource.ExternalClient, error) {
+	cr, ok := mg.(*databasev1alpha2.RDSInstance)
+	if !ok {
+		return nil, errors.New(errNotRDSInstance)
 	}
 
-	// Get Provider's AWS Config
-	config, err := aws.Config(r.kubeclient, p)
+	cfg, err := c.awsConfigFn(ctx, c.client, cr.Spec.ProviderReference, cr.Spec.Region)
 	if err != nil {
 		return nil, err
 	}
-
-	// Create new RDS RDSClient
-	return rds.NewClient(config), nil
+	return &external{client: c.newClientFn(cfg), kube: c.client}, nil
 }
 
-func (r *Reconciler) _create(instance *databasev1alpha2.RDSInstance, client rds.Client) (reconcile.Result, error) {
-	instance.Status.SetConditions(runtimev1alpha1.Creating())
-	resourceName := fmt.Sprintf("%s-%s", instance.Spec.Engine, instance.UID)
+type external struct {
+	client rds.Client
+	kube   client.Client
+}
 
-	// generate new password
-	password, err := util.GeneratePassword(20)
-	if err != nil {
-		return r.fail(instance, err)
+func (e *external) Observe(ctx context.Context, mg resource.Managed) (resource.ExternalObservation, error) {
+	cr, ok := mg.(*databasev1alpha2.RDSInstance)
+	if !ok {
+		return resource.ExternalObservation{}, errors.New(errNotRDSInstance)
 	}
 
-	_, err = util.ApplySecret(r.kubeclient, connectionSecret(instance, password))
+	instance, err := e.client.GetInstance(meta.GetExternalName(cr))
 	if err != nil {
-		return r.fail(instance, err)
+		return resource.ExternalObservation{ResourceExists: false}, errors.Wrap(resource.Ignore(rds.IsErrorNotFound, err), errGetInstance)
 	}
 
-	// Create DB Instance
-	_, err = client.CreateInstance(resourceName, password, &instance.Spec)
-	if err != nil && !rds.IsErrorAlreadyExists(err) {
-		return r.fail(instance, err)
+	current := cr.Spec.RDSInstanceParameters.DeepCopy()
+	rds.LateInitialize(&cr.Spec.RDSInstanceParameters, instance)
+	if !reflect.DeepEqual(current, &cr.Spec.RDSInstanceParameters) {
+		if err := e.kube.Update(ctx, cr); err != nil {
+			return resource.ExternalObservation{}, errors.Wrap(err, errUpdateManaged)
+		}
 	}
 
-	instance.Status.InstanceName = resourceName
-	meta.AddFinalizer(instance, finalizer)
-	instance.Status.SetConditions(runtimev1alpha1.ReconcileSuccess())
-
-	return resultRequeue, r.Update(ctx, instance)
-}
-
-func (r *Reconciler) _sync(instance *databasev1alpha2.RDSInstance, client rds.Client) (reconcile.Result, error) {
-	// Search for the RDS instance in AWS
-	db, err := client.GetInstance(instance.Status.InstanceName)
-	if err != nil {
-		return r.fail(instance, err)
-	}
+	cr.Status.InstanceName = instance.Name
+	cr.Status.State = instance.Status
+	cr.Status.ProviderID = instance.ARN
+	cr.Status.Endpoint = instance.Endpoint
 
-	instance.Status.State = db.Status
-
-	switch db.Status {
-	case string(databasev1alpha2.RDSInstanceStateCreating):
-		instance.Status.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileSuccess())
-		return resultRequeue, r.Update(ctx, instance)
-	case string(databasev1alpha2.RDSInstanceStateFailed):
-		instance.Status.SetConditions(runtimev1alpha1.Unavailable(), runtimev1alpha1.ReconcileSuccess())
-		return result, r.Update(ctx, instance)
-	case string(databasev1alpha2.RDSInstanceStateAvailable):
-		instance.Status.SetConditions(runtimev1alpha1.Available())
-		resource.SetBindable(instance)
+	switch databasev1alpha2.RDSInstanceState(instance.Status) {
+	case databasev1alpha2.RDSInstanceStateAvailable:
+		cr.Status.SetConditions(runtimev1alpha1.Available())
+		resource.SetBindable(cr)
+	case databasev1alpha2.RDSInstanceStateCreating:
+		cr.Status.SetConditions(runtimev1alpha1.Creating())
+	case databasev1alpha2.RDSInstanceStateDeleting:
+		cr.Status.SetConditions(runtimev1alpha1.Deleting())
 	default:
-		return r.fail(instance, errors.Errorf("unexpected resource status: %s", db.Status))
+		cr.Status.SetConditions(runtimev1alpha1.Unavailable())
 	}
 
-	// Retrieve connection secret that was created during resource create phase
-	connSecret, err := r.kubeclient.CoreV1().
-		Secrets(instance.GetNamespace()).
-		Get(instance.GetWriteConnectionSecretToReference().Name, metav1.GetOptions{})
-	if err != nil {
-		return r.fail(instance, err)
+	details := resource.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretUserKey: []byte(cr.Spec.MasterUsername),
 	}
-
-	// Save resource endpoint
-	instance.Status.Endpoint = db.Endpoint
-	instance.Status.ProviderID = db.ARN
-
-	// Update resource secret
-	connSecret.Data[runtimev1alpha1.ResourceCredentialsSecretEndpointKey] = []byte(db.Endpoint)
-	_, err = util.ApplySecret(r.kubeclient, connSecret)
-	if err != nil {
-		return r.fail(instance, err)
+	if instance.Endpoint != "" {
+		details[runtimev1alpha1.ResourceCredentialsSecretEndpointKey] = []byte(instance.Endpoint)
 	}
 
-	instance.Status.SetConditions(runtimev1alpha1.ReconcileSuccess())
-	return result, r.Update(ctx, instance)
+	// An RDS instance can only be modified while it is available, so one that
+	// is busy is considered up to date until it becomes available again.
+	return resource.ExternalObservation{
+		ResourceExists:    true,
+		ResourceUpToDate:  instance.Status != string(databasev1alpha2.RDSInstanceStateAvailable) || !rds.NeedsUpdate(cr.Spec.RDSInstanceParameters, instance),
+		ConnectionDetails: details,
+	}, nil
 }
 
-func (r *Reconciler) _delete(instance *databasev1alpha2.RDSInstance, client rds.Client) (reconcile.Result, error) {
-	instance.Status.SetConditions(runtimev1alpha1.Deleting())
-
-	if instance.Spec.ReclaimPolicy == runtimev1alpha1.ReclaimDelete {
-		if _, err := client.DeleteInstance(instance.Status.InstanceName); err != nil && !rds.IsErrorNotFound(err) {
-			return r.fail(instance, err)
-		}
+func (e *external) Create(ctx context.Context, mg resource.Managed) (resource.ExternalCreation, error) {
+	cr, ok := mg.(*databasev1alpha2.RDSInstance)
+	if !ok {
+		return resource.ExternalCreation{}, errors.New(errNotRDSInstance)
 	}
 
-	meta.RemoveFinalizer(instance, finalizer)
-	instance.Status.SetConditions(runtimev1alpha1.ReconcileSuccess())
-	return result, r.Update(ctx, instance)
-}
-
-// Reconcile reads that state of the cluster for a Instance object and makes changes based on the state read
-// and what is in the Instance.Spec
-func (r *Reconciler) Reconcile(request reconcile.Request) (reconcile.Result, error) {
-	log.V(logging.Debug).Info("reconciling", "kind", databasev1alpha2.RDSInstanceKindAPIVersion, "request", request)
-	// Fetch the CRD instance
-	instance := &databasev1alpha2.RDSInstance{}
-
-	err := r.Get(ctx, request.NamespacedName, instance)
+	cr.Status.SetConditions(runtimev1alpha1.Creating())
+	password, err := util.GeneratePassword(passwordLength)
 	if err != nil {
-		if kerrors.IsNotFound(err) {
-			// Object not found, return.  Created objects are automatically garbage collected.
-			// For additional cleanup logic use finalizers.
-			return reconcile.Result{}, nil
-		}
-		log.Error(err, "failed to get object at start of reconcile loop")
-		return reconcile.Result{}, err
+		return resource.ExternalCreation{}, errors.Wrap(err, errGeneratePassword)
 	}
 
-	rdsClient, err := r.connect(instance)
-	if err != nil {
-		return r.fail(instance, err)
+	// The password of an instance that already exists is unknown, so we don't
+	// publish the one we generated for it.
+	if _, err := e.client.CreateInstance(meta.GetExternalName(cr), password, &cr.Spec); err != nil {
+		return resource.ExternalCreation{}, errors.Wrap(resource.Ignore(rds.IsErrorAlreadyExists, err), errCreateInstance)
 	}
 
-	if !resource.IsConditionTrue(instance.GetCondition(runtimev1alpha1.TypeReferencesResolved)) {
-		if err := r.ResolveReferences(ctx, instance); err != nil {
-			condition := runtimev1alpha1.ReconcileError(err)
-			if resource.IsReferencesAccessError(err) {
-				condition = runtimev1alpha1.ReferenceResolutionBlocked(err)
-			}
-
-			instance.Status.SetConditions(condition)
-			return reconcile.Result{RequeueAfter: aLongWait}, errors.Wrap(r.Update(ctx, instance), errUpdateManagedStatus)
-		}
+	return resource.ExternalCreation{
+		ConnectionDetails: resource.ConnectionDetails{
+			runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(cr.Spec.MasterUsername),
+			runtimev1alpha1.ResourceCredentialsSecretPasswordKey: []byte(password),
+		},
+	}, nil
+}
 
-		// Add ReferenceResolutionSuccess to the conditions
-		instance.Status.SetConditions(runtimev1alpha1.ReferenceResolutionSuccess())
+func (e *external) Update(ctx context.Context, mg resource.Managed) (resource.ExternalUpdate, error) {
+	cr, ok := mg.(*databasev1alpha2.RDSInstance)
+	if !ok {
+		return resource.ExternalUpdate{}, errors.New(errNotRDSInstance)
 	}
 
-	// Check for deletion
-	if instance.DeletionTimestamp != nil {
-		return r.delete(instance, rdsClient)
-	}
+	_, err := e.client.ModifyInstance(meta.GetExternalName(cr), &cr.Spec)
+	return resource.ExternalUpdate{}, errors.Wrap(err, errModifyInstance)
+}
 
-	// Create cluster instance
-	if instance.Status.InstanceName == "" {
-		return r.create(instance, rdsClient)
+func (e *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*databasev1alpha2.RDSInstance)
+	if !ok {
+		return errors.New(errNotRDSInstance)
 	}
 
-	// Sync cluster instance status with cluster status
-	return r.sync(instance, rdsClient)
+	cr.Status.SetConditions(runtimev1alpha1.Deleting())
+	if cr.Status.State == string(databasev1alpha2.RDSInstanceStateDeleting) {
+		return nil
+	}
+	_, err := e.client.DeleteInstance(meta.GetExternalName(cr))
+	return errors.Wrap(resource.Ignore(rds.IsErrorNotFound, err), errDeleteInstance)
 }
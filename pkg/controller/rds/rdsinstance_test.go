@@ -17,30 +17,29 @@ limitations under the License.
 package rds
 
 import (
+	"context"
 	"testing"
 
-	"github.com/crossplaneio/stack-aws/apis"
-
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/awserr"
+	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/google/go-cmp/cmp"
-	. "github.com/onsi/gomega"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	. "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
-	. "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	. "sigs.k8s.io/controller-runtime/pkg/client/fake"
-	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
-	"github.com/crossplaneio/stack-aws/apis/database/v1alpha2"
+	"github.com/crossplaneio/stack-aws/apis"
 	. "github.com/crossplaneio/stack-aws/apis/database/v1alpha2"
-	awsv1alpha2 "github.com/crossplaneio/stack-aws/apis/v1alpha2"
+	storagev1alpha2 "github.com/crossplaneio/stack-aws/apis/storage/v1alpha2"
 	"github.com/crossplaneio/stack-aws/pkg/clients/rds"
 	. "github.com/crossplaneio/stack-aws/pkg/clients/rds/fake"
 
 	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
+	"github.com/crossplaneio/crossplane-runtime/pkg/meta"
 	"github.com/crossplaneio/crossplane-runtime/pkg/resource"
 	"github.com/crossplaneio/crossplane-runtime/pkg/test"
 )
@@ -54,443 +53,467 @@ const (
 	engine         = "mysql"
 	class          = "db.t2.small"
 	size           = int64(10)
+	endpoint       = "test-instance.rds.amazonaws.com"
 )
 
 var (
-	key = types.NamespacedName{
-		Namespace: namespace,
-		Name:      instanceName,
-	}
-	request = reconcile.Request{
-		NamespacedName: key,
-	}
+	ctx       = context.Background()
+	errorBoom = errors.New("boom")
+
+	errNotFound      = awserr.New(awsrds.ErrCodeDBInstanceNotFoundFault, "", nil)
+	errAlreadyExists = awserr.New(awsrds.ErrCodeDBInstanceAlreadyExistsFault, "", nil)
 )
 
+var _ resource.ExternalConnecter = &connector{}
+var _ resource.ExternalClient = &external{}
+
 func init() {
 	if err := apis.AddToScheme(scheme.Scheme); err != nil {
 		panic(err)
 	}
 }
 
-func testProvider() *awsv1alpha2.Provider {
-	return &awsv1alpha2.Provider{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      providerName,
-			Namespace: namespace,
-		},
+type instanceModifier func(*RDSInstance)
+
+func withConditions(c ...runtimev1alpha1.Condition) instanceModifier {
+	return func(cr *RDSInstance) { cr.Status.SetConditions(c...) }
+}
+
+func withBindingPhase(p runtimev1alpha1.BindingPhase) instanceModifier {
+	return func(cr *RDSInstance) { cr.Status.SetBindingPhase(p) }
+}
+
+func withState(s RDSInstanceState) instanceModifier {
+	return func(cr *RDSInstance) { cr.Status.State = string(s) }
+}
+
+func withObservedInstance(i *rds.Instance) instanceModifier {
+	return func(cr *RDSInstance) {
+		cr.Status.InstanceName = i.Name
+		cr.Status.State = i.Status
+		cr.Status.ProviderID = i.ARN
+		cr.Status.Endpoint = i.Endpoint
 	}
 }
 
-func testResource() *RDSInstance {
-	return &RDSInstance{
+func withEngineVersion(v string) instanceModifier {
+	return func(cr *RDSInstance) { cr.Spec.EngineVersion = v }
+}
+
+func withClass(c string) instanceModifier {
+	return func(cr *RDSInstance) { cr.Spec.Class = c }
+}
+
+func testResource(m ...instanceModifier) *RDSInstance {
+	cr := &RDSInstance{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      instanceName,
 			Namespace: namespace,
 		},
 		Spec: RDSInstanceSpec{
 			ResourceSpec: runtimev1alpha1.ResourceSpec{
-				ProviderReference: &corev1.ObjectReference{},
+				ProviderReference: &corev1.ObjectReference{Name: providerName},
 			},
-			RDSInstanceParameters: v1alpha2.RDSInstanceParameters{
-				MasterUsername: masterUserName,
-				Engine:         engine,
-				Class:          class,
-				Size:           size,
+			RDSInstanceParameters: RDSInstanceParameters{
+				MasterUsername:    masterUserName,
+				Engine:            engine,
+				EngineVersion:     "5.6",
+				Class:             class,
+				Size:              size,
+				DBSubnetGroupName: "default",
 			},
 		},
 	}
+	meta.SetExternalName(cr, instanceName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
 }
 
-// assertResource a helper function to check on cluster and its status
-func assertResource(g *GomegaWithT, r *Reconciler, s runtimev1alpha1.ConditionedStatus) *RDSInstance {
-	resource := &RDSInstance{}
-	err := r.Get(ctx, key, resource)
-	g.Expect(err).To(BeNil())
-	g.Expect(cmp.Diff(s, resource.Status.ConditionedStatus, test.EquateConditions())).Should(BeZero())
-	return resource
+func instanceWithStatus(s RDSInstanceState) *rds.Instance {
+	i := &rds.Instance{
+		Name:              instanceName,
+		ARN:               "test-arn",
+		Status:            string(s),
+		Class:             class,
+		Size:              size,
+		EngineVersion:     "5.6.41",
+		DBSubnetGroupName: "default",
+	}
+	if s == RDSInstanceStateAvailable {
+		i.Endpoint = endpoint
+	}
+	return i
 }
 
-func TestSyncClusterError(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	assert := func(instance *RDSInstance, client rds.Client, expectedResult reconcile.Result, expectedStatus runtimev1alpha1.ConditionedStatus) {
-		r := &Reconciler{
-			Client:                   NewFakeClient(instance),
-			kubeclient:               NewSimpleClientset(),
-			ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient(instance)),
-		}
-
-		rs, err := r._sync(instance, client)
-
-		g.Expect(err).NotTo(HaveOccurred())
-		g.Expect(rs).To(Equal(expectedResult))
-		assertResource(g, r, expectedStatus)
+func TestConnect(t *testing.T) {
+	type args struct {
+		awsConfigFn func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error)
+		cr          resource.Managed
 	}
 
-	// get error
-	testError := errors.New("test-resource-retrieve-error")
-	cl := &MockRDSClient{
-		MockGetInstance: func(s string) (instance *rds.Instance, e error) {
-			return nil, testError
-		},
-	}
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(testError))
-	assert(testResource(), cl, resultRequeue, expectedStatus)
-
-	// instance is not ready
-	cl = &MockRDSClient{
-		MockGetInstance: func(s string) (instance *rds.Instance, e error) {
-			return &rds.Instance{
-				Status: string(RDSInstanceStateCreating),
-			}, nil
+	cases := map[string]struct {
+		args args
+		want error
+	}{
+		"Successful": {
+			args: args{
+				awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+					return &aws.Config{}, nil
+				},
+				cr: testResource(),
+			},
 		},
-	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileSuccess())
-	assert(testResource(), cl, resultRequeue, expectedStatus)
-
-	// instance in failed state
-	cl = &MockRDSClient{
-		MockGetInstance: func(s string) (instance *rds.Instance, e error) {
-			return &rds.Instance{
-				Status: string(RDSInstanceStateFailed),
-			}, nil
+		"NotRDSInstance": {
+			args: args{cr: &storagev1alpha2.DBSubnetGroup{}},
+			want: errors.New(errNotRDSInstance),
 		},
-	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Unavailable(), runtimev1alpha1.ReconcileSuccess())
-	assert(testResource(), cl, result, expectedStatus)
-
-	// instance is in deleting state
-	cl = &MockRDSClient{
-		MockGetInstance: func(s string) (instance *rds.Instance, e error) {
-			return &rds.Instance{
-				Status: string(RDSInstanceStateDeleting),
-			}, nil
+		"AWSConfigFailed": {
+			args: args{
+				awsConfigFn: func(context.Context, client.Reader, *corev1.ObjectReference, string) (*aws.Config, error) {
+					return nil, errorBoom
+				},
+				cr: testResource(),
+			},
+			want: errorBoom,
 		},
 	}
 
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(
-		runtimev1alpha1.ReconcileError(errors.Errorf("unexpected resource status: %s", RDSInstanceStateDeleting)),
-	)
-	assert(testResource(), cl, resultRequeue, expectedStatus)
-
-	// failed to retrieve instance secret
-	cl = &MockRDSClient{
-		MockGetInstance: func(s string) (instance *rds.Instance, e error) {
-			return &rds.Instance{
-				Status: string(RDSInstanceStateAvailable),
-			}, nil
-		},
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			c := &connector{
+				newClientFn: func(*aws.Config) rds.Client { return &MockRDSClient{} },
+				awsConfigFn: tc.args.awsConfigFn,
+			}
+			_, err := c.Connect(ctx, tc.args.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("c.Connect(...): -want error, +got error:\n%s", diff)
+			}
+		})
 	}
-
-	tr := testResource()
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(
-		runtimev1alpha1.Available(),
-		runtimev1alpha1.ReconcileError(errors.Errorf("secrets \"%s\" not found", tr.GetWriteConnectionSecretToReference().Name)),
-	)
-	assert(tr, cl, resultRequeue, expectedStatus)
-
 }
 
-func TestSyncClusterUpdateSecretFailure(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	tr := testResource()
-	ts := connectionSecret(tr, "testPassword")
-
-	testError := errors.New("test-error-create-secret")
-	kc := NewSimpleClientset(ts)
-	kc.PrependReactor("update", "secrets", func(Action) (handled bool, ret runtime.Object, err error) {
-		return true, nil, testError
+func TestInitialize(t *testing.T) {
+	legacy := testResource(func(cr *RDSInstance) {
+		meta.SetExternalName(cr, "")
+		cr.Status.InstanceName = "mysql-test-uid"
+		cr.Finalizers = []string{legacyFinalizer}
 	})
 
-	r := &Reconciler{
-		Client:                   NewFakeClient(tr),
-		kubeclient:               kc,
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient(tr)),
+	kube := NewFakeClient(legacy)
+	i := resource.InitializerChain{&legacyInstanceNameAdopter{client: kube}, &legacyFinalizerRemover{client: kube}}
+	if err := i.Initialize(ctx, legacy); err != nil {
+		t.Fatalf("i.Initialize(...): %s", err)
 	}
 
-	called := false
-	cl := &MockRDSClient{
-		MockGetInstance: func(s string) (instance *rds.Instance, e error) {
-			called = true
-			return &rds.Instance{
-				Status: string(RDSInstanceStateAvailable),
-			}, nil
-		},
+	got := &RDSInstance{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: namespace, Name: instanceName}, got); err != nil {
+		t.Fatalf("kube.Get(...): %s", err)
 	}
-
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Available(), runtimev1alpha1.ReconcileError(testError))
-
-	rs, err := r._sync(tr, cl)
-	g.Expect(rs).To(Equal(resultRequeue))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, expectedStatus)
-}
-
-func TestSyncCluster(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	tr := testResource()
-	ts := connectionSecret(tr, "testPassword")
-
-	r := &Reconciler{
-		Client:                   NewFakeClient(tr),
-		kubeclient:               NewSimpleClientset(ts),
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient(tr)),
+	if diff := cmp.Diff("mysql-test-uid", meta.GetExternalName(got)); diff != "" {
+		t.Errorf("i.Initialize(...): -want external name, +got external name:\n%s", diff)
 	}
-
-	called := false
-	cl := &MockRDSClient{
-		MockGetInstance: func(s string) (instance *rds.Instance, e error) {
-			called = true
-			return &rds.Instance{
-				Status: string(RDSInstanceStateAvailable),
-			}, nil
-		},
+	if meta.FinalizerExists(got, legacyFinalizer) {
+		t.Errorf("i.Initialize(...): want legacy finalizer removed, got %v", got.Finalizers)
 	}
-
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Available(), runtimev1alpha1.ReconcileSuccess())
-
-	rs, err := r._sync(tr, cl)
-	g.Expect(rs).To(Equal(result))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	rr := assertResource(g, r, expectedStatus)
-	g.Expect(rr.Status.State).To(Equal(string(RDSInstanceStateAvailable)))
 }
 
-func TestDelete(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	tr := testResource()
-
-	r := &Reconciler{
-		Client:                   NewFakeClient(tr),
-		kubeclient:               NewSimpleClientset(),
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient(tr)),
+func TestObserve(t *testing.T) {
+	getInstance := func(i *rds.Instance) func(string) (*rds.Instance, error) {
+		return func(name string) (*rds.Instance, error) {
+			if name != instanceName {
+				return nil, errors.Errorf("unexpected instance %s", name)
+			}
+			return i, nil
+		}
 	}
-
-	cl := &MockRDSClient{}
-
-	// test delete w/ reclaim policy
-	tr.Spec.ReclaimPolicy = runtimev1alpha1.ReclaimRetain
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Deleting(), runtimev1alpha1.ReconcileSuccess())
-
-	rs, err := r._delete(tr, cl)
-	g.Expect(rs).To(Equal(result))
-	g.Expect(err).NotTo(HaveOccurred())
-	assertResource(g, r, expectedStatus)
-
-	// test delete w/ delete policy
-	tr.Spec.ReclaimPolicy = runtimev1alpha1.ReclaimDelete
-	called := false
-	cl.MockDeleteInstance = func(name string) (instance *rds.Instance, e error) {
-		called = true
-		return nil, nil
+	details := resource.ConnectionDetails{
+		runtimev1alpha1.ResourceCredentialsSecretUserKey:     []byte(masterUserName),
+		runtimev1alpha1.ResourceCredentialsSecretEndpointKey: []byte(endpoint),
 	}
 
-	rs, err = r._delete(tr, cl)
-	g.Expect(rs).To(Equal(result))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, expectedStatus)
-
-	// test delete w/ delete policy and delete error
-	testError := errors.New("test-delete-error")
-	called = false
-	cl.MockDeleteInstance = func(name string) (instance *rds.Instance, e error) {
-		called = true
-		return nil, testError
+	type want struct {
+		cr          *RDSInstance
+		observation resource.ExternalObservation
+		err         error
 	}
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Deleting(), runtimev1alpha1.ReconcileError(testError))
-
-	rs, err = r._delete(tr, cl)
-	g.Expect(rs).To(Equal(resultRequeue))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, expectedStatus)
-}
 
-func TestCreate(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	tr := testResource()
-
-	tk := NewSimpleClientset()
-
-	r := &Reconciler{
-		Client:                   NewFakeClient(tr),
-		kubeclient:               tk,
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient(tr)),
-	}
-
-	called := false
-	cl := &MockRDSClient{
-		MockCreateInstance: func(s string, s2 string, spec *RDSInstanceSpec) (instance *rds.Instance, e error) {
-			called = true
-			return nil, nil
+	cases := map[string]struct {
+		client *MockRDSClient
+		kube   client.Client
+		cr     *RDSInstance
+		want   want
+	}{
+		"NotFound": {
+			client: &MockRDSClient{MockGetInstance: func(string) (*rds.Instance, error) { return nil, errNotFound }},
+			cr:     testResource(),
+			want:   want{cr: testResource()},
+		},
+		"GetFailed": {
+			client: &MockRDSClient{MockGetInstance: func(string) (*rds.Instance, error) { return nil, errorBoom }},
+			cr:     testResource(),
+			want:   want{cr: testResource(), err: errors.Wrap(errorBoom, errGetInstance)},
+		},
+		"Creating": {
+			client: &MockRDSClient{MockGetInstance: getInstance(instanceWithStatus(RDSInstanceStateCreating))},
+			cr:     testResource(),
+			want: want{
+				cr: testResource(
+					withObservedInstance(instanceWithStatus(RDSInstanceStateCreating)),
+					withConditions(runtimev1alpha1.Creating()),
+				),
+				observation: resource.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: resource.ConnectionDetails{runtimev1alpha1.ResourceCredentialsSecretUserKey: []byte(masterUserName)},
+				},
+			},
+		},
+		"Failed": {
+			client: &MockRDSClient{MockGetInstance: getInstance(instanceWithStatus(RDSInstanceStateFailed))},
+			cr:     testResource(),
+			want: want{
+				cr: testResource(
+					withObservedInstance(instanceWithStatus(RDSInstanceStateFailed)),
+					withConditions(runtimev1alpha1.Unavailable()),
+				),
+				observation: resource.ExternalObservation{
+					ResourceExists:    true,
+					ResourceUpToDate:  true,
+					ConnectionDetails: resource.ConnectionDetails{runtimev1alpha1.ResourceCredentialsSecretUserKey: []byte(masterUserName)},
+				},
+			},
+		},
+		"Available": {
+			client: &MockRDSClient{MockGetInstance: getInstance(instanceWithStatus(RDSInstanceStateAvailable))},
+			cr:     testResource(),
+			want: want{
+				cr: testResource(
+					withObservedInstance(instanceWithStatus(RDSInstanceStateAvailable)),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+				),
+				observation: resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: details},
+			},
+		},
+		"Outdated": {
+			client: &MockRDSClient{MockGetInstance: getInstance(instanceWithStatus(RDSInstanceStateAvailable))},
+			cr:     testResource(withClass("db.t2.large")),
+			want: want{
+				cr: testResource(
+					withClass("db.t2.large"),
+					withObservedInstance(instanceWithStatus(RDSInstanceStateAvailable)),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+				),
+				observation: resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, ConnectionDetails: details},
+			},
+		},
+		"LateInitialized": {
+			client: &MockRDSClient{MockGetInstance: getInstance(instanceWithStatus(RDSInstanceStateAvailable))},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(nil)},
+			cr:     testResource(withEngineVersion("")),
+			want: want{
+				cr: testResource(
+					withEngineVersion("5.6"),
+					withObservedInstance(instanceWithStatus(RDSInstanceStateAvailable)),
+					withConditions(runtimev1alpha1.Available()),
+					withBindingPhase(runtimev1alpha1.BindingPhaseUnbound),
+				),
+				observation: resource.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ConnectionDetails: details},
+			},
+		},
+		"LateInitializeFailed": {
+			client: &MockRDSClient{MockGetInstance: getInstance(instanceWithStatus(RDSInstanceStateAvailable))},
+			kube:   &test.MockClient{MockUpdate: test.NewMockUpdateFn(errorBoom)},
+			cr:     testResource(withEngineVersion("")),
+			want: want{
+				cr:  testResource(withEngineVersion("5.6")),
+				err: errors.Wrap(errorBoom, errUpdateManaged),
+			},
 		},
 	}
 
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileSuccess())
-
-	rs, err := r._create(testResource(), cl)
-	g.Expect(rs).To(Equal(resultRequeue))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, expectedStatus)
-	// assertSecret
-	g.Expect(tk.Actions()).To(HaveLen(2))
-	g.Expect(tk.Actions()[0].GetVerb()).To(Equal("get"))
-	g.Expect(tk.Actions()[1].GetVerb()).To(Equal("create"))
-	s, err := tk.CoreV1().Secrets(tr.GetNamespace()).Get(tr.GetWriteConnectionSecretToReference().Name, metav1.GetOptions{})
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(s).NotTo(BeNil())
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client, kube: tc.kube}
+			o, err := e.Observe(ctx, tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Observe(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.observation, o); diff != "" {
+				t.Errorf("e.Observe(...): -want, +got:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("e.Observe(...): -want cr, +got cr:\n%s", diff)
+			}
+		})
+	}
 }
 
-func TestCreateFail(t *testing.T) {
-	g := NewGomegaWithT(t)
-	tr := testResource()
-	tk := NewSimpleClientset()
-	cl := &MockRDSClient{}
-
-	r := &Reconciler{
-		Client:                   NewFakeClient(tr),
-		kubeclient:               tk,
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient(tr)),
+func TestCreate(t *testing.T) {
+	type want struct {
+		cr      *RDSInstance
+		details []string
+		err     error
 	}
 
-	// test apply secret error
-	testError := errors.New("test-get-secret-error")
-	tk.PrependReactor("get", "secrets", func(action Action) (handled bool, ret runtime.Object, err error) {
-		return true, nil, testError
-	})
-
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileError(testError))
-
-	rs, err := r._create(tr, cl)
-	g.Expect(rs).To(Equal(resultRequeue))
-	g.Expect(err).NotTo(HaveOccurred())
-	assertResource(g, r, expectedStatus)
-
-	// test create resource error
-	tr = testResource()
-	r.kubeclient = NewSimpleClientset()
-	called := false
-	testError = errors.New("test-create-error")
-	cl.MockCreateInstance = func(s string, s2 string, spec *RDSInstanceSpec) (instance *rds.Instance, e error) {
-		called = true
-		return nil, testError
+	cases := map[string]struct {
+		client *MockRDSClient
+		cr     *RDSInstance
+		want   want
+	}{
+		"Successful": {
+			client: &MockRDSClient{
+				MockCreateInstance: func(name, password string, _ *RDSInstanceSpec) (*rds.Instance, error) {
+					if name != instanceName || password == "" {
+						return nil, errors.Errorf("unexpected instance %s with password %q", name, password)
+					}
+					return instanceWithStatus(RDSInstanceStateCreating), nil
+				},
+			},
+			cr: testResource(),
+			want: want{
+				cr: testResource(withConditions(runtimev1alpha1.Creating())),
+				details: []string{
+					runtimev1alpha1.ResourceCredentialsSecretPasswordKey,
+					runtimev1alpha1.ResourceCredentialsSecretUserKey,
+				},
+			},
+		},
+		"AlreadyExists": {
+			client: &MockRDSClient{
+				MockCreateInstance: func(string, string, *RDSInstanceSpec) (*rds.Instance, error) { return nil, errAlreadyExists },
+			},
+			cr:   testResource(),
+			want: want{cr: testResource(withConditions(runtimev1alpha1.Creating()))},
+		},
+		"Failed": {
+			client: &MockRDSClient{
+				MockCreateInstance: func(string, string, *RDSInstanceSpec) (*rds.Instance, error) { return nil, errorBoom },
+			},
+			cr: testResource(),
+			want: want{
+				cr:  testResource(withConditions(runtimev1alpha1.Creating())),
+				err: errors.Wrap(errorBoom, errCreateInstance),
+			},
+		},
 	}
 
-	expectedStatus = runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.Creating(), runtimev1alpha1.ReconcileError(testError))
-
-	rs, err = r._create(tr, cl)
-	g.Expect(rs).To(Equal(resultRequeue))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, expectedStatus)
-}
-
-func TestConnect(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	tp := testProvider()
-	tr := testResource()
-
-	r := &Reconciler{
-		Client:                   NewFakeClient(tp),
-		kubeclient:               NewSimpleClientset(),
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient(tr)),
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			c, err := e.Create(ctx, tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Create(...): -want error, +got error:\n%s", diff)
+			}
+			var keys []string
+			for _, k := range []string{runtimev1alpha1.ResourceCredentialsSecretPasswordKey, runtimev1alpha1.ResourceCredentialsSecretUserKey} {
+				if len(c.ConnectionDetails[k]) != 0 {
+					keys = append(keys, k)
+				}
+			}
+			if diff := cmp.Diff(tc.want.details, keys); diff != "" {
+				t.Errorf("e.Create(...): -want connection details, +got connection details:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("e.Create(...): -want cr, +got cr:\n%s", diff)
+			}
+		})
 	}
-
-	// error getting aws config - secret is not found
-	r.Client = NewFakeClient(tp)
-	c, err := r._connect(tr)
-	g.Expect(c).To(BeNil())
-	g.Expect(err).To(Not(BeNil()))
 }
 
-func TestReconcile(t *testing.T) {
-	g := NewGomegaWithT(t)
-
-	tr := testResource()
-
-	r := &Reconciler{
-		Client:                   NewFakeClient(tr),
-		kubeclient:               NewSimpleClientset(),
-		ManagedReferenceResolver: resource.NewAPIManagedReferenceResolver(NewFakeClient(tr)),
+func TestUpdate(t *testing.T) {
+	cases := map[string]struct {
+		client *MockRDSClient
+		cr     *RDSInstance
+		want   error
+	}{
+		"Successful": {
+			client: &MockRDSClient{
+				MockModifyInstance: func(name string, spec *RDSInstanceSpec) (*rds.Instance, error) {
+					if name != instanceName || spec.Class != "db.t2.large" {
+						return nil, errors.Errorf("unexpected modification of %s to %s", name, spec.Class)
+					}
+					return instanceWithStatus(RDSInstanceStateAvailable), nil
+				},
+			},
+			cr: testResource(withClass("db.t2.large")),
+		},
+		"Failed": {
+			client: &MockRDSClient{
+				MockModifyInstance: func(string, *RDSInstanceSpec) (*rds.Instance, error) { return nil, errorBoom },
+			},
+			cr:   testResource(),
+			want: errors.Wrap(errorBoom, errModifyInstance),
+		},
 	}
 
-	// test connect error
-	called := false
-	testError := errors.New("test-connect-error")
-	r.connect = func(instance *RDSInstance) (client rds.Client, e error) {
-		called = true
-		return nil, testError
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			_, err := e.Update(ctx, tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Update(...): -want error, +got error:\n%s", diff)
+			}
+		})
 	}
+}
 
-	expectedStatus := runtimev1alpha1.ConditionedStatus{}
-	expectedStatus.SetConditions(runtimev1alpha1.ReconcileError(testError))
-
-	rs, err := r.Reconcile(request)
-	g.Expect(rs).To(Equal(resultRequeue))
-	g.Expect(err).NotTo(HaveOccurred())
-	g.Expect(called).To(BeTrue())
-	assertResource(g, r, expectedStatus)
-
-	// test delete
-	r.connect = func(instance *RDSInstance) (client rds.Client, e error) {
-		t := metav1.Now()
-		instance.DeletionTimestamp = &t
-		return nil, nil
-	}
-	called = false
-	r.delete = func(instance *RDSInstance, client rds.Client) (i reconcile.Result, e error) {
-		called = true
-		return result, nil
+func TestDelete(t *testing.T) {
+	type want struct {
+		cr  *RDSInstance
+		err error
 	}
-	r.Reconcile(request)
-	g.Expect(called).To(BeTrue())
 
-	// test create
-	r.connect = func(instance *RDSInstance) (client rds.Client, e error) {
-		return nil, nil
-	}
-	called = false
-	r.delete = r._delete
-	r.create = func(instance *RDSInstance, client rds.Client) (i reconcile.Result, e error) {
-		called = true
-		return result, nil
+	cases := map[string]struct {
+		client *MockRDSClient
+		cr     *RDSInstance
+		want   want
+	}{
+		"Successful": {
+			client: &MockRDSClient{
+				MockDeleteInstance: func(name string) (*rds.Instance, error) {
+					if name != instanceName {
+						return nil, errors.Errorf("unexpected instance %s", name)
+					}
+					return instanceWithStatus(RDSInstanceStateDeleting), nil
+				},
+			},
+			cr:   testResource(),
+			want: want{cr: testResource(withConditions(runtimev1alpha1.Deleting()))},
+		},
+		"AlreadyDeleting": {
+			client: &MockRDSClient{},
+			cr:     testResource(withState(RDSInstanceStateDeleting)),
+			want:   want{cr: testResource(withState(RDSInstanceStateDeleting), withConditions(runtimev1alpha1.Deleting()))},
+		},
+		"NotFound": {
+			client: &MockRDSClient{MockDeleteInstance: func(string) (*rds.Instance, error) { return nil, errNotFound }},
+			cr:     testResource(),
+			want:   want{cr: testResource(withConditions(runtimev1alpha1.Deleting()))},
+		},
+		"Failed": {
+			client: &MockRDSClient{MockDeleteInstance: func(string) (*rds.Instance, error) { return nil, errorBoom }},
+			cr:     testResource(),
+			want: want{
+				cr:  testResource(withConditions(runtimev1alpha1.Deleting())),
+				err: errors.Wrap(errorBoom, errDeleteInstance),
+			},
+		},
 	}
-	r.Reconcile(request)
-	g.Expect(called).To(BeTrue())
 
-	// test sync
-	r.connect = func(instance *RDSInstance) (client rds.Client, e error) {
-		instance.Status.InstanceName = "foo"
-		return nil, nil
-	}
-	called = false
-	r.create = r._create
-	r.sync = func(instance *RDSInstance, client rds.Client) (i reconcile.Result, e error) {
-		called = true
-		return result, nil
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := &external{client: tc.client}
+			err := e.Delete(ctx, tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("e.Delete(...): -want error, +got error:\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.want.cr, tc.cr, test.EquateConditions()); diff != "" {
+				t.Errorf("e.Delete(...): -want cr, +got cr:\n%s", diff)
+			}
+		})
 	}
-	r.Reconcile(request)
-	g.Expect(called).To(BeTrue())
-
 }
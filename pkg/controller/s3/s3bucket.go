@@ -32,9 +32,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	bucketv1alpha2 "github.com/crossplaneio/stack-aws/apis/storage/v1alpha2"
-	awsv1alpha2 "github.com/crossplaneio/stack-aws/apis/v1alpha2"
-	aws "github.com/crossplaneio/stack-aws/pkg/clients"
 	"github.com/crossplaneio/stack-aws/pkg/clients/s3"
+	"github.com/crossplaneio/stack-aws/pkg/controller/utils"
 
 	runtimev1alpha1 "github.com/crossplaneio/crossplane-runtime/apis/core/v1alpha1"
 	"github.com/crossplaneio/crossplane-runtime/pkg/logging"
@@ -123,22 +122,12 @@ func connectionSecret(bucket *bucketv1alpha2.S3Bucket, accessKey *iam.AccessKey)
 }
 
 func (r *Reconciler) _connect(instance *bucketv1alpha2.S3Bucket) (s3.Service, error) {
-	// Fetch AWS Provider
-	p := &awsv1alpha2.Provider{}
-	err := r.Get(ctx, meta.NamespacedNameOf(instance.Spec.ProviderReference), p)
-	if err != nil {
-		return nil, err
-	}
-
-	// Get Provider's AWS Config
-	config, err := aws.Config(r.kubeclient, p)
+	// Bucket Region and client region must match.
+	config, err := utils.RetrieveAwsConfigFromProviderInRegion(ctx, r, instance.Spec.ProviderReference, instance.Spec.Region)
 	if err != nil {
 		return nil, err
 	}
 
-	// Bucket Region and client region must match.
-	config.Region = instance.Spec.Region
-
 	// Create new S3 S3Client
 	return s3.NewClient(config), nil
 }
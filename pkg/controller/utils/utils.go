@@ -2,10 +2,13 @@ package utils
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -15,22 +18,117 @@ import (
 	awsclients "github.com/crossplaneio/stack-aws/pkg/clients"
 )
 
+// Error strings.
+const (
+	errGetProvider       = "cannot get provider %s"
+	errGetProviderSecret = "cannot get provider secret %s"
+	errNewConfig         = "cannot create new AWS configuration"
+)
+
+// A configCache caches the AWS configurations of providers. The configurations
+// of a provider are cached alongside its UID and generation, and the resource
+// version of the credentials secret they were loaded from, so that they are
+// reloaded when any of these change; e.g. when the credentials are rotated.
+// The configurations of a provider's previous version are evicted when those
+// of its current version are cached, and those of a provider that no longer
+// exists are evicted when it is next looked up.
+type configCache struct {
+	mu        sync.RWMutex
+	providers map[types.NamespacedName]*cachedConfigs
+}
+
+// cachedConfigs are the configurations of one version of a provider, keyed by
+// the region they are for.
+type cachedConfigs struct {
+	version string
+	regions map[string]*aws.Config
+}
+
+func (c *configCache) get(p types.NamespacedName, version, region string) (*aws.Config, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	cc, ok := c.providers[p]
+	if !ok || cc.version != version {
+		return nil, false
+	}
+	cfg, ok := cc.regions[region]
+	if !ok {
+		return nil, false
+	}
+	cp := cfg.Copy()
+	return &cp, true
+}
+
+func (c *configCache) set(p types.NamespacedName, version, region string, cfg *aws.Config) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cc, ok := c.providers[p]
+	if !ok || cc.version != version {
+		cc = &cachedConfigs{version: version, regions: map[string]*aws.Config{}}
+		c.providers[p] = cc
+	}
+	cc.regions[region] = cfg
+}
+
+func (c *configCache) evict(p types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.providers, p)
+}
+
+var cache = &configCache{providers: map[types.NamespacedName]*cachedConfigs{}}
+
 // RetrieveAwsConfigFromProvider retrieves the aws config from the given aws provider reference
 func RetrieveAwsConfigFromProvider(ctx context.Context, client client.Reader, providerRef *corev1.ObjectReference) (*aws.Config, error) {
+	return RetrieveAwsConfigFromProviderInRegion(ctx, client, providerRef, "")
+}
+
+// RetrieveAwsConfigFromProviderInRegion retrieves the aws config from the
+// given aws provider reference, for use in the supplied region. The provider's
+// region is used if the supplied region is empty. Configurations are cached
+// until the provider or its credentials secret change.
+func RetrieveAwsConfigFromProviderInRegion(ctx context.Context, client client.Reader, providerRef *corev1.ObjectReference, region string) (*aws.Config, error) {
 	p := &awsv1alpha2.Provider{}
 	n := meta.NamespacedNameOf(providerRef)
 	if err := client.Get(ctx, n, p); err != nil {
-		return nil, errors.Wrapf(err, "cannot get provider %s", n)
+		if kerrors.IsNotFound(err) {
+			cache.evict(n)
+		}
+		return nil, errors.Wrapf(err, errGetProvider, n)
 	}
 
-	secret := &corev1.Secret{}
-	n = types.NamespacedName{Namespace: p.GetNamespace(), Name: p.Spec.Secret.Name}
-	err := client.Get(ctx, n, secret)
-	if err != nil {
-		return nil, errors.Wrapf(err, "cannot get provider secret %s", n)
+	if region == "" {
+		region = p.Spec.Region
 	}
 
-	cfg, err := awsclients.LoadConfig(secret.Data[p.Spec.Secret.Key], awsclients.DefaultSection, p.Spec.Region)
+	var data []byte
+	secretVersion := ""
+	if awsclients.ProviderUsesSecret(p) {
+		secret := &corev1.Secret{}
+		sn := types.NamespacedName{Namespace: p.GetNamespace(), Name: p.Spec.Secret.Name}
+		if err := client.Get(ctx, sn, secret); err != nil {
+			return nil, errors.Wrapf(err, errGetProviderSecret, sn)
+		}
+		data = secret.Data[p.Spec.Secret.Key]
+		secretVersion = secret.GetResourceVersion()
+	}
+
+	// Objects that have never been persisted (e.g. in tests) have no resource
+	// version, so we can't tell whether a cached configuration is stale.
+	cacheable := p.GetResourceVersion() != ""
+	version := fmt.Sprintf("%s/%d/%s", p.GetUID(), p.GetGeneration(), secretVersion)
+	if cfg, ok := cache.get(n, version, region); ok && cacheable {
+		return cfg, nil
+	}
+
+	cfg, err := awsclients.ProviderConfig(p, data, region)
+	if err != nil {
+		return nil, errors.Wrap(err, errNewConfig)
+	}
+	if cacheable {
+		cache.set(n, version, region, cfg)
+	}
 
-	return cfg, errors.Wrap(err, "cannot create new AWS configuration")
+	c := cfg.Copy()
+	return &c, nil
 }
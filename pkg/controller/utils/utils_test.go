@@ -24,8 +24,10 @@ import (
 
 	"github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	coption "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -126,3 +128,72 @@ aws_secret_access_key = mock_aws_secret_access_key`),
 		g.Expect(err == nil).To(gomega.Equal(tc.expectErrNil), tc.description)
 	}
 }
+
+func Test_RetrieveAwsConfigFromProvider_Cache(t *testing.T) {
+	g := gomega.NewGomegaWithT(t)
+
+	credentials := func(id string) []byte {
+		return []byte("[default]\naws_access_key_id = " + id + "\naws_secret_access_key = mock_aws_secret_access_key")
+	}
+
+	mockProvider := &awsv1alpha2.Provider{
+		ObjectMeta: metav1.ObjectMeta{Name: "cachedprovider", ResourceVersion: "1"},
+		Spec: awsv1alpha2.ProviderSpec{
+			Region: "mock-region",
+			Secret: corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: "cachedsecret"},
+				Key:                  "mockawskey",
+			},
+		},
+	}
+	mockSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1"},
+		Data:       map[string][]byte{"mockawskey": credentials("first")},
+	}
+
+	m := mockClient{
+		mockGet: func(ctx context.Context, n types.NamespacedName, o runtime.Object) error {
+			switch obj := o.(type) {
+			case *awsv1alpha2.Provider:
+				mockProvider.DeepCopyInto(obj)
+			case *corev1.Secret:
+				mockSecret.DeepCopyInto(obj)
+			}
+			return nil
+		},
+	}
+	ref := &corev1.ObjectReference{Name: mockProvider.Name}
+
+	accessKeyID := func(region string) string {
+		cfg, err := RetrieveAwsConfigFromProviderInRegion(context.Background(), &m, ref, region)
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		creds, err := cfg.Credentials.Retrieve()
+		g.Expect(err).NotTo(gomega.HaveOccurred())
+		return creds.AccessKeyID
+	}
+
+	g.Expect(accessKeyID("")).To(gomega.Equal("first"))
+
+	// The secret data changed but its resource version did not, so the cached
+	// configuration should be returned.
+	mockSecret.Data["mockawskey"] = credentials("second")
+	g.Expect(accessKeyID("")).To(gomega.Equal("first"))
+
+	// A region override is cached separately from the provider's region.
+	g.Expect(accessKeyID("other-region")).To(gomega.Equal("second"))
+
+	// Rotating the secret should invalidate the cached configuration, and
+	// evict those of the previous version in other regions.
+	mockSecret.ResourceVersion = "2"
+	g.Expect(accessKeyID("")).To(gomega.Equal("second"))
+	n := types.NamespacedName{Name: mockProvider.Name}
+	g.Expect(cache.providers[n].regions).To(gomega.HaveLen(1))
+
+	// A provider that no longer exists should be evicted.
+	m.mockGet = func(ctx context.Context, n types.NamespacedName, o runtime.Object) error {
+		return kerrors.NewNotFound(schema.GroupResource{}, n.Name)
+	}
+	_, err := RetrieveAwsConfigFromProviderInRegion(context.Background(), &m, ref, "")
+	g.Expect(err).To(gomega.HaveOccurred())
+	g.Expect(cache.providers).NotTo(gomega.HaveKey(n))
+}